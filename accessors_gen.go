@@ -0,0 +1,6071 @@
+// Code generated by accessorgen; DO NOT EDIT.
+
+package thousandeyes
+
+import (
+	"time"
+)
+
+// GetHref returns the Href field if it's non-nil, zero value otherwise.
+func (a *APILink) GetHref() string {
+	if a == nil || a.Href == nil {
+		return ""
+	}
+	return *a.Href
+}
+
+// GetRel returns the Rel field if it's non-nil, zero value otherwise.
+func (a *APILink) GetRel() string {
+	if a == nil || a.Rel == nil {
+		return ""
+	}
+	return *a.Rel
+}
+
+// GetAID returns the AID field if it's non-nil, zero value otherwise.
+func (t *AccountGroup) GetAID() int {
+	if t == nil || t.AID == nil {
+		return 0
+	}
+	return *t.AID
+}
+
+// GetAccountGroupName returns the AccountGroupName field if it's non-nil, zero value otherwise.
+func (t *AccountGroup) GetAccountGroupName() string {
+	if t == nil || t.AccountGroupName == nil {
+		return ""
+	}
+	return *t.AccountGroupName
+}
+
+// GetAgents returns the Agents field if it's non-nil, zero value otherwise.
+func (t *AccountGroup) GetAgents() []Agent {
+	if t == nil || t.Agents == nil {
+		return nil
+	}
+	return *t.Agents
+}
+
+// GetCurrent returns the Current field if it's non-nil, zero value otherwise.
+func (t *AccountGroup) GetCurrent() bool {
+	if t == nil || t.Current == nil {
+		return false
+	}
+	return *t.Current
+}
+
+// GetDefault returns the Default field if it's non-nil, zero value otherwise.
+func (t *AccountGroup) GetDefault() bool {
+	if t == nil || t.Default == nil {
+		return false
+	}
+	return *t.Default
+}
+
+// GetOrganizationName returns the OrganizationName field if it's non-nil, zero value otherwise.
+func (t *AccountGroup) GetOrganizationName() string {
+	if t == nil || t.OrganizationName == nil {
+		return ""
+	}
+	return *t.OrganizationName
+}
+
+// GetUsers returns the Users field if it's non-nil, zero value otherwise.
+func (t *AccountGroup) GetUsers() []AccountGroupUser {
+	if t == nil || t.Users == nil {
+		return nil
+	}
+	return *t.Users
+}
+
+// GetBuiltin returns the Builtin field if it's non-nil, zero value otherwise.
+func (t *AccountGroupRole) GetBuiltin() bool {
+	if t == nil || t.Builtin == nil {
+		return false
+	}
+	return *t.Builtin
+}
+
+// GetHasManagementPermissions returns the HasManagementPermissions field if it's non-nil, zero value otherwise.
+func (t *AccountGroupRole) GetHasManagementPermissions() bool {
+	if t == nil || t.HasManagementPermissions == nil {
+		return false
+	}
+	return *t.HasManagementPermissions
+}
+
+// GetPermissions returns the Permissions field if it's non-nil, zero value otherwise.
+func (t *AccountGroupRole) GetPermissions() []Permission {
+	if t == nil || t.Permissions == nil {
+		return nil
+	}
+	return *t.Permissions
+}
+
+// GetRoleID returns the RoleID field if it's non-nil, zero value otherwise.
+func (t *AccountGroupRole) GetRoleID() int {
+	if t == nil || t.RoleID == nil {
+		return 0
+	}
+	return *t.RoleID
+}
+
+// GetRoleName returns the RoleName field if it's non-nil, zero value otherwise.
+func (t *AccountGroupRole) GetRoleName() string {
+	if t == nil || t.RoleName == nil {
+		return ""
+	}
+	return *t.RoleName
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (a *AccountGroupUser) GetEmail() string {
+	if a == nil || a.Email == nil {
+		return ""
+	}
+	return *a.Email
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (a *AccountGroupUser) GetName() string {
+	if a == nil || a.Name == nil {
+		return ""
+	}
+	return *a.Name
+}
+
+// GetRoles returns the Roles field if it's non-nil, zero value otherwise.
+func (a *AccountGroupUser) GetRoles() []AccountGroupRole {
+	if a == nil || a.Roles == nil {
+		return nil
+	}
+	return *a.Roles
+}
+
+// GetUID returns the UID field if it's non-nil, zero value otherwise.
+func (a *AccountGroupUser) GetUID() int {
+	if a == nil || a.UID == nil {
+		return 0
+	}
+	return *a.UID
+}
+
+// GetAgentID returns the AgentID field if it's non-nil, zero value otherwise.
+func (t *Agent) GetAgentID() int {
+	if t == nil || t.AgentID == nil {
+		return 0
+	}
+	return *t.AgentID
+}
+
+// GetAgentName returns the AgentName field if it's non-nil, zero value otherwise.
+func (t *Agent) GetAgentName() string {
+	if t == nil || t.AgentName == nil {
+		return ""
+	}
+	return *t.AgentName
+}
+
+// GetAgentState returns the AgentState field if it's non-nil, zero value otherwise.
+func (t *Agent) GetAgentState() string {
+	if t == nil || t.AgentState == nil {
+		return ""
+	}
+	return *t.AgentState
+}
+
+// GetAgentType returns the AgentType field if it's non-nil, zero value otherwise.
+func (t *Agent) GetAgentType() string {
+	if t == nil || t.AgentType == nil {
+		return ""
+	}
+	return *t.AgentType
+}
+
+// GetClusterMembers returns the ClusterMembers field if it's non-nil, zero value otherwise.
+func (t *Agent) GetClusterMembers() []ClusterMember {
+	if t == nil || t.ClusterMembers == nil {
+		return nil
+	}
+	return *t.ClusterMembers
+}
+
+// GetCountryID returns the CountryID field if it's non-nil, zero value otherwise.
+func (t *Agent) GetCountryID() string {
+	if t == nil || t.CountryID == nil {
+		return ""
+	}
+	return *t.CountryID
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (t *Agent) GetCreatedDate() string {
+	if t == nil || t.CreatedDate == nil {
+		return ""
+	}
+	return *t.CreatedDate
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (t *Agent) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return false
+	}
+	return *t.Enabled
+}
+
+// GetErrorDetails returns the ErrorDetails field if it's non-nil, zero value otherwise.
+func (t *Agent) GetErrorDetails() []AgentErrorDetails {
+	if t == nil || t.ErrorDetails == nil {
+		return nil
+	}
+	return *t.ErrorDetails
+}
+
+// GetGroups returns the Groups field if it's non-nil, zero value otherwise.
+func (t *Agent) GetGroups() GroupLabels {
+	if t == nil || t.Groups == nil {
+		return nil
+	}
+	return *t.Groups
+}
+
+// GetHostname returns the Hostname field if it's non-nil, zero value otherwise.
+func (t *Agent) GetHostname() string {
+	if t == nil || t.Hostname == nil {
+		return ""
+	}
+	return *t.Hostname
+}
+
+// GetIPAddresses returns the IPAddresses field if it's non-nil, zero value otherwise.
+func (t *Agent) GetIPAddresses() []string {
+	if t == nil || t.IPAddresses == nil {
+		return nil
+	}
+	return *t.IPAddresses
+}
+
+// GetIpv6Policy returns the Ipv6Policy field if it's non-nil, zero value otherwise.
+func (t *Agent) GetIpv6Policy() string {
+	if t == nil || t.Ipv6Policy == nil {
+		return ""
+	}
+	return *t.Ipv6Policy
+}
+
+// GetKeepBrowserCache returns the KeepBrowserCache field if it's non-nil, zero value otherwise.
+func (t *Agent) GetKeepBrowserCache() bool {
+	if t == nil || t.KeepBrowserCache == nil {
+		return false
+	}
+	return *t.KeepBrowserCache
+}
+
+// GetLastSeen returns the LastSeen field if it's non-nil, zero value otherwise.
+func (t *Agent) GetLastSeen() string {
+	if t == nil || t.LastSeen == nil {
+		return ""
+	}
+	return *t.LastSeen
+}
+
+// GetLocation returns the Location field if it's non-nil, zero value otherwise.
+func (t *Agent) GetLocation() string {
+	if t == nil || t.Location == nil {
+		return ""
+	}
+	return *t.Location
+}
+
+// GetNetwork returns the Network field if it's non-nil, zero value otherwise.
+func (t *Agent) GetNetwork() string {
+	if t == nil || t.Network == nil {
+		return ""
+	}
+	return *t.Network
+}
+
+// GetPrefix returns the Prefix field if it's non-nil, zero value otherwise.
+func (t *Agent) GetPrefix() string {
+	if t == nil || t.Prefix == nil {
+		return ""
+	}
+	return *t.Prefix
+}
+
+// GetTargetForTests returns the TargetForTests field if it's non-nil, zero value otherwise.
+func (t *Agent) GetTargetForTests() string {
+	if t == nil || t.TargetForTests == nil {
+		return ""
+	}
+	return *t.TargetForTests
+}
+
+// GetUtilization returns the Utilization field if it's non-nil, zero value otherwise.
+func (t *Agent) GetUtilization() int {
+	if t == nil || t.Utilization == nil {
+		return 0
+	}
+	return *t.Utilization
+}
+
+// GetVerifySslCertificates returns the VerifySslCertificates field if it's non-nil, zero value otherwise.
+func (t *Agent) GetVerifySslCertificates() bool {
+	if t == nil || t.VerifySslCertificates == nil {
+		return false
+	}
+	return *t.VerifySslCertificates
+}
+
+// GetAPILinks returns the APILinks field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetAPILinks() []APILink {
+	if t == nil || t.APILinks == nil {
+		return nil
+	}
+	return *t.APILinks
+}
+
+// GetAgents returns the Agents field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetAgents() []Agent {
+	if t == nil || t.Agents == nil {
+		return nil
+	}
+	return *t.Agents
+}
+
+// GetAlertRules returns the AlertRules field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetAlertRules() []AlertRule {
+	if t == nil || t.AlertRules == nil {
+		return nil
+	}
+	return *t.AlertRules
+}
+
+// GetAlertsEnabled returns the AlertsEnabled field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetAlertsEnabled() bool {
+	if t == nil || t.AlertsEnabled == nil {
+		return false
+	}
+	return *t.AlertsEnabled
+}
+
+// GetBGPMeasurements returns the BGPMeasurements field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetBGPMeasurements() bool {
+	if t == nil || t.BGPMeasurements == nil {
+		return false
+	}
+	return *t.BGPMeasurements
+}
+
+// GetBGPMonitors returns the BGPMonitors field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetBGPMonitors() []BGPMonitor {
+	if t == nil || t.BGPMonitors == nil {
+		return nil
+	}
+	return *t.BGPMonitors
+}
+
+// GetCreatedBy returns the CreatedBy field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetCreatedBy() string {
+	if t == nil || t.CreatedBy == nil {
+		return ""
+	}
+	return *t.CreatedBy
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetCreatedDate() string {
+	if t == nil || t.CreatedDate == nil {
+		return ""
+	}
+	return *t.CreatedDate
+}
+
+// GetDSCP returns the DSCP field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetDSCP() DSCP {
+	if t == nil || t.DSCP == nil {
+		return ""
+	}
+	return *t.DSCP
+}
+
+// GetDSCPID returns the DSCPID field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetDSCPID() int {
+	if t == nil || t.DSCPID == nil {
+		return 0
+	}
+	return *t.DSCPID
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetDescription() string {
+	if t == nil || t.Description == nil {
+		return ""
+	}
+	return *t.Description
+}
+
+// GetDirection returns the Direction field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetDirection() Direction {
+	if t == nil || t.Direction == nil {
+		return ""
+	}
+	return *t.Direction
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return false
+	}
+	return *t.Enabled
+}
+
+// GetGroups returns the Groups field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetGroups() []GroupLabel {
+	if t == nil || t.Groups == nil {
+		return nil
+	}
+	return *t.Groups
+}
+
+// GetInterval returns the Interval field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetInterval() int {
+	if t == nil || t.Interval == nil {
+		return 0
+	}
+	return *t.Interval
+}
+
+// GetLiveShare returns the LiveShare field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetLiveShare() bool {
+	if t == nil || t.LiveShare == nil {
+		return false
+	}
+	return *t.LiveShare
+}
+
+// GetMSS returns the MSS field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetMSS() int {
+	if t == nil || t.MSS == nil {
+		return 0
+	}
+	return *t.MSS
+}
+
+// GetMTUMeasurements returns the MTUMeasurements field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetMTUMeasurements() bool {
+	if t == nil || t.MTUMeasurements == nil {
+		return false
+	}
+	return *t.MTUMeasurements
+}
+
+// GetModifiedBy returns the ModifiedBy field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetModifiedBy() string {
+	if t == nil || t.ModifiedBy == nil {
+		return ""
+	}
+	return *t.ModifiedBy
+}
+
+// GetModifiedDate returns the ModifiedDate field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetModifiedDate() string {
+	if t == nil || t.ModifiedDate == nil {
+		return ""
+	}
+	return *t.ModifiedDate
+}
+
+// GetNetworkMeasurements returns the NetworkMeasurements field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetNetworkMeasurements() bool {
+	if t == nil || t.NetworkMeasurements == nil {
+		return false
+	}
+	return *t.NetworkMeasurements
+}
+
+// GetNumPathTraces returns the NumPathTraces field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetNumPathTraces() int {
+	if t == nil || t.NumPathTraces == nil {
+		return 0
+	}
+	return *t.NumPathTraces
+}
+
+// GetPathTraceMode returns the PathTraceMode field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetPathTraceMode() PathTraceMode {
+	if t == nil || t.PathTraceMode == nil {
+		return ""
+	}
+	return *t.PathTraceMode
+}
+
+// GetPort returns the Port field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetPort() int {
+	if t == nil || t.Port == nil {
+		return 0
+	}
+	return *t.Port
+}
+
+// GetProtocol returns the Protocol field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetProtocol() Protocol {
+	if t == nil || t.Protocol == nil {
+		return ""
+	}
+	return *t.Protocol
+}
+
+// GetSavedEvent returns the SavedEvent field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetSavedEvent() bool {
+	if t == nil || t.SavedEvent == nil {
+		return false
+	}
+	return *t.SavedEvent
+}
+
+// GetSharedWithAccounts returns the SharedWithAccounts field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetSharedWithAccounts() []SharedWithAccount {
+	if t == nil || t.SharedWithAccounts == nil {
+		return nil
+	}
+	return *t.SharedWithAccounts
+}
+
+// GetTargetAgentID returns the TargetAgentID field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetTargetAgentID() int {
+	if t == nil || t.TargetAgentID == nil {
+		return 0
+	}
+	return *t.TargetAgentID
+}
+
+// GetTestID returns the TestID field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetTestID() int64 {
+	if t == nil || t.TestID == nil {
+		return 0
+	}
+	return *t.TestID
+}
+
+// GetTestName returns the TestName field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetTestName() string {
+	if t == nil || t.TestName == nil {
+		return ""
+	}
+	return *t.TestName
+}
+
+// GetThroughputDuration returns the ThroughputDuration field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetThroughputDuration() int {
+	if t == nil || t.ThroughputDuration == nil {
+		return 0
+	}
+	return *t.ThroughputDuration
+}
+
+// GetThroughputMeasurements returns the ThroughputMeasurements field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetThroughputMeasurements() bool {
+	if t == nil || t.ThroughputMeasurements == nil {
+		return false
+	}
+	return *t.ThroughputMeasurements
+}
+
+// GetThroughputRate returns the ThroughputRate field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetThroughputRate() int {
+	if t == nil || t.ThroughputRate == nil {
+		return 0
+	}
+	return *t.ThroughputRate
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetType() string {
+	if t == nil || t.Type == nil {
+		return ""
+	}
+	return *t.Type
+}
+
+// GetUsePublicBGP returns the UsePublicBGP field if it's non-nil, zero value otherwise.
+func (t *AgentAgent) GetUsePublicBGP() bool {
+	if t == nil || t.UsePublicBGP == nil {
+		return false
+	}
+	return *t.UsePublicBGP
+}
+
+// GetCode returns the Code field if it's non-nil, zero value otherwise.
+func (a *AgentErrorDetails) GetCode() string {
+	if a == nil || a.Code == nil {
+		return ""
+	}
+	return *a.Code
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (a *AgentErrorDetails) GetDescription() string {
+	if a == nil || a.Description == nil {
+		return ""
+	}
+	return *a.Description
+}
+
+// GetAPILinks returns the APILinks field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetAPILinks() []APILink {
+	if t == nil || t.APILinks == nil {
+		return nil
+	}
+	return *t.APILinks
+}
+
+// GetAgents returns the Agents field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetAgents() []Agent {
+	if t == nil || t.Agents == nil {
+		return nil
+	}
+	return *t.Agents
+}
+
+// GetAlertRules returns the AlertRules field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetAlertRules() []AlertRule {
+	if t == nil || t.AlertRules == nil {
+		return nil
+	}
+	return *t.AlertRules
+}
+
+// GetAlertsEnabled returns the AlertsEnabled field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetAlertsEnabled() bool {
+	if t == nil || t.AlertsEnabled == nil {
+		return false
+	}
+	return *t.AlertsEnabled
+}
+
+// GetBGPMeasurements returns the BGPMeasurements field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetBGPMeasurements() bool {
+	if t == nil || t.BGPMeasurements == nil {
+		return false
+	}
+	return *t.BGPMeasurements
+}
+
+// GetBGPMonitors returns the BGPMonitors field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetBGPMonitors() []BGPMonitor {
+	if t == nil || t.BGPMonitors == nil {
+		return nil
+	}
+	return *t.BGPMonitors
+}
+
+// GetBandwidthMeasurements returns the BandwidthMeasurements field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetBandwidthMeasurements() bool {
+	if t == nil || t.BandwidthMeasurements == nil {
+		return false
+	}
+	return *t.BandwidthMeasurements
+}
+
+// GetCreatedBy returns the CreatedBy field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetCreatedBy() string {
+	if t == nil || t.CreatedBy == nil {
+		return ""
+	}
+	return *t.CreatedBy
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetCreatedDate() string {
+	if t == nil || t.CreatedDate == nil {
+		return ""
+	}
+	return *t.CreatedDate
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetDescription() string {
+	if t == nil || t.Description == nil {
+		return ""
+	}
+	return *t.Description
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return false
+	}
+	return *t.Enabled
+}
+
+// GetGroups returns the Groups field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetGroups() []GroupLabel {
+	if t == nil || t.Groups == nil {
+		return nil
+	}
+	return *t.Groups
+}
+
+// GetInterval returns the Interval field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetInterval() int {
+	if t == nil || t.Interval == nil {
+		return 0
+	}
+	return *t.Interval
+}
+
+// GetLiveShare returns the LiveShare field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetLiveShare() bool {
+	if t == nil || t.LiveShare == nil {
+		return false
+	}
+	return *t.LiveShare
+}
+
+// GetMTUMeasurements returns the MTUMeasurements field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetMTUMeasurements() bool {
+	if t == nil || t.MTUMeasurements == nil {
+		return false
+	}
+	return *t.MTUMeasurements
+}
+
+// GetModifiedBy returns the ModifiedBy field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetModifiedBy() string {
+	if t == nil || t.ModifiedBy == nil {
+		return ""
+	}
+	return *t.ModifiedBy
+}
+
+// GetModifiedDate returns the ModifiedDate field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetModifiedDate() string {
+	if t == nil || t.ModifiedDate == nil {
+		return ""
+	}
+	return *t.ModifiedDate
+}
+
+// GetNetworkMeasurements returns the NetworkMeasurements field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetNetworkMeasurements() bool {
+	if t == nil || t.NetworkMeasurements == nil {
+		return false
+	}
+	return *t.NetworkMeasurements
+}
+
+// GetNumPathTraces returns the NumPathTraces field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetNumPathTraces() int {
+	if t == nil || t.NumPathTraces == nil {
+		return 0
+	}
+	return *t.NumPathTraces
+}
+
+// GetPathTraceMode returns the PathTraceMode field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetPathTraceMode() PathTraceMode {
+	if t == nil || t.PathTraceMode == nil {
+		return ""
+	}
+	return *t.PathTraceMode
+}
+
+// GetPort returns the Port field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetPort() int {
+	if t == nil || t.Port == nil {
+		return 0
+	}
+	return *t.Port
+}
+
+// GetProbeMode returns the ProbeMode field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetProbeMode() ProbeMode {
+	if t == nil || t.ProbeMode == nil {
+		return ""
+	}
+	return *t.ProbeMode
+}
+
+// GetProtocol returns the Protocol field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetProtocol() Protocol {
+	if t == nil || t.Protocol == nil {
+		return ""
+	}
+	return *t.Protocol
+}
+
+// GetSavedEvent returns the SavedEvent field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetSavedEvent() bool {
+	if t == nil || t.SavedEvent == nil {
+		return false
+	}
+	return *t.SavedEvent
+}
+
+// GetServer returns the Server field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetServer() string {
+	if t == nil || t.Server == nil {
+		return ""
+	}
+	return *t.Server
+}
+
+// GetSharedWithAccounts returns the SharedWithAccounts field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetSharedWithAccounts() []SharedWithAccount {
+	if t == nil || t.SharedWithAccounts == nil {
+		return nil
+	}
+	return *t.SharedWithAccounts
+}
+
+// GetTestID returns the TestID field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetTestID() int64 {
+	if t == nil || t.TestID == nil {
+		return 0
+	}
+	return *t.TestID
+}
+
+// GetTestName returns the TestName field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetTestName() string {
+	if t == nil || t.TestName == nil {
+		return ""
+	}
+	return *t.TestName
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetType() string {
+	if t == nil || t.Type == nil {
+		return ""
+	}
+	return *t.Type
+}
+
+// GetUsePublicBGP returns the UsePublicBGP field if it's non-nil, zero value otherwise.
+func (t *AgentServer) GetUsePublicBGP() bool {
+	if t == nil || t.UsePublicBGP == nil {
+		return false
+	}
+	return *t.UsePublicBGP
+}
+
+// GetAPILinks returns the APILinks field if it's non-nil, zero value otherwise.
+func (a *Alert) GetAPILinks() []APILink {
+	if a == nil || a.APILinks == nil {
+		return nil
+	}
+	return *a.APILinks
+}
+
+// GetActive returns the Active field if it's non-nil, zero value otherwise.
+func (a *Alert) GetActive() int {
+	if a == nil || a.Active == nil {
+		return 0
+	}
+	return *a.Active
+}
+
+// GetAgents returns the Agents field if it's non-nil, zero value otherwise.
+func (a *Alert) GetAgents() []Agent {
+	if a == nil || a.Agents == nil {
+		return nil
+	}
+	return *a.Agents
+}
+
+// GetAlertID returns the AlertID field if it's non-nil, zero value otherwise.
+func (a *Alert) GetAlertID() int {
+	if a == nil || a.AlertID == nil {
+		return 0
+	}
+	return *a.AlertID
+}
+
+// GetDateEnd returns the DateEnd field if it's non-nil, zero value otherwise.
+func (a *Alert) GetDateEnd() string {
+	if a == nil || a.DateEnd == nil {
+		return ""
+	}
+	return *a.DateEnd
+}
+
+// GetDateStart returns the DateStart field if it's non-nil, zero value otherwise.
+func (a *Alert) GetDateStart() string {
+	if a == nil || a.DateStart == nil {
+		return ""
+	}
+	return *a.DateStart
+}
+
+// GetMonitors returns the Monitors field if it's non-nil, zero value otherwise.
+func (a *Alert) GetMonitors() []Monitor {
+	if a == nil || a.Monitors == nil {
+		return nil
+	}
+	return *a.Monitors
+}
+
+// GetPermalink returns the Permalink field if it's non-nil, zero value otherwise.
+func (a *Alert) GetPermalink() string {
+	if a == nil || a.Permalink == nil {
+		return ""
+	}
+	return *a.Permalink
+}
+
+// GetRuleExpression returns the RuleExpression field if it's non-nil, zero value otherwise.
+func (a *Alert) GetRuleExpression() string {
+	if a == nil || a.RuleExpression == nil {
+		return ""
+	}
+	return *a.RuleExpression
+}
+
+// GetRuleName returns the RuleName field if it's non-nil, zero value otherwise.
+func (a *Alert) GetRuleName() string {
+	if a == nil || a.RuleName == nil {
+		return ""
+	}
+	return *a.RuleName
+}
+
+// GetTestID returns the TestID field if it's non-nil, zero value otherwise.
+func (a *Alert) GetTestID() int64 {
+	if a == nil || a.TestID == nil {
+		return 0
+	}
+	return *a.TestID
+}
+
+// GetTestName returns the TestName field if it's non-nil, zero value otherwise.
+func (a *Alert) GetTestName() string {
+	if a == nil || a.TestName == nil {
+		return ""
+	}
+	return *a.TestName
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (a *Alert) GetType() string {
+	if a == nil || a.Type == nil {
+		return ""
+	}
+	return *a.Type
+}
+
+// GetViolationCount returns the ViolationCount field if it's non-nil, zero value otherwise.
+func (a *Alert) GetViolationCount() int {
+	if a == nil || a.ViolationCount == nil {
+		return 0
+	}
+	return *a.ViolationCount
+}
+
+// GetAlertRuleID returns the AlertRuleID field if it's non-nil, zero value otherwise.
+func (t *AlertRule) GetAlertRuleID() int {
+	if t == nil || t.AlertRuleID == nil {
+		return 0
+	}
+	return *t.AlertRuleID
+}
+
+// GetAlertType returns the AlertType field if it's non-nil, zero value otherwise.
+func (t *AlertRule) GetAlertType() string {
+	if t == nil || t.AlertType == nil {
+		return ""
+	}
+	return *t.AlertType
+}
+
+// GetDefault returns the Default field if it's non-nil, zero value otherwise.
+func (t *AlertRule) GetDefault() bool {
+	if t == nil || t.Default == nil {
+		return false
+	}
+	return *t.Default
+}
+
+// GetDirection returns the Direction field if it's non-nil, zero value otherwise.
+func (t *AlertRule) GetDirection() string {
+	if t == nil || t.Direction == nil {
+		return ""
+	}
+	return *t.Direction
+}
+
+// GetExpression returns the Expression field if it's non-nil, zero value otherwise.
+func (t *AlertRule) GetExpression() string {
+	if t == nil || t.Expression == nil {
+		return ""
+	}
+	return *t.Expression
+}
+
+// GetIncludeCoveredPrefixes returns the IncludeCoveredPrefixes field if it's non-nil, zero value otherwise.
+func (t *AlertRule) GetIncludeCoveredPrefixes() int {
+	if t == nil || t.IncludeCoveredPrefixes == nil {
+		return 0
+	}
+	return *t.IncludeCoveredPrefixes
+}
+
+// GetMinimumSources returns the MinimumSources field if it's non-nil, zero value otherwise.
+func (t *AlertRule) GetMinimumSources() int {
+	if t == nil || t.MinimumSources == nil {
+		return 0
+	}
+	return *t.MinimumSources
+}
+
+// GetMinimumSourcesPct returns the MinimumSourcesPct field if it's non-nil, zero value otherwise.
+func (t *AlertRule) GetMinimumSourcesPct() int {
+	if t == nil || t.MinimumSourcesPct == nil {
+		return 0
+	}
+	return *t.MinimumSourcesPct
+}
+
+// GetNotifications returns the Notifications field, or nil if the receiver is nil.
+func (t *AlertRule) GetNotifications() *Notification {
+	if t == nil {
+		return nil
+	}
+	return t.Notifications
+}
+
+// GetNotifyOnClear returns the NotifyOnClear field if it's non-nil, zero value otherwise.
+func (t *AlertRule) GetNotifyOnClear() bool {
+	if t == nil || t.NotifyOnClear == nil {
+		return false
+	}
+	return *t.NotifyOnClear
+}
+
+// GetRoundsViolatingMode returns the RoundsViolatingMode field if it's non-nil, zero value otherwise.
+func (t *AlertRule) GetRoundsViolatingMode() string {
+	if t == nil || t.RoundsViolatingMode == nil {
+		return ""
+	}
+	return *t.RoundsViolatingMode
+}
+
+// GetRoundsViolatingOutOf returns the RoundsViolatingOutOf field if it's non-nil, zero value otherwise.
+func (t *AlertRule) GetRoundsViolatingOutOf() int {
+	if t == nil || t.RoundsViolatingOutOf == nil {
+		return 0
+	}
+	return *t.RoundsViolatingOutOf
+}
+
+// GetRoundsViolatingRequired returns the RoundsViolatingRequired field if it's non-nil, zero value otherwise.
+func (t *AlertRule) GetRoundsViolatingRequired() int {
+	if t == nil || t.RoundsViolatingRequired == nil {
+		return 0
+	}
+	return *t.RoundsViolatingRequired
+}
+
+// GetRuleID returns the RuleID field if it's non-nil, zero value otherwise.
+func (t *AlertRule) GetRuleID() int {
+	if t == nil || t.RuleID == nil {
+		return 0
+	}
+	return *t.RuleID
+}
+
+// GetRuleName returns the RuleName field if it's non-nil, zero value otherwise.
+func (t *AlertRule) GetRuleName() string {
+	if t == nil || t.RuleName == nil {
+		return ""
+	}
+	return *t.RuleName
+}
+
+// GetTestIds returns the TestIds field if it's non-nil, zero value otherwise.
+func (t *AlertRule) GetTestIds() []int {
+	if t == nil || t.TestIds == nil {
+		return nil
+	}
+	return *t.TestIds
+}
+
+// GetAID returns the AID field if it's non-nil, zero value otherwise.
+func (e *AuditEvent) GetAID() int {
+	if e == nil || e.AID == nil {
+		return 0
+	}
+	return *e.AID
+}
+
+// GetAccountGroupName returns the AccountGroupName field if it's non-nil, zero value otherwise.
+func (e *AuditEvent) GetAccountGroupName() string {
+	if e == nil || e.AccountGroupName == nil {
+		return ""
+	}
+	return *e.AccountGroupName
+}
+
+// GetDate returns the Date field if it's non-nil, zero value otherwise.
+func (e *AuditEvent) GetDate() string {
+	if e == nil || e.Date == nil {
+		return ""
+	}
+	return *e.Date
+}
+
+// GetEvent returns the Event field if it's non-nil, zero value otherwise.
+func (e *AuditEvent) GetEvent() string {
+	if e == nil || e.Event == nil {
+		return ""
+	}
+	return *e.Event
+}
+
+// GetIPAddress returns the IPAddress field if it's non-nil, zero value otherwise.
+func (e *AuditEvent) GetIPAddress() string {
+	if e == nil || e.IPAddress == nil {
+		return ""
+	}
+	return *e.IPAddress
+}
+
+// GetResourceType returns the ResourceType field if it's non-nil, zero value otherwise.
+func (e *AuditEvent) GetResourceType() string {
+	if e == nil || e.ResourceType == nil {
+		return ""
+	}
+	return *e.ResourceType
+}
+
+// GetUID returns the UID field if it's non-nil, zero value otherwise.
+func (e *AuditEvent) GetUID() int {
+	if e == nil || e.UID == nil {
+		return 0
+	}
+	return *e.UID
+}
+
+// GetUser returns the User field if it's non-nil, zero value otherwise.
+func (e *AuditEvent) GetUser() string {
+	if e == nil || e.User == nil {
+		return ""
+	}
+	return *e.User
+}
+
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (a *AuditEventsPage) GetFrom() string {
+	if a == nil || a.From == nil {
+		return ""
+	}
+	return *a.From
+}
+
+// GetTo returns the To field if it's non-nil, zero value otherwise.
+func (a *AuditEventsPage) GetTo() string {
+	if a == nil || a.To == nil {
+		return ""
+	}
+	return *a.To
+}
+
+// GetAPILinks returns the APILinks field if it's non-nil, zero value otherwise.
+func (t *BGP) GetAPILinks() []APILink {
+	if t == nil || t.APILinks == nil {
+		return nil
+	}
+	return *t.APILinks
+}
+
+// GetAlertRules returns the AlertRules field if it's non-nil, zero value otherwise.
+func (t *BGP) GetAlertRules() []AlertRule {
+	if t == nil || t.AlertRules == nil {
+		return nil
+	}
+	return *t.AlertRules
+}
+
+// GetAlertsEnabled returns the AlertsEnabled field if it's non-nil, zero value otherwise.
+func (t *BGP) GetAlertsEnabled() bool {
+	if t == nil || t.AlertsEnabled == nil {
+		return false
+	}
+	return *t.AlertsEnabled
+}
+
+// GetBGPMonitors returns the BGPMonitors field if it's non-nil, zero value otherwise.
+func (t *BGP) GetBGPMonitors() []BGPMonitor {
+	if t == nil || t.BGPMonitors == nil {
+		return nil
+	}
+	return *t.BGPMonitors
+}
+
+// GetCreatedBy returns the CreatedBy field if it's non-nil, zero value otherwise.
+func (t *BGP) GetCreatedBy() string {
+	if t == nil || t.CreatedBy == nil {
+		return ""
+	}
+	return *t.CreatedBy
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (t *BGP) GetCreatedDate() string {
+	if t == nil || t.CreatedDate == nil {
+		return ""
+	}
+	return *t.CreatedDate
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (t *BGP) GetDescription() string {
+	if t == nil || t.Description == nil {
+		return ""
+	}
+	return *t.Description
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (t *BGP) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return false
+	}
+	return *t.Enabled
+}
+
+// GetGroups returns the Groups field if it's non-nil, zero value otherwise.
+func (t *BGP) GetGroups() []GroupLabel {
+	if t == nil || t.Groups == nil {
+		return nil
+	}
+	return *t.Groups
+}
+
+// GetIncludeCoveredPrefixes returns the IncludeCoveredPrefixes field if it's non-nil, zero value otherwise.
+func (t *BGP) GetIncludeCoveredPrefixes() bool {
+	if t == nil || t.IncludeCoveredPrefixes == nil {
+		return false
+	}
+	return *t.IncludeCoveredPrefixes
+}
+
+// GetLiveShare returns the LiveShare field if it's non-nil, zero value otherwise.
+func (t *BGP) GetLiveShare() bool {
+	if t == nil || t.LiveShare == nil {
+		return false
+	}
+	return *t.LiveShare
+}
+
+// GetModifiedBy returns the ModifiedBy field if it's non-nil, zero value otherwise.
+func (t *BGP) GetModifiedBy() string {
+	if t == nil || t.ModifiedBy == nil {
+		return ""
+	}
+	return *t.ModifiedBy
+}
+
+// GetModifiedDate returns the ModifiedDate field if it's non-nil, zero value otherwise.
+func (t *BGP) GetModifiedDate() string {
+	if t == nil || t.ModifiedDate == nil {
+		return ""
+	}
+	return *t.ModifiedDate
+}
+
+// GetPrefix returns the Prefix field if it's non-nil, zero value otherwise.
+func (t *BGP) GetPrefix() string {
+	if t == nil || t.Prefix == nil {
+		return ""
+	}
+	return *t.Prefix
+}
+
+// GetSavedEvent returns the SavedEvent field if it's non-nil, zero value otherwise.
+func (t *BGP) GetSavedEvent() bool {
+	if t == nil || t.SavedEvent == nil {
+		return false
+	}
+	return *t.SavedEvent
+}
+
+// GetSharedWithAccounts returns the SharedWithAccounts field if it's non-nil, zero value otherwise.
+func (t *BGP) GetSharedWithAccounts() []SharedWithAccount {
+	if t == nil || t.SharedWithAccounts == nil {
+		return nil
+	}
+	return *t.SharedWithAccounts
+}
+
+// GetTestID returns the TestID field if it's non-nil, zero value otherwise.
+func (t *BGP) GetTestID() int64 {
+	if t == nil || t.TestID == nil {
+		return 0
+	}
+	return *t.TestID
+}
+
+// GetTestName returns the TestName field if it's non-nil, zero value otherwise.
+func (t *BGP) GetTestName() string {
+	if t == nil || t.TestName == nil {
+		return ""
+	}
+	return *t.TestName
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (t *BGP) GetType() string {
+	if t == nil || t.Type == nil {
+		return ""
+	}
+	return *t.Type
+}
+
+// GetUsePublicBGP returns the UsePublicBGP field if it's non-nil, zero value otherwise.
+func (t *BGP) GetUsePublicBGP() bool {
+	if t == nil || t.UsePublicBGP == nil {
+		return false
+	}
+	return *t.UsePublicBGP
+}
+
+// GetCountryID returns the CountryID field if it's non-nil, zero value otherwise.
+func (b *BGPMonitor) GetCountryID() string {
+	if b == nil || b.CountryID == nil {
+		return ""
+	}
+	return *b.CountryID
+}
+
+// GetIPAddress returns the IPAddress field if it's non-nil, zero value otherwise.
+func (b *BGPMonitor) GetIPAddress() string {
+	if b == nil || b.IPAddress == nil {
+		return ""
+	}
+	return *b.IPAddress
+}
+
+// GetMonitorID returns the MonitorID field if it's non-nil, zero value otherwise.
+func (b *BGPMonitor) GetMonitorID() int {
+	if b == nil || b.MonitorID == nil {
+		return 0
+	}
+	return *b.MonitorID
+}
+
+// GetMonitorName returns the MonitorName field if it's non-nil, zero value otherwise.
+func (b *BGPMonitor) GetMonitorName() string {
+	if b == nil || b.MonitorName == nil {
+		return ""
+	}
+	return *b.MonitorName
+}
+
+// GetMonitorType returns the MonitorType field if it's non-nil, zero value otherwise.
+func (b *BGPMonitor) GetMonitorType() string {
+	if b == nil || b.MonitorType == nil {
+		return ""
+	}
+	return *b.MonitorType
+}
+
+// GetNetwork returns the Network field if it's non-nil, zero value otherwise.
+func (b *BGPMonitor) GetNetwork() string {
+	if b == nil || b.Network == nil {
+		return ""
+	}
+	return *b.Network
+}
+
+// GetAlertsEnabled returns the AlertsEnabled field if it's non-nil, zero value otherwise.
+func (o *CloneOverrides) GetAlertsEnabled() bool {
+	if o == nil || o.AlertsEnabled == nil {
+		return false
+	}
+	return *o.AlertsEnabled
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (o *CloneOverrides) GetDescription() string {
+	if o == nil || o.Description == nil {
+		return ""
+	}
+	return *o.Description
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (o *CloneOverrides) GetEnabled() bool {
+	if o == nil || o.Enabled == nil {
+		return false
+	}
+	return *o.Enabled
+}
+
+// GetInterval returns the Interval field if it's non-nil, zero value otherwise.
+func (o *CloneOverrides) GetInterval() int {
+	if o == nil || o.Interval == nil {
+		return 0
+	}
+	return *o.Interval
+}
+
+// GetTestName returns the TestName field if it's non-nil, zero value otherwise.
+func (o *CloneOverrides) GetTestName() string {
+	if o == nil || o.TestName == nil {
+		return ""
+	}
+	return *o.TestName
+}
+
+// GetAgentState returns the AgentState field if it's non-nil, zero value otherwise.
+func (c *ClusterMember) GetAgentState() string {
+	if c == nil || c.AgentState == nil {
+		return ""
+	}
+	return *c.AgentState
+}
+
+// GetIPAddresses returns the IPAddresses field if it's non-nil, zero value otherwise.
+func (c *ClusterMember) GetIPAddresses() []string {
+	if c == nil || c.IPAddresses == nil {
+		return nil
+	}
+	return *c.IPAddresses
+}
+
+// GetLastSeen returns the LastSeen field if it's non-nil, zero value otherwise.
+func (c *ClusterMember) GetLastSeen() string {
+	if c == nil || c.LastSeen == nil {
+		return ""
+	}
+	return *c.LastSeen
+}
+
+// GetMemberID returns the MemberID field if it's non-nil, zero value otherwise.
+func (c *ClusterMember) GetMemberID() int {
+	if c == nil || c.MemberID == nil {
+		return 0
+	}
+	return *c.MemberID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *ClusterMember) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+	return *c.Name
+}
+
+// GetNetwork returns the Network field if it's non-nil, zero value otherwise.
+func (c *ClusterMember) GetNetwork() string {
+	if c == nil || c.Network == nil {
+		return ""
+	}
+	return *c.Network
+}
+
+// GetPrefix returns the Prefix field if it's non-nil, zero value otherwise.
+func (c *ClusterMember) GetPrefix() string {
+	if c == nil || c.Prefix == nil {
+		return ""
+	}
+	return *c.Prefix
+}
+
+// GetPublicIPAddresses returns the PublicIPAddresses field if it's non-nil, zero value otherwise.
+func (c *ClusterMember) GetPublicIPAddresses() []string {
+	if c == nil || c.PublicIPAddresses == nil {
+		return nil
+	}
+	return *c.PublicIPAddresses
+}
+
+// GetTargetForTests returns the TargetForTests field if it's non-nil, zero value otherwise.
+func (c *ClusterMember) GetTargetForTests() string {
+	if c == nil || c.TargetForTests == nil {
+		return ""
+	}
+	return *c.TargetForTests
+}
+
+// GetUtilization returns the Utilization field if it's non-nil, zero value otherwise.
+func (c *ClusterMember) GetUtilization() int {
+	if c == nil || c.Utilization == nil {
+		return 0
+	}
+	return *c.Utilization
+}
+
+// GetAgent returns the Agent field, or nil if the receiver is nil.
+func (c *ClusterReconcileReport) GetAgent() *Agent {
+	if c == nil {
+		return nil
+	}
+	return c.Agent
+}
+
+// GetCreatedBy returns the CreatedBy field if it's non-nil, zero value otherwise.
+func (c *Credential) GetCreatedBy() string {
+	if c == nil || c.CreatedBy == nil {
+		return ""
+	}
+	return *c.CreatedBy
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (c *Credential) GetCreatedDate() string {
+	if c == nil || c.CreatedDate == nil {
+		return ""
+	}
+	return *c.CreatedDate
+}
+
+// GetCredentialID returns the CredentialID field if it's non-nil, zero value otherwise.
+func (c *Credential) GetCredentialID() int {
+	if c == nil || c.CredentialID == nil {
+		return 0
+	}
+	return *c.CredentialID
+}
+
+// GetModifiedBy returns the ModifiedBy field if it's non-nil, zero value otherwise.
+func (c *Credential) GetModifiedBy() string {
+	if c == nil || c.ModifiedBy == nil {
+		return ""
+	}
+	return *c.ModifiedBy
+}
+
+// GetModifiedDate returns the ModifiedDate field if it's non-nil, zero value otherwise.
+func (c *Credential) GetModifiedDate() string {
+	if c == nil || c.ModifiedDate == nil {
+		return ""
+	}
+	return *c.ModifiedDate
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *Credential) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+	return *c.Name
+}
+
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
+func (c *Credential) GetValue() string {
+	if c == nil || c.Value == nil {
+		return ""
+	}
+	return *c.Value
+}
+
+// GetAPILinks returns the APILinks field if it's non-nil, zero value otherwise.
+func (t *DNSSec) GetAPILinks() []APILink {
+	if t == nil || t.APILinks == nil {
+		return nil
+	}
+	return *t.APILinks
+}
+
+// GetAgents returns the Agents field if it's non-nil, zero value otherwise.
+func (t *DNSSec) GetAgents() []Agent {
+	if t == nil || t.Agents == nil {
+		return nil
+	}
+	return *t.Agents
+}
+
+// GetAlertRules returns the AlertRules field if it's non-nil, zero value otherwise.
+func (t *DNSSec) GetAlertRules() []AlertRule {
+	if t == nil || t.AlertRules == nil {
+		return nil
+	}
+	return *t.AlertRules
+}
+
+// GetAlertsEnabled returns the AlertsEnabled field if it's non-nil, zero value otherwise.
+func (t *DNSSec) GetAlertsEnabled() bool {
+	if t == nil || t.AlertsEnabled == nil {
+		return false
+	}
+	return *t.AlertsEnabled
+}
+
+// GetCreatedBy returns the CreatedBy field if it's non-nil, zero value otherwise.
+func (t *DNSSec) GetCreatedBy() string {
+	if t == nil || t.CreatedBy == nil {
+		return ""
+	}
+	return *t.CreatedBy
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (t *DNSSec) GetCreatedDate() string {
+	if t == nil || t.CreatedDate == nil {
+		return ""
+	}
+	return *t.CreatedDate
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (t *DNSSec) GetDescription() string {
+	if t == nil || t.Description == nil {
+		return ""
+	}
+	return *t.Description
+}
+
+// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
+func (t *DNSSec) GetDomain() string {
+	if t == nil || t.Domain == nil {
+		return ""
+	}
+	return *t.Domain
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (t *DNSSec) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return false
+	}
+	return *t.Enabled
+}
+
+// GetGroups returns the Groups field if it's non-nil, zero value otherwise.
+func (t *DNSSec) GetGroups() []GroupLabel {
+	if t == nil || t.Groups == nil {
+		return nil
+	}
+	return *t.Groups
+}
+
+// GetInterval returns the Interval field if it's non-nil, zero value otherwise.
+func (t *DNSSec) GetInterval() int {
+	if t == nil || t.Interval == nil {
+		return 0
+	}
+	return *t.Interval
+}
+
+// GetLiveShare returns the LiveShare field if it's non-nil, zero value otherwise.
+func (t *DNSSec) GetLiveShare() bool {
+	if t == nil || t.LiveShare == nil {
+		return false
+	}
+	return *t.LiveShare
+}
+
+// GetModifiedBy returns the ModifiedBy field if it's non-nil, zero value otherwise.
+func (t *DNSSec) GetModifiedBy() string {
+	if t == nil || t.ModifiedBy == nil {
+		return ""
+	}
+	return *t.ModifiedBy
+}
+
+// GetModifiedDate returns the ModifiedDate field if it's non-nil, zero value otherwise.
+func (t *DNSSec) GetModifiedDate() string {
+	if t == nil || t.ModifiedDate == nil {
+		return ""
+	}
+	return *t.ModifiedDate
+}
+
+// GetSavedEvent returns the SavedEvent field if it's non-nil, zero value otherwise.
+func (t *DNSSec) GetSavedEvent() bool {
+	if t == nil || t.SavedEvent == nil {
+		return false
+	}
+	return *t.SavedEvent
+}
+
+// GetSharedWithAccounts returns the SharedWithAccounts field if it's non-nil, zero value otherwise.
+func (t *DNSSec) GetSharedWithAccounts() []SharedWithAccount {
+	if t == nil || t.SharedWithAccounts == nil {
+		return nil
+	}
+	return *t.SharedWithAccounts
+}
+
+// GetTestID returns the TestID field if it's non-nil, zero value otherwise.
+func (t *DNSSec) GetTestID() int64 {
+	if t == nil || t.TestID == nil {
+		return 0
+	}
+	return *t.TestID
+}
+
+// GetTestName returns the TestName field if it's non-nil, zero value otherwise.
+func (t *DNSSec) GetTestName() string {
+	if t == nil || t.TestName == nil {
+		return ""
+	}
+	return *t.TestName
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (t *DNSSec) GetType() string {
+	if t == nil || t.Type == nil {
+		return ""
+	}
+	return *t.Type
+}
+
+// GetAPILinks returns the APILinks field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetAPILinks() []APILink {
+	if t == nil || t.APILinks == nil {
+		return nil
+	}
+	return *t.APILinks
+}
+
+// GetAgents returns the Agents field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetAgents() []Agent {
+	if t == nil || t.Agents == nil {
+		return nil
+	}
+	return *t.Agents
+}
+
+// GetAlertRules returns the AlertRules field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetAlertRules() []AlertRule {
+	if t == nil || t.AlertRules == nil {
+		return nil
+	}
+	return *t.AlertRules
+}
+
+// GetAlertsEnabled returns the AlertsEnabled field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetAlertsEnabled() bool {
+	if t == nil || t.AlertsEnabled == nil {
+		return false
+	}
+	return *t.AlertsEnabled
+}
+
+// GetBGPMeasurements returns the BGPMeasurements field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetBGPMeasurements() bool {
+	if t == nil || t.BGPMeasurements == nil {
+		return false
+	}
+	return *t.BGPMeasurements
+}
+
+// GetBGPMonitors returns the BGPMonitors field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetBGPMonitors() []BGPMonitor {
+	if t == nil || t.BGPMonitors == nil {
+		return nil
+	}
+	return *t.BGPMonitors
+}
+
+// GetBandwidthMeasurements returns the BandwidthMeasurements field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetBandwidthMeasurements() bool {
+	if t == nil || t.BandwidthMeasurements == nil {
+		return false
+	}
+	return *t.BandwidthMeasurements
+}
+
+// GetCreatedBy returns the CreatedBy field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetCreatedBy() string {
+	if t == nil || t.CreatedBy == nil {
+		return ""
+	}
+	return *t.CreatedBy
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetCreatedDate() string {
+	if t == nil || t.CreatedDate == nil {
+		return ""
+	}
+	return *t.CreatedDate
+}
+
+// GetDNSServers returns the DNSServers field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetDNSServers() []Server {
+	if t == nil || t.DNSServers == nil {
+		return nil
+	}
+	return *t.DNSServers
+}
+
+// GetDNSTransportProtocol returns the DNSTransportProtocol field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetDNSTransportProtocol() DNSTransportProtocol {
+	if t == nil || t.DNSTransportProtocol == nil {
+		return ""
+	}
+	return *t.DNSTransportProtocol
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetDescription() string {
+	if t == nil || t.Description == nil {
+		return ""
+	}
+	return *t.Description
+}
+
+// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetDomain() string {
+	if t == nil || t.Domain == nil {
+		return ""
+	}
+	return *t.Domain
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return false
+	}
+	return *t.Enabled
+}
+
+// GetGroups returns the Groups field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetGroups() []GroupLabel {
+	if t == nil || t.Groups == nil {
+		return nil
+	}
+	return *t.Groups
+}
+
+// GetInterval returns the Interval field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetInterval() int {
+	if t == nil || t.Interval == nil {
+		return 0
+	}
+	return *t.Interval
+}
+
+// GetLiveShare returns the LiveShare field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetLiveShare() bool {
+	if t == nil || t.LiveShare == nil {
+		return false
+	}
+	return *t.LiveShare
+}
+
+// GetMTUMeasurements returns the MTUMeasurements field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetMTUMeasurements() bool {
+	if t == nil || t.MTUMeasurements == nil {
+		return false
+	}
+	return *t.MTUMeasurements
+}
+
+// GetModifiedBy returns the ModifiedBy field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetModifiedBy() string {
+	if t == nil || t.ModifiedBy == nil {
+		return ""
+	}
+	return *t.ModifiedBy
+}
+
+// GetModifiedDate returns the ModifiedDate field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetModifiedDate() string {
+	if t == nil || t.ModifiedDate == nil {
+		return ""
+	}
+	return *t.ModifiedDate
+}
+
+// GetNetworkMeasurements returns the NetworkMeasurements field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetNetworkMeasurements() bool {
+	if t == nil || t.NetworkMeasurements == nil {
+		return false
+	}
+	return *t.NetworkMeasurements
+}
+
+// GetNumPathTraces returns the NumPathTraces field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetNumPathTraces() int {
+	if t == nil || t.NumPathTraces == nil {
+		return 0
+	}
+	return *t.NumPathTraces
+}
+
+// GetPathTraceMode returns the PathTraceMode field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetPathTraceMode() PathTraceMode {
+	if t == nil || t.PathTraceMode == nil {
+		return ""
+	}
+	return *t.PathTraceMode
+}
+
+// GetProbeMode returns the ProbeMode field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetProbeMode() ProbeMode {
+	if t == nil || t.ProbeMode == nil {
+		return ""
+	}
+	return *t.ProbeMode
+}
+
+// GetProtocol returns the Protocol field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetProtocol() Protocol {
+	if t == nil || t.Protocol == nil {
+		return ""
+	}
+	return *t.Protocol
+}
+
+// GetRecursiveQueries returns the RecursiveQueries field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetRecursiveQueries() bool {
+	if t == nil || t.RecursiveQueries == nil {
+		return false
+	}
+	return *t.RecursiveQueries
+}
+
+// GetSavedEvent returns the SavedEvent field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetSavedEvent() bool {
+	if t == nil || t.SavedEvent == nil {
+		return false
+	}
+	return *t.SavedEvent
+}
+
+// GetSharedWithAccounts returns the SharedWithAccounts field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetSharedWithAccounts() []SharedWithAccount {
+	if t == nil || t.SharedWithAccounts == nil {
+		return nil
+	}
+	return *t.SharedWithAccounts
+}
+
+// GetTestID returns the TestID field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetTestID() int64 {
+	if t == nil || t.TestID == nil {
+		return 0
+	}
+	return *t.TestID
+}
+
+// GetTestName returns the TestName field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetTestName() string {
+	if t == nil || t.TestName == nil {
+		return ""
+	}
+	return *t.TestName
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetType() string {
+	if t == nil || t.Type == nil {
+		return ""
+	}
+	return *t.Type
+}
+
+// GetUsePublicBGP returns the UsePublicBGP field if it's non-nil, zero value otherwise.
+func (t *DNSServer) GetUsePublicBGP() bool {
+	if t == nil || t.UsePublicBGP == nil {
+		return false
+	}
+	return *t.UsePublicBGP
+}
+
+// GetAPILinks returns the APILinks field if it's non-nil, zero value otherwise.
+func (t *DNSTrace) GetAPILinks() []APILink {
+	if t == nil || t.APILinks == nil {
+		return nil
+	}
+	return *t.APILinks
+}
+
+// GetAgents returns the Agents field if it's non-nil, zero value otherwise.
+func (t *DNSTrace) GetAgents() []Agent {
+	if t == nil || t.Agents == nil {
+		return nil
+	}
+	return *t.Agents
+}
+
+// GetAlertRules returns the AlertRules field if it's non-nil, zero value otherwise.
+func (t *DNSTrace) GetAlertRules() []AlertRule {
+	if t == nil || t.AlertRules == nil {
+		return nil
+	}
+	return *t.AlertRules
+}
+
+// GetAlertsEnabled returns the AlertsEnabled field if it's non-nil, zero value otherwise.
+func (t *DNSTrace) GetAlertsEnabled() bool {
+	if t == nil || t.AlertsEnabled == nil {
+		return false
+	}
+	return *t.AlertsEnabled
+}
+
+// GetCreatedBy returns the CreatedBy field if it's non-nil, zero value otherwise.
+func (t *DNSTrace) GetCreatedBy() string {
+	if t == nil || t.CreatedBy == nil {
+		return ""
+	}
+	return *t.CreatedBy
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (t *DNSTrace) GetCreatedDate() string {
+	if t == nil || t.CreatedDate == nil {
+		return ""
+	}
+	return *t.CreatedDate
+}
+
+// GetDNSTransportProtocol returns the DNSTransportProtocol field if it's non-nil, zero value otherwise.
+func (t *DNSTrace) GetDNSTransportProtocol() DNSTransportProtocol {
+	if t == nil || t.DNSTransportProtocol == nil {
+		return ""
+	}
+	return *t.DNSTransportProtocol
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (t *DNSTrace) GetDescription() string {
+	if t == nil || t.Description == nil {
+		return ""
+	}
+	return *t.Description
+}
+
+// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
+func (t *DNSTrace) GetDomain() string {
+	if t == nil || t.Domain == nil {
+		return ""
+	}
+	return *t.Domain
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (t *DNSTrace) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return false
+	}
+	return *t.Enabled
+}
+
+// GetGroups returns the Groups field if it's non-nil, zero value otherwise.
+func (t *DNSTrace) GetGroups() []GroupLabel {
+	if t == nil || t.Groups == nil {
+		return nil
+	}
+	return *t.Groups
+}
+
+// GetInterval returns the Interval field if it's non-nil, zero value otherwise.
+func (t *DNSTrace) GetInterval() int {
+	if t == nil || t.Interval == nil {
+		return 0
+	}
+	return *t.Interval
+}
+
+// GetLiveShare returns the LiveShare field if it's non-nil, zero value otherwise.
+func (t *DNSTrace) GetLiveShare() bool {
+	if t == nil || t.LiveShare == nil {
+		return false
+	}
+	return *t.LiveShare
+}
+
+// GetModifiedBy returns the ModifiedBy field if it's non-nil, zero value otherwise.
+func (t *DNSTrace) GetModifiedBy() string {
+	if t == nil || t.ModifiedBy == nil {
+		return ""
+	}
+	return *t.ModifiedBy
+}
+
+// GetModifiedDate returns the ModifiedDate field if it's non-nil, zero value otherwise.
+func (t *DNSTrace) GetModifiedDate() string {
+	if t == nil || t.ModifiedDate == nil {
+		return ""
+	}
+	return *t.ModifiedDate
+}
+
+// GetSavedEvent returns the SavedEvent field if it's non-nil, zero value otherwise.
+func (t *DNSTrace) GetSavedEvent() bool {
+	if t == nil || t.SavedEvent == nil {
+		return false
+	}
+	return *t.SavedEvent
+}
+
+// GetSharedWithAccounts returns the SharedWithAccounts field if it's non-nil, zero value otherwise.
+func (t *DNSTrace) GetSharedWithAccounts() []SharedWithAccount {
+	if t == nil || t.SharedWithAccounts == nil {
+		return nil
+	}
+	return *t.SharedWithAccounts
+}
+
+// GetTestID returns the TestID field if it's non-nil, zero value otherwise.
+func (t *DNSTrace) GetTestID() int64 {
+	if t == nil || t.TestID == nil {
+		return 0
+	}
+	return *t.TestID
+}
+
+// GetTestName returns the TestName field if it's non-nil, zero value otherwise.
+func (t *DNSTrace) GetTestName() string {
+	if t == nil || t.TestName == nil {
+		return ""
+	}
+	return *t.TestName
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (t *DNSTrace) GetType() string {
+	if t == nil || t.Type == nil {
+		return ""
+	}
+	return *t.Type
+}
+
+// GetAPILinks returns the APILinks field if it's non-nil, zero value otherwise.
+func (d *Dashboard) GetAPILinks() []APILink {
+	if d == nil || d.APILinks == nil {
+		return nil
+	}
+	return *d.APILinks
+}
+
+// GetCreatedBy returns the CreatedBy field if it's non-nil, zero value otherwise.
+func (d *Dashboard) GetCreatedBy() string {
+	if d == nil || d.CreatedBy == nil {
+		return ""
+	}
+	return *d.CreatedBy
+}
+
+// GetDashboardID returns the DashboardID field if it's non-nil, zero value otherwise.
+func (d *Dashboard) GetDashboardID() string {
+	if d == nil || d.DashboardID == nil {
+		return ""
+	}
+	return *d.DashboardID
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (d *Dashboard) GetDescription() string {
+	if d == nil || d.Description == nil {
+		return ""
+	}
+	return *d.Description
+}
+
+// GetIsBuiltIn returns the IsBuiltIn field if it's non-nil, zero value otherwise.
+func (d *Dashboard) GetIsBuiltIn() bool {
+	if d == nil || d.IsBuiltIn == nil {
+		return false
+	}
+	return *d.IsBuiltIn
+}
+
+// GetIsGlobalOverride returns the IsGlobalOverride field if it's non-nil, zero value otherwise.
+func (d *Dashboard) GetIsGlobalOverride() bool {
+	if d == nil || d.IsGlobalOverride == nil {
+		return false
+	}
+	return *d.IsGlobalOverride
+}
+
+// GetIsPrivate returns the IsPrivate field if it's non-nil, zero value otherwise.
+func (d *Dashboard) GetIsPrivate() bool {
+	if d == nil || d.IsPrivate == nil {
+		return false
+	}
+	return *d.IsPrivate
+}
+
+// GetModifiedBy returns the ModifiedBy field if it's non-nil, zero value otherwise.
+func (d *Dashboard) GetModifiedBy() string {
+	if d == nil || d.ModifiedBy == nil {
+		return ""
+	}
+	return *d.ModifiedBy
+}
+
+// GetModifiedDate returns the ModifiedDate field if it's non-nil, zero value otherwise.
+func (d *Dashboard) GetModifiedDate() string {
+	if d == nil || d.ModifiedDate == nil {
+		return ""
+	}
+	return *d.ModifiedDate
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (d *Dashboard) GetTitle() string {
+	if d == nil || d.Title == nil {
+		return ""
+	}
+	return *d.Title
+}
+
+// GetWidgets returns the Widgets field if it's non-nil, zero value otherwise.
+func (d *Dashboard) GetWidgets() []DashboardWidget {
+	if d == nil || d.Widgets == nil {
+		return nil
+	}
+	return *d.Widgets
+}
+
+// GetDataSource returns the DataSource field if it's non-nil, zero value otherwise.
+func (d *DashboardWidget) GetDataSource() string {
+	if d == nil || d.DataSource == nil {
+		return ""
+	}
+	return *d.DataSource
+}
+
+// GetDirection returns the Direction field if it's non-nil, zero value otherwise.
+func (d *DashboardWidget) GetDirection() string {
+	if d == nil || d.Direction == nil {
+		return ""
+	}
+	return *d.Direction
+}
+
+// GetFilters returns the Filters field, or nil if the receiver is nil.
+func (d *DashboardWidget) GetFilters() *DashboardWidgetFilters {
+	if d == nil {
+		return nil
+	}
+	return d.Filters
+}
+
+// GetFixedTimespan returns the FixedTimespan field, or nil if the receiver is nil.
+func (d *DashboardWidget) GetFixedTimespan() *DashboardWidgetTimespan {
+	if d == nil {
+		return nil
+	}
+	return d.FixedTimespan
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (d *DashboardWidget) GetID() string {
+	if d == nil || d.ID == nil {
+		return ""
+	}
+	return *d.ID
+}
+
+// GetMeasure returns the Measure field, or nil if the receiver is nil.
+func (d *DashboardWidget) GetMeasure() *DashboardWidgetMeasure {
+	if d == nil {
+		return nil
+	}
+	return d.Measure
+}
+
+// GetMetric returns the Metric field if it's non-nil, zero value otherwise.
+func (d *DashboardWidget) GetMetric() string {
+	if d == nil || d.Metric == nil {
+		return ""
+	}
+	return *d.Metric
+}
+
+// GetMetricGroup returns the MetricGroup field if it's non-nil, zero value otherwise.
+func (d *DashboardWidget) GetMetricGroup() string {
+	if d == nil || d.MetricGroup == nil {
+		return ""
+	}
+	return *d.MetricGroup
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (d *DashboardWidget) GetTitle() string {
+	if d == nil || d.Title == nil {
+		return ""
+	}
+	return *d.Title
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (d *DashboardWidget) GetType() DashboardWidgetType {
+	if d == nil || d.Type == nil {
+		return ""
+	}
+	return *d.Type
+}
+
+// GetVisual returns the Visual field if it's non-nil, zero value otherwise.
+func (d *DashboardWidget) GetVisual() string {
+	if d == nil || d.Visual == nil {
+		return ""
+	}
+	return *d.Visual
+}
+
+// GetAgents returns the Agents field if it's non-nil, zero value otherwise.
+func (d *DashboardWidgetFilters) GetAgents() []int {
+	if d == nil || d.Agents == nil {
+		return nil
+	}
+	return *d.Agents
+}
+
+// GetLabels returns the Labels field if it's non-nil, zero value otherwise.
+func (d *DashboardWidgetFilters) GetLabels() []int64 {
+	if d == nil || d.Labels == nil {
+		return nil
+	}
+	return *d.Labels
+}
+
+// GetTests returns the Tests field if it's non-nil, zero value otherwise.
+func (d *DashboardWidgetFilters) GetTests() []int64 {
+	if d == nil || d.Tests == nil {
+		return nil
+	}
+	return *d.Tests
+}
+
+// GetPercentile returns the Percentile field if it's non-nil, zero value otherwise.
+func (d *DashboardWidgetMeasure) GetPercentile() int {
+	if d == nil || d.Percentile == nil {
+		return 0
+	}
+	return *d.Percentile
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (d *DashboardWidgetMeasure) GetType() string {
+	if d == nil || d.Type == nil {
+		return ""
+	}
+	return *d.Type
+}
+
+// GetUnit returns the Unit field if it's non-nil, zero value otherwise.
+func (d *DashboardWidgetTimespan) GetUnit() string {
+	if d == nil || d.Unit == nil {
+		return ""
+	}
+	return *d.Unit
+}
+
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
+func (d *DashboardWidgetTimespan) GetValue() int {
+	if d == nil || d.Value == nil {
+		return 0
+	}
+	return *d.Value
+}
+
+// GetAgentID returns the AgentID field if it's non-nil, zero value otherwise.
+func (a *EndpointAgent) GetAgentID() string {
+	if a == nil || a.AgentID == nil {
+		return ""
+	}
+	return *a.AgentID
+}
+
+// GetAgentName returns the AgentName field if it's non-nil, zero value otherwise.
+func (a *EndpointAgent) GetAgentName() string {
+	if a == nil || a.AgentName == nil {
+		return ""
+	}
+	return *a.AgentName
+}
+
+// GetAgentType returns the AgentType field if it's non-nil, zero value otherwise.
+func (a *EndpointAgent) GetAgentType() string {
+	if a == nil || a.AgentType == nil {
+		return ""
+	}
+	return *a.AgentType
+}
+
+// GetComputerName returns the ComputerName field if it's non-nil, zero value otherwise.
+func (a *EndpointAgent) GetComputerName() string {
+	if a == nil || a.ComputerName == nil {
+		return ""
+	}
+	return *a.ComputerName
+}
+
+// GetCreated returns the Created field if it's non-nil, zero value otherwise.
+func (a *EndpointAgent) GetCreated() string {
+	if a == nil || a.Created == nil {
+		return ""
+	}
+	return *a.Created
+}
+
+// GetKernelVersion returns the KernelVersion field if it's non-nil, zero value otherwise.
+func (a *EndpointAgent) GetKernelVersion() string {
+	if a == nil || a.KernelVersion == nil {
+		return ""
+	}
+	return *a.KernelVersion
+}
+
+// GetLastSeen returns the LastSeen field if it's non-nil, zero value otherwise.
+func (a *EndpointAgent) GetLastSeen() string {
+	if a == nil || a.LastSeen == nil {
+		return ""
+	}
+	return *a.LastSeen
+}
+
+// GetLocation returns the Location field, or nil if the receiver is nil.
+func (a *EndpointAgent) GetLocation() *EndpointAgentLocation {
+	if a == nil {
+		return nil
+	}
+	return a.Location
+}
+
+// GetManufacturer returns the Manufacturer field if it's non-nil, zero value otherwise.
+func (a *EndpointAgent) GetManufacturer() string {
+	if a == nil || a.Manufacturer == nil {
+		return ""
+	}
+	return *a.Manufacturer
+}
+
+// GetModel returns the Model field if it's non-nil, zero value otherwise.
+func (a *EndpointAgent) GetModel() string {
+	if a == nil || a.Model == nil {
+		return ""
+	}
+	return *a.Model
+}
+
+// GetNetworkProfiles returns the NetworkProfiles field if it's non-nil, zero value otherwise.
+func (a *EndpointAgent) GetNetworkProfiles() []EndpointNetworkProfile {
+	if a == nil || a.NetworkProfiles == nil {
+		return nil
+	}
+	return *a.NetworkProfiles
+}
+
+// GetOSVersion returns the OSVersion field if it's non-nil, zero value otherwise.
+func (a *EndpointAgent) GetOSVersion() string {
+	if a == nil || a.OSVersion == nil {
+		return ""
+	}
+	return *a.OSVersion
+}
+
+// GetPlatform returns the Platform field if it's non-nil, zero value otherwise.
+func (a *EndpointAgent) GetPlatform() string {
+	if a == nil || a.Platform == nil {
+		return ""
+	}
+	return *a.Platform
+}
+
+// GetPublicIP returns the PublicIP field if it's non-nil, zero value otherwise.
+func (a *EndpointAgent) GetPublicIP() string {
+	if a == nil || a.PublicIP == nil {
+		return ""
+	}
+	return *a.PublicIP
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (a *EndpointAgent) GetStatus() string {
+	if a == nil || a.Status == nil {
+		return ""
+	}
+	return *a.Status
+}
+
+// GetVPNProfiles returns the VPNProfiles field if it's non-nil, zero value otherwise.
+func (a *EndpointAgent) GetVPNProfiles() []EndpointVPNProfile {
+	if a == nil || a.VPNProfiles == nil {
+		return nil
+	}
+	return *a.VPNProfiles
+}
+
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (a *EndpointAgent) GetVersion() string {
+	if a == nil || a.Version == nil {
+		return ""
+	}
+	return *a.Version
+}
+
+// GetLatitude returns the Latitude field if it's non-nil, zero value otherwise.
+func (e *EndpointAgentLocation) GetLatitude() float64 {
+	if e == nil || e.Latitude == nil {
+		return 0
+	}
+	return *e.Latitude
+}
+
+// GetLocationName returns the LocationName field if it's non-nil, zero value otherwise.
+func (e *EndpointAgentLocation) GetLocationName() string {
+	if e == nil || e.LocationName == nil {
+		return ""
+	}
+	return *e.LocationName
+}
+
+// GetLongitude returns the Longitude field if it's non-nil, zero value otherwise.
+func (e *EndpointAgentLocation) GetLongitude() float64 {
+	if e == nil || e.Longitude == nil {
+		return 0
+	}
+	return *e.Longitude
+}
+
+// GetAgentSelectorType returns the AgentSelectorType field if it's non-nil, zero value otherwise.
+func (e *EndpointAgentSelector) GetAgentSelectorType() string {
+	if e == nil || e.AgentSelectorType == nil {
+		return ""
+	}
+	return *e.AgentSelectorType
+}
+
+// GetEndpointAgentLabels returns the EndpointAgentLabels field if it's non-nil, zero value otherwise.
+func (e *EndpointAgentSelector) GetEndpointAgentLabels() []int {
+	if e == nil || e.EndpointAgentLabels == nil {
+		return nil
+	}
+	return *e.EndpointAgentLabels
+}
+
+// GetEndpointAgents returns the EndpointAgents field if it's non-nil, zero value otherwise.
+func (e *EndpointAgentSelector) GetEndpointAgents() []string {
+	if e == nil || e.EndpointAgents == nil {
+		return nil
+	}
+	return *e.EndpointAgents
+}
+
+// GetMaxMachines returns the MaxMachines field if it's non-nil, zero value otherwise.
+func (e *EndpointAgentSelector) GetMaxMachines() int {
+	if e == nil || e.MaxMachines == nil {
+		return 0
+	}
+	return *e.MaxMachines
+}
+
+// GetAPILinks returns the APILinks field if it's non-nil, zero value otherwise.
+func (t *EndpointAgentServer) GetAPILinks() []APILink {
+	if t == nil || t.APILinks == nil {
+		return nil
+	}
+	return *t.APILinks
+}
+
+// GetAgentSelectorConfig returns the AgentSelectorConfig field, or nil if the receiver is nil.
+func (t *EndpointAgentServer) GetAgentSelectorConfig() *EndpointAgentSelector {
+	if t == nil {
+		return nil
+	}
+	return t.AgentSelectorConfig
+}
+
+// GetAlertRules returns the AlertRules field if it's non-nil, zero value otherwise.
+func (t *EndpointAgentServer) GetAlertRules() []AlertRule {
+	if t == nil || t.AlertRules == nil {
+		return nil
+	}
+	return *t.AlertRules
+}
+
+// GetAlertsEnabled returns the AlertsEnabled field if it's non-nil, zero value otherwise.
+func (t *EndpointAgentServer) GetAlertsEnabled() bool {
+	if t == nil || t.AlertsEnabled == nil {
+		return false
+	}
+	return *t.AlertsEnabled
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (t *EndpointAgentServer) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return false
+	}
+	return *t.Enabled
+}
+
+// GetInterval returns the Interval field if it's non-nil, zero value otherwise.
+func (t *EndpointAgentServer) GetInterval() int {
+	if t == nil || t.Interval == nil {
+		return 0
+	}
+	return *t.Interval
+}
+
+// GetPort returns the Port field if it's non-nil, zero value otherwise.
+func (t *EndpointAgentServer) GetPort() int {
+	if t == nil || t.Port == nil {
+		return 0
+	}
+	return *t.Port
+}
+
+// GetProbeMode returns the ProbeMode field if it's non-nil, zero value otherwise.
+func (t *EndpointAgentServer) GetProbeMode() ProbeMode {
+	if t == nil || t.ProbeMode == nil {
+		return ""
+	}
+	return *t.ProbeMode
+}
+
+// GetProtocol returns the Protocol field if it's non-nil, zero value otherwise.
+func (t *EndpointAgentServer) GetProtocol() Protocol {
+	if t == nil || t.Protocol == nil {
+		return ""
+	}
+	return *t.Protocol
+}
+
+// GetServer returns the Server field if it's non-nil, zero value otherwise.
+func (t *EndpointAgentServer) GetServer() string {
+	if t == nil || t.Server == nil {
+		return ""
+	}
+	return *t.Server
+}
+
+// GetTCPProbeMode returns the TCPProbeMode field if it's non-nil, zero value otherwise.
+func (t *EndpointAgentServer) GetTCPProbeMode() string {
+	if t == nil || t.TCPProbeMode == nil {
+		return ""
+	}
+	return *t.TCPProbeMode
+}
+
+// GetTestID returns the TestID field if it's non-nil, zero value otherwise.
+func (t *EndpointAgentServer) GetTestID() int64 {
+	if t == nil || t.TestID == nil {
+		return 0
+	}
+	return *t.TestID
+}
+
+// GetTestName returns the TestName field if it's non-nil, zero value otherwise.
+func (t *EndpointAgentServer) GetTestName() string {
+	if t == nil || t.TestName == nil {
+		return ""
+	}
+	return *t.TestName
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (t *EndpointAgentServer) GetType() string {
+	if t == nil || t.Type == nil {
+		return ""
+	}
+	return *t.Type
+}
+
+// GetAID returns the AID field if it's non-nil, zero value otherwise.
+func (e *EndpointAgentUsage) GetAID() int {
+	if e == nil || e.AID == nil {
+		return 0
+	}
+	return *e.AID
+}
+
+// GetAccountGroupName returns the AccountGroupName field if it's non-nil, zero value otherwise.
+func (e *EndpointAgentUsage) GetAccountGroupName() string {
+	if e == nil || e.AccountGroupName == nil {
+		return ""
+	}
+	return *e.AccountGroupName
+}
+
+// GetEndpointAgentsUsed returns the EndpointAgentsUsed field if it's non-nil, zero value otherwise.
+func (e *EndpointAgentUsage) GetEndpointAgentsUsed() int {
+	if e == nil || e.EndpointAgentsUsed == nil {
+		return 0
+	}
+	return *e.EndpointAgentsUsed
+}
+
+// GetAPILinks returns the APILinks field if it's non-nil, zero value otherwise.
+func (t *EndpointHTTPServer) GetAPILinks() []APILink {
+	if t == nil || t.APILinks == nil {
+		return nil
+	}
+	return *t.APILinks
+}
+
+// GetAgentSelectorConfig returns the AgentSelectorConfig field, or nil if the receiver is nil.
+func (t *EndpointHTTPServer) GetAgentSelectorConfig() *EndpointAgentSelector {
+	if t == nil {
+		return nil
+	}
+	return t.AgentSelectorConfig
+}
+
+// GetAlertRules returns the AlertRules field if it's non-nil, zero value otherwise.
+func (t *EndpointHTTPServer) GetAlertRules() []AlertRule {
+	if t == nil || t.AlertRules == nil {
+		return nil
+	}
+	return *t.AlertRules
+}
+
+// GetAlertsEnabled returns the AlertsEnabled field if it's non-nil, zero value otherwise.
+func (t *EndpointHTTPServer) GetAlertsEnabled() bool {
+	if t == nil || t.AlertsEnabled == nil {
+		return false
+	}
+	return *t.AlertsEnabled
+}
+
+// GetAuthType returns the AuthType field if it's non-nil, zero value otherwise.
+func (t *EndpointHTTPServer) GetAuthType() AuthType {
+	if t == nil || t.AuthType == nil {
+		return ""
+	}
+	return *t.AuthType
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (t *EndpointHTTPServer) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return false
+	}
+	return *t.Enabled
+}
+
+// GetHTTPTimeLimit returns the HTTPTimeLimit field if it's non-nil, zero value otherwise.
+func (t *EndpointHTTPServer) GetHTTPTimeLimit() int {
+	if t == nil || t.HTTPTimeLimit == nil {
+		return 0
+	}
+	return *t.HTTPTimeLimit
+}
+
+// GetInterval returns the Interval field if it's non-nil, zero value otherwise.
+func (t *EndpointHTTPServer) GetInterval() int {
+	if t == nil || t.Interval == nil {
+		return 0
+	}
+	return *t.Interval
+}
+
+// GetPassword returns the Password field if it's non-nil, zero value otherwise.
+func (t *EndpointHTTPServer) GetPassword() string {
+	if t == nil || t.Password == nil {
+		return ""
+	}
+	return *t.Password
+}
+
+// GetProbeMode returns the ProbeMode field if it's non-nil, zero value otherwise.
+func (t *EndpointHTTPServer) GetProbeMode() ProbeMode {
+	if t == nil || t.ProbeMode == nil {
+		return ""
+	}
+	return *t.ProbeMode
+}
+
+// GetProtocol returns the Protocol field if it's non-nil, zero value otherwise.
+func (t *EndpointHTTPServer) GetProtocol() Protocol {
+	if t == nil || t.Protocol == nil {
+		return ""
+	}
+	return *t.Protocol
+}
+
+// GetSSLVersionID returns the SSLVersionID field if it's non-nil, zero value otherwise.
+func (t *EndpointHTTPServer) GetSSLVersionID() int {
+	if t == nil || t.SSLVersionID == nil {
+		return 0
+	}
+	return *t.SSLVersionID
+}
+
+// GetTCPProbeMode returns the TCPProbeMode field if it's non-nil, zero value otherwise.
+func (t *EndpointHTTPServer) GetTCPProbeMode() string {
+	if t == nil || t.TCPProbeMode == nil {
+		return ""
+	}
+	return *t.TCPProbeMode
+}
+
+// GetTestID returns the TestID field if it's non-nil, zero value otherwise.
+func (t *EndpointHTTPServer) GetTestID() int64 {
+	if t == nil || t.TestID == nil {
+		return 0
+	}
+	return *t.TestID
+}
+
+// GetTestName returns the TestName field if it's non-nil, zero value otherwise.
+func (t *EndpointHTTPServer) GetTestName() string {
+	if t == nil || t.TestName == nil {
+		return ""
+	}
+	return *t.TestName
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (t *EndpointHTTPServer) GetType() string {
+	if t == nil || t.Type == nil {
+		return ""
+	}
+	return *t.Type
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (t *EndpointHTTPServer) GetURL() string {
+	if t == nil || t.URL == nil {
+		return ""
+	}
+	return *t.URL
+}
+
+// GetUsername returns the Username field if it's non-nil, zero value otherwise.
+func (t *EndpointHTTPServer) GetUsername() string {
+	if t == nil || t.Username == nil {
+		return ""
+	}
+	return *t.Username
+}
+
+// GetVerifyCertificate returns the VerifyCertificate field if it's non-nil, zero value otherwise.
+func (t *EndpointHTTPServer) GetVerifyCertificate() bool {
+	if t == nil || t.VerifyCertificate == nil {
+		return false
+	}
+	return *t.VerifyCertificate
+}
+
+// GetDNSServers returns the DNSServers field if it's non-nil, zero value otherwise.
+func (e *EndpointNetworkProfile) GetDNSServers() []string {
+	if e == nil || e.DNSServers == nil {
+		return nil
+	}
+	return *e.DNSServers
+}
+
+// GetGateway returns the Gateway field if it's non-nil, zero value otherwise.
+func (e *EndpointNetworkProfile) GetGateway() string {
+	if e == nil || e.Gateway == nil {
+		return ""
+	}
+	return *e.Gateway
+}
+
+// GetHardwareType returns the HardwareType field if it's non-nil, zero value otherwise.
+func (e *EndpointNetworkProfile) GetHardwareType() string {
+	if e == nil || e.HardwareType == nil {
+		return ""
+	}
+	return *e.HardwareType
+}
+
+// GetIPAddresses returns the IPAddresses field if it's non-nil, zero value otherwise.
+func (e *EndpointNetworkProfile) GetIPAddresses() []string {
+	if e == nil || e.IPAddresses == nil {
+		return nil
+	}
+	return *e.IPAddresses
+}
+
+// GetIPv6Addresses returns the IPv6Addresses field if it's non-nil, zero value otherwise.
+func (e *EndpointNetworkProfile) GetIPv6Addresses() []string {
+	if e == nil || e.IPv6Addresses == nil {
+		return nil
+	}
+	return *e.IPv6Addresses
+}
+
+// GetInterfaceName returns the InterfaceName field if it's non-nil, zero value otherwise.
+func (e *EndpointNetworkProfile) GetInterfaceName() string {
+	if e == nil || e.InterfaceName == nil {
+		return ""
+	}
+	return *e.InterfaceName
+}
+
+// GetInterfaceType returns the InterfaceType field if it's non-nil, zero value otherwise.
+func (e *EndpointNetworkProfile) GetInterfaceType() string {
+	if e == nil || e.InterfaceType == nil {
+		return ""
+	}
+	return *e.InterfaceType
+}
+
+// GetProxyConfigured returns the ProxyConfigured field if it's non-nil, zero value otherwise.
+func (e *EndpointNetworkProfile) GetProxyConfigured() bool {
+	if e == nil || e.ProxyConfigured == nil {
+		return false
+	}
+	return *e.ProxyConfigured
+}
+
+// GetInterfaceName returns the InterfaceName field if it's non-nil, zero value otherwise.
+func (e *EndpointVPNProfile) GetInterfaceName() string {
+	if e == nil || e.InterfaceName == nil {
+		return ""
+	}
+	return *e.InterfaceName
+}
+
+// GetVPNClientAddresses returns the VPNClientAddresses field if it's non-nil, zero value otherwise.
+func (e *EndpointVPNProfile) GetVPNClientAddresses() []string {
+	if e == nil || e.VPNClientAddresses == nil {
+		return nil
+	}
+	return *e.VPNClientAddresses
+}
+
+// GetVPNClientNetworkRange returns the VPNClientNetworkRange field if it's non-nil, zero value otherwise.
+func (e *EndpointVPNProfile) GetVPNClientNetworkRange() []string {
+	if e == nil || e.VPNClientNetworkRange == nil {
+		return nil
+	}
+	return *e.VPNClientNetworkRange
+}
+
+// GetVPNGatewayAddress returns the VPNGatewayAddress field if it's non-nil, zero value otherwise.
+func (e *EndpointVPNProfile) GetVPNGatewayAddress() string {
+	if e == nil || e.VPNGatewayAddress == nil {
+		return ""
+	}
+	return *e.VPNGatewayAddress
+}
+
+// GetVPNType returns the VPNType field if it's non-nil, zero value otherwise.
+func (e *EndpointVPNProfile) GetVPNType() string {
+	if e == nil || e.VPNType == nil {
+		return ""
+	}
+	return *e.VPNType
+}
+
+// GetAID returns the AID field if it's non-nil, zero value otherwise.
+func (e *EnterpriseAgentUsage) GetAID() int {
+	if e == nil || e.AID == nil {
+		return 0
+	}
+	return *e.AID
+}
+
+// GetAccountGroupName returns the AccountGroupName field if it's non-nil, zero value otherwise.
+func (e *EnterpriseAgentUsage) GetAccountGroupName() string {
+	if e == nil || e.AccountGroupName == nil {
+		return ""
+	}
+	return *e.AccountGroupName
+}
+
+// GetEnterpriseAgentsUsed returns the EnterpriseAgentsUsed field if it's non-nil, zero value otherwise.
+func (e *EnterpriseAgentUsage) GetEnterpriseAgentsUsed() int {
+	if e == nil || e.EnterpriseAgentsUsed == nil {
+		return 0
+	}
+	return *e.EnterpriseAgentsUsed
+}
+
+// GetAPILinks returns the APILinks field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetAPILinks() []APILink {
+	if t == nil || t.APILinks == nil {
+		return nil
+	}
+	return *t.APILinks
+}
+
+// GetAgents returns the Agents field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetAgents() []Agent {
+	if t == nil || t.Agents == nil {
+		return nil
+	}
+	return *t.Agents
+}
+
+// GetAlertRules returns the AlertRules field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetAlertRules() []AlertRule {
+	if t == nil || t.AlertRules == nil {
+		return nil
+	}
+	return *t.AlertRules
+}
+
+// GetAlertsEnabled returns the AlertsEnabled field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetAlertsEnabled() bool {
+	if t == nil || t.AlertsEnabled == nil {
+		return false
+	}
+	return *t.AlertsEnabled
+}
+
+// GetBGPMeasurements returns the BGPMeasurements field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetBGPMeasurements() bool {
+	if t == nil || t.BGPMeasurements == nil {
+		return false
+	}
+	return *t.BGPMeasurements
+}
+
+// GetCreatedBy returns the CreatedBy field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetCreatedBy() string {
+	if t == nil || t.CreatedBy == nil {
+		return ""
+	}
+	return *t.CreatedBy
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetCreatedDate() string {
+	if t == nil || t.CreatedDate == nil {
+		return ""
+	}
+	return *t.CreatedDate
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetDescription() string {
+	if t == nil || t.Description == nil {
+		return ""
+	}
+	return *t.Description
+}
+
+// GetDownloadLimit returns the DownloadLimit field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetDownloadLimit() int {
+	if t == nil || t.DownloadLimit == nil {
+		return 0
+	}
+	return *t.DownloadLimit
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return false
+	}
+	return *t.Enabled
+}
+
+// GetFTPTargetTime returns the FTPTargetTime field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetFTPTargetTime() int {
+	if t == nil || t.FTPTargetTime == nil {
+		return 0
+	}
+	return *t.FTPTargetTime
+}
+
+// GetFTPTimeLimit returns the FTPTimeLimit field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetFTPTimeLimit() int {
+	if t == nil || t.FTPTimeLimit == nil {
+		return 0
+	}
+	return *t.FTPTimeLimit
+}
+
+// GetGroups returns the Groups field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetGroups() []GroupLabel {
+	if t == nil || t.Groups == nil {
+		return nil
+	}
+	return *t.Groups
+}
+
+// GetInterval returns the Interval field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetInterval() int {
+	if t == nil || t.Interval == nil {
+		return 0
+	}
+	return *t.Interval
+}
+
+// GetLiveShare returns the LiveShare field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetLiveShare() bool {
+	if t == nil || t.LiveShare == nil {
+		return false
+	}
+	return *t.LiveShare
+}
+
+// GetMTUMeasurements returns the MTUMeasurements field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetMTUMeasurements() bool {
+	if t == nil || t.MTUMeasurements == nil {
+		return false
+	}
+	return *t.MTUMeasurements
+}
+
+// GetModifiedBy returns the ModifiedBy field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetModifiedBy() string {
+	if t == nil || t.ModifiedBy == nil {
+		return ""
+	}
+	return *t.ModifiedBy
+}
+
+// GetModifiedDate returns the ModifiedDate field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetModifiedDate() string {
+	if t == nil || t.ModifiedDate == nil {
+		return ""
+	}
+	return *t.ModifiedDate
+}
+
+// GetNetworkMeasurements returns the NetworkMeasurements field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetNetworkMeasurements() bool {
+	if t == nil || t.NetworkMeasurements == nil {
+		return false
+	}
+	return *t.NetworkMeasurements
+}
+
+// GetNumPathTraces returns the NumPathTraces field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetNumPathTraces() int {
+	if t == nil || t.NumPathTraces == nil {
+		return 0
+	}
+	return *t.NumPathTraces
+}
+
+// GetPassword returns the Password field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetPassword() string {
+	if t == nil || t.Password == nil {
+		return ""
+	}
+	return *t.Password
+}
+
+// GetPathTraceMode returns the PathTraceMode field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetPathTraceMode() PathTraceMode {
+	if t == nil || t.PathTraceMode == nil {
+		return ""
+	}
+	return *t.PathTraceMode
+}
+
+// GetProbeMode returns the ProbeMode field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetProbeMode() ProbeMode {
+	if t == nil || t.ProbeMode == nil {
+		return ""
+	}
+	return *t.ProbeMode
+}
+
+// GetProtocol returns the Protocol field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetProtocol() Protocol {
+	if t == nil || t.Protocol == nil {
+		return ""
+	}
+	return *t.Protocol
+}
+
+// GetRequestType returns the RequestType field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetRequestType() string {
+	if t == nil || t.RequestType == nil {
+		return ""
+	}
+	return *t.RequestType
+}
+
+// GetSavedEvent returns the SavedEvent field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetSavedEvent() bool {
+	if t == nil || t.SavedEvent == nil {
+		return false
+	}
+	return *t.SavedEvent
+}
+
+// GetSharedWithAccounts returns the SharedWithAccounts field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetSharedWithAccounts() []SharedWithAccount {
+	if t == nil || t.SharedWithAccounts == nil {
+		return nil
+	}
+	return *t.SharedWithAccounts
+}
+
+// GetTestID returns the TestID field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetTestID() int64 {
+	if t == nil || t.TestID == nil {
+		return 0
+	}
+	return *t.TestID
+}
+
+// GetTestName returns the TestName field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetTestName() string {
+	if t == nil || t.TestName == nil {
+		return ""
+	}
+	return *t.TestName
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetType() string {
+	if t == nil || t.Type == nil {
+		return ""
+	}
+	return *t.Type
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetURL() string {
+	if t == nil || t.URL == nil {
+		return ""
+	}
+	return *t.URL
+}
+
+// GetUseActiveFTP returns the UseActiveFTP field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetUseActiveFTP() int {
+	if t == nil || t.UseActiveFTP == nil {
+		return 0
+	}
+	return *t.UseActiveFTP
+}
+
+// GetUseExplicitFTPS returns the UseExplicitFTPS field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetUseExplicitFTPS() int {
+	if t == nil || t.UseExplicitFTPS == nil {
+		return 0
+	}
+	return *t.UseExplicitFTPS
+}
+
+// GetUsername returns the Username field if it's non-nil, zero value otherwise.
+func (t *FTPServer) GetUsername() string {
+	if t == nil || t.Username == nil {
+		return ""
+	}
+	return *t.Username
+}
+
+// GetAPILinks returns the APILinks field if it's non-nil, zero value otherwise.
+func (t *GenericEndpointTest) GetAPILinks() []APILink {
+	if t == nil || t.APILinks == nil {
+		return nil
+	}
+	return *t.APILinks
+}
+
+// GetAgentSelectorConfig returns the AgentSelectorConfig field, or nil if the receiver is nil.
+func (t *GenericEndpointTest) GetAgentSelectorConfig() *EndpointAgentSelector {
+	if t == nil {
+		return nil
+	}
+	return t.AgentSelectorConfig
+}
+
+// GetAlertsEnabled returns the AlertsEnabled field if it's non-nil, zero value otherwise.
+func (t *GenericEndpointTest) GetAlertsEnabled() bool {
+	if t == nil || t.AlertsEnabled == nil {
+		return false
+	}
+	return *t.AlertsEnabled
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (t *GenericEndpointTest) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return false
+	}
+	return *t.Enabled
+}
+
+// GetInterval returns the Interval field if it's non-nil, zero value otherwise.
+func (t *GenericEndpointTest) GetInterval() int {
+	if t == nil || t.Interval == nil {
+		return 0
+	}
+	return *t.Interval
+}
+
+// GetTestID returns the TestID field if it's non-nil, zero value otherwise.
+func (t *GenericEndpointTest) GetTestID() int64 {
+	if t == nil || t.TestID == nil {
+		return 0
+	}
+	return *t.TestID
+}
+
+// GetTestName returns the TestName field if it's non-nil, zero value otherwise.
+func (t *GenericEndpointTest) GetTestName() string {
+	if t == nil || t.TestName == nil {
+		return ""
+	}
+	return *t.TestName
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (t *GenericEndpointTest) GetType() string {
+	if t == nil || t.Type == nil {
+		return ""
+	}
+	return *t.Type
+}
+
+// GetAPILinks returns the APILinks field if it's non-nil, zero value otherwise.
+func (t *GenericTest) GetAPILinks() []APILink {
+	if t == nil || t.APILinks == nil {
+		return nil
+	}
+	return *t.APILinks
+}
+
+// GetAgents returns the Agents field if it's non-nil, zero value otherwise.
+func (t *GenericTest) GetAgents() []Agent {
+	if t == nil || t.Agents == nil {
+		return nil
+	}
+	return *t.Agents
+}
+
+// GetAlertRules returns the AlertRules field if it's non-nil, zero value otherwise.
+func (t *GenericTest) GetAlertRules() []AlertRule {
+	if t == nil || t.AlertRules == nil {
+		return nil
+	}
+	return *t.AlertRules
+}
+
+// GetAlertsEnabled returns the AlertsEnabled field if it's non-nil, zero value otherwise.
+func (t *GenericTest) GetAlertsEnabled() bool {
+	if t == nil || t.AlertsEnabled == nil {
+		return false
+	}
+	return *t.AlertsEnabled
+}
+
+// GetCreatedBy returns the CreatedBy field if it's non-nil, zero value otherwise.
+func (t *GenericTest) GetCreatedBy() string {
+	if t == nil || t.CreatedBy == nil {
+		return ""
+	}
+	return *t.CreatedBy
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (t *GenericTest) GetCreatedDate() string {
+	if t == nil || t.CreatedDate == nil {
+		return ""
+	}
+	return *t.CreatedDate
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (t *GenericTest) GetDescription() string {
+	if t == nil || t.Description == nil {
+		return ""
+	}
+	return *t.Description
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (t *GenericTest) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return false
+	}
+	return *t.Enabled
+}
+
+// GetGroups returns the Groups field if it's non-nil, zero value otherwise.
+func (t *GenericTest) GetGroups() []GroupLabel {
+	if t == nil || t.Groups == nil {
+		return nil
+	}
+	return *t.Groups
+}
+
+// GetLiveShare returns the LiveShare field if it's non-nil, zero value otherwise.
+func (t *GenericTest) GetLiveShare() bool {
+	if t == nil || t.LiveShare == nil {
+		return false
+	}
+	return *t.LiveShare
+}
+
+// GetModifiedBy returns the ModifiedBy field if it's non-nil, zero value otherwise.
+func (t *GenericTest) GetModifiedBy() string {
+	if t == nil || t.ModifiedBy == nil {
+		return ""
+	}
+	return *t.ModifiedBy
+}
+
+// GetModifiedDate returns the ModifiedDate field if it's non-nil, zero value otherwise.
+func (t *GenericTest) GetModifiedDate() string {
+	if t == nil || t.ModifiedDate == nil {
+		return ""
+	}
+	return *t.ModifiedDate
+}
+
+// GetSavedEvent returns the SavedEvent field if it's non-nil, zero value otherwise.
+func (t *GenericTest) GetSavedEvent() bool {
+	if t == nil || t.SavedEvent == nil {
+		return false
+	}
+	return *t.SavedEvent
+}
+
+// GetSharedWithAccounts returns the SharedWithAccounts field if it's non-nil, zero value otherwise.
+func (t *GenericTest) GetSharedWithAccounts() []SharedWithAccount {
+	if t == nil || t.SharedWithAccounts == nil {
+		return nil
+	}
+	return *t.SharedWithAccounts
+}
+
+// GetTestID returns the TestID field if it's non-nil, zero value otherwise.
+func (t *GenericTest) GetTestID() int64 {
+	if t == nil || t.TestID == nil {
+		return 0
+	}
+	return *t.TestID
+}
+
+// GetTestName returns the TestName field if it's non-nil, zero value otherwise.
+func (t *GenericTest) GetTestName() string {
+	if t == nil || t.TestName == nil {
+		return ""
+	}
+	return *t.TestName
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (t *GenericTest) GetType() string {
+	if t == nil || t.Type == nil {
+		return ""
+	}
+	return *t.Type
+}
+
+// GetAgents returns the Agents field if it's non-nil, zero value otherwise.
+func (t *GroupLabel) GetAgents() []Agent {
+	if t == nil || t.Agents == nil {
+		return nil
+	}
+	return *t.Agents
+}
+
+// GetBuiltin returns the Builtin field if it's non-nil, zero value otherwise.
+func (t *GroupLabel) GetBuiltin() bool {
+	if t == nil || t.Builtin == nil {
+		return false
+	}
+	return *t.Builtin
+}
+
+// GetDashboards returns the Dashboards field if it's non-nil, zero value otherwise.
+func (t *GroupLabel) GetDashboards() []Dashboard {
+	if t == nil || t.Dashboards == nil {
+		return nil
+	}
+	return *t.Dashboards
+}
+
+// GetEndpointAgents returns the EndpointAgents field if it's non-nil, zero value otherwise.
+func (t *GroupLabel) GetEndpointAgents() []EndpointAgent {
+	if t == nil || t.EndpointAgents == nil {
+		return nil
+	}
+	return *t.EndpointAgents
+}
+
+// GetEndpointTests returns the EndpointTests field if it's non-nil, zero value otherwise.
+func (t *GroupLabel) GetEndpointTests() []GenericEndpointTest {
+	if t == nil || t.EndpointTests == nil {
+		return nil
+	}
+	return *t.EndpointTests
+}
+
+// GetGroupID returns the GroupID field if it's non-nil, zero value otherwise.
+func (t *GroupLabel) GetGroupID() int64 {
+	if t == nil || t.GroupID == nil {
+		return 0
+	}
+	return *t.GroupID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (t *GroupLabel) GetName() string {
+	if t == nil || t.Name == nil {
+		return ""
+	}
+	return *t.Name
+}
+
+// GetTests returns the Tests field if it's non-nil, zero value otherwise.
+func (t *GroupLabel) GetTests() []GenericTest {
+	if t == nil || t.Tests == nil {
+		return nil
+	}
+	return *t.Tests
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (t *GroupLabel) GetType() LabelType {
+	if t == nil || t.Type == nil {
+		return ""
+	}
+	return *t.Type
+}
+
+// GetAPILinks returns the APILinks field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetAPILinks() []APILink {
+	if t == nil || t.APILinks == nil {
+		return nil
+	}
+	return *t.APILinks
+}
+
+// GetAgents returns the Agents field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetAgents() []Agent {
+	if t == nil || t.Agents == nil {
+		return nil
+	}
+	return *t.Agents
+}
+
+// GetAlertRules returns the AlertRules field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetAlertRules() []AlertRule {
+	if t == nil || t.AlertRules == nil {
+		return nil
+	}
+	return *t.AlertRules
+}
+
+// GetAlertsEnabled returns the AlertsEnabled field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetAlertsEnabled() bool {
+	if t == nil || t.AlertsEnabled == nil {
+		return false
+	}
+	return *t.AlertsEnabled
+}
+
+// GetAuthType returns the AuthType field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetAuthType() AuthType {
+	if t == nil || t.AuthType == nil {
+		return ""
+	}
+	return *t.AuthType
+}
+
+// GetBGPMeasurements returns the BGPMeasurements field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetBGPMeasurements() bool {
+	if t == nil || t.BGPMeasurements == nil {
+		return false
+	}
+	return *t.BGPMeasurements
+}
+
+// GetBGPMonitors returns the BGPMonitors field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetBGPMonitors() []Monitor {
+	if t == nil || t.BGPMonitors == nil {
+		return nil
+	}
+	return *t.BGPMonitors
+}
+
+// GetBandwidthMeasurements returns the BandwidthMeasurements field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetBandwidthMeasurements() bool {
+	if t == nil || t.BandwidthMeasurements == nil {
+		return false
+	}
+	return *t.BandwidthMeasurements
+}
+
+// GetClientCertificate returns the ClientCertificate field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetClientCertificate() string {
+	if t == nil || t.ClientCertificate == nil {
+		return ""
+	}
+	return *t.ClientCertificate
+}
+
+// GetContentRegex returns the ContentRegex field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetContentRegex() string {
+	if t == nil || t.ContentRegex == nil {
+		return ""
+	}
+	return *t.ContentRegex
+}
+
+// GetCreatedBy returns the CreatedBy field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetCreatedBy() string {
+	if t == nil || t.CreatedBy == nil {
+		return ""
+	}
+	return *t.CreatedBy
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetCreatedDate() string {
+	if t == nil || t.CreatedDate == nil {
+		return ""
+	}
+	return *t.CreatedDate
+}
+
+// GetCustomHeaders returns the CustomHeaders field, or nil if the receiver is nil.
+func (t *HTTPServer) GetCustomHeaders() *CustomHeaders {
+	if t == nil {
+		return nil
+	}
+	return t.CustomHeaders
+}
+
+// GetDNSOverride returns the DNSOverride field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetDNSOverride() string {
+	if t == nil || t.DNSOverride == nil {
+		return ""
+	}
+	return *t.DNSOverride
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetDescription() string {
+	if t == nil || t.Description == nil {
+		return ""
+	}
+	return *t.Description
+}
+
+// GetDesiredStatusCode returns the DesiredStatusCode field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetDesiredStatusCode() string {
+	if t == nil || t.DesiredStatusCode == nil {
+		return ""
+	}
+	return *t.DesiredStatusCode
+}
+
+// GetDownloadLimit returns the DownloadLimit field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetDownloadLimit() string {
+	if t == nil || t.DownloadLimit == nil {
+		return ""
+	}
+	return *t.DownloadLimit
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return false
+	}
+	return *t.Enabled
+}
+
+// GetFollowRedirects returns the FollowRedirects field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetFollowRedirects() bool {
+	if t == nil || t.FollowRedirects == nil {
+		return false
+	}
+	return *t.FollowRedirects
+}
+
+// GetGroups returns the Groups field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetGroups() []GroupLabel {
+	if t == nil || t.Groups == nil {
+		return nil
+	}
+	return *t.Groups
+}
+
+// GetHTTPTargetTime returns the HTTPTargetTime field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetHTTPTargetTime() int {
+	if t == nil || t.HTTPTargetTime == nil {
+		return 0
+	}
+	return *t.HTTPTargetTime
+}
+
+// GetHTTPTimeLimit returns the HTTPTimeLimit field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetHTTPTimeLimit() int {
+	if t == nil || t.HTTPTimeLimit == nil {
+		return 0
+	}
+	return *t.HTTPTimeLimit
+}
+
+// GetHTTPVersion returns the HTTPVersion field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetHTTPVersion() int {
+	if t == nil || t.HTTPVersion == nil {
+		return 0
+	}
+	return *t.HTTPVersion
+}
+
+// GetHeaders returns the Headers field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetHeaders() []string {
+	if t == nil || t.Headers == nil {
+		return nil
+	}
+	return *t.Headers
+}
+
+// GetInterval returns the Interval field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetInterval() int {
+	if t == nil || t.Interval == nil {
+		return 0
+	}
+	return *t.Interval
+}
+
+// GetLiveShare returns the LiveShare field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetLiveShare() bool {
+	if t == nil || t.LiveShare == nil {
+		return false
+	}
+	return *t.LiveShare
+}
+
+// GetMTUMeasurements returns the MTUMeasurements field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetMTUMeasurements() bool {
+	if t == nil || t.MTUMeasurements == nil {
+		return false
+	}
+	return *t.MTUMeasurements
+}
+
+// GetModifiedBy returns the ModifiedBy field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetModifiedBy() string {
+	if t == nil || t.ModifiedBy == nil {
+		return ""
+	}
+	return *t.ModifiedBy
+}
+
+// GetModifiedDate returns the ModifiedDate field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetModifiedDate() string {
+	if t == nil || t.ModifiedDate == nil {
+		return ""
+	}
+	return *t.ModifiedDate
+}
+
+// GetNetworkMeasurements returns the NetworkMeasurements field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetNetworkMeasurements() bool {
+	if t == nil || t.NetworkMeasurements == nil {
+		return false
+	}
+	return *t.NetworkMeasurements
+}
+
+// GetNumPathTraces returns the NumPathTraces field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetNumPathTraces() int {
+	if t == nil || t.NumPathTraces == nil {
+		return 0
+	}
+	return *t.NumPathTraces
+}
+
+// GetPassword returns the Password field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetPassword() string {
+	if t == nil || t.Password == nil {
+		return ""
+	}
+	return *t.Password
+}
+
+// GetPathTraceMode returns the PathTraceMode field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetPathTraceMode() PathTraceMode {
+	if t == nil || t.PathTraceMode == nil {
+		return ""
+	}
+	return *t.PathTraceMode
+}
+
+// GetPostBody returns the PostBody field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetPostBody() string {
+	if t == nil || t.PostBody == nil {
+		return ""
+	}
+	return *t.PostBody
+}
+
+// GetProbeMode returns the ProbeMode field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetProbeMode() ProbeMode {
+	if t == nil || t.ProbeMode == nil {
+		return ""
+	}
+	return *t.ProbeMode
+}
+
+// GetProtocol returns the Protocol field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetProtocol() Protocol {
+	if t == nil || t.Protocol == nil {
+		return ""
+	}
+	return *t.Protocol
+}
+
+// GetSSLVersion returns the SSLVersion field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetSSLVersion() string {
+	if t == nil || t.SSLVersion == nil {
+		return ""
+	}
+	return *t.SSLVersion
+}
+
+// GetSSLVersionID returns the SSLVersionID field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetSSLVersionID() int {
+	if t == nil || t.SSLVersionID == nil {
+		return 0
+	}
+	return *t.SSLVersionID
+}
+
+// GetSavedEvent returns the SavedEvent field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetSavedEvent() bool {
+	if t == nil || t.SavedEvent == nil {
+		return false
+	}
+	return *t.SavedEvent
+}
+
+// GetSharedWithAccounts returns the SharedWithAccounts field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetSharedWithAccounts() []SharedWithAccount {
+	if t == nil || t.SharedWithAccounts == nil {
+		return nil
+	}
+	return *t.SharedWithAccounts
+}
+
+// GetTestID returns the TestID field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetTestID() int64 {
+	if t == nil || t.TestID == nil {
+		return 0
+	}
+	return *t.TestID
+}
+
+// GetTestName returns the TestName field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetTestName() string {
+	if t == nil || t.TestName == nil {
+		return ""
+	}
+	return *t.TestName
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetType() string {
+	if t == nil || t.Type == nil {
+		return ""
+	}
+	return *t.Type
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetURL() string {
+	if t == nil || t.URL == nil {
+		return ""
+	}
+	return *t.URL
+}
+
+// GetUseNTLM returns the UseNTLM field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetUseNTLM() bool {
+	if t == nil || t.UseNTLM == nil {
+		return false
+	}
+	return *t.UseNTLM
+}
+
+// GetUserAgent returns the UserAgent field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetUserAgent() string {
+	if t == nil || t.UserAgent == nil {
+		return ""
+	}
+	return *t.UserAgent
+}
+
+// GetUsername returns the Username field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetUsername() string {
+	if t == nil || t.Username == nil {
+		return ""
+	}
+	return *t.Username
+}
+
+// GetVerifyCertificate returns the VerifyCertificate field if it's non-nil, zero value otherwise.
+func (t *HTTPServer) GetVerifyCertificate() bool {
+	if t == nil || t.VerifyCertificate == nil {
+		return false
+	}
+	return *t.VerifyCertificate
+}
+
+// GetAuthMethod returns the AuthMethod field if it's non-nil, zero value otherwise.
+func (i *Integration) GetAuthMethod() string {
+	if i == nil || i.AuthMethod == nil {
+		return ""
+	}
+	return *i.AuthMethod
+}
+
+// GetAuthToken returns the AuthToken field if it's non-nil, zero value otherwise.
+func (i *Integration) GetAuthToken() string {
+	if i == nil || i.AuthToken == nil {
+		return ""
+	}
+	return *i.AuthToken
+}
+
+// GetAuthUser returns the AuthUser field if it's non-nil, zero value otherwise.
+func (i *Integration) GetAuthUser() string {
+	if i == nil || i.AuthUser == nil {
+		return ""
+	}
+	return *i.AuthUser
+}
+
+// GetChannel returns the Channel field if it's non-nil, zero value otherwise.
+func (i *Integration) GetChannel() string {
+	if i == nil || i.Channel == nil {
+		return ""
+	}
+	return *i.Channel
+}
+
+// GetIntegrationID returns the IntegrationID field if it's non-nil, zero value otherwise.
+func (i *Integration) GetIntegrationID() string {
+	if i == nil || i.IntegrationID == nil {
+		return ""
+	}
+	return *i.IntegrationID
+}
+
+// GetIntegrationName returns the IntegrationName field if it's non-nil, zero value otherwise.
+func (i *Integration) GetIntegrationName() string {
+	if i == nil || i.IntegrationName == nil {
+		return ""
+	}
+	return *i.IntegrationName
+}
+
+// GetIntegrationType returns the IntegrationType field if it's non-nil, zero value otherwise.
+func (i *Integration) GetIntegrationType() IntegrationType {
+	if i == nil || i.IntegrationType == nil {
+		return ""
+	}
+	return *i.IntegrationType
+}
+
+// GetTarget returns the Target field if it's non-nil, zero value otherwise.
+func (i *Integration) GetTarget() string {
+	if i == nil || i.Target == nil {
+		return ""
+	}
+	return *i.Target
+}
+
+// GetCountryID returns the CountryID field if it's non-nil, zero value otherwise.
+func (m *Monitor) GetCountryID() string {
+	if m == nil || m.CountryID == nil {
+		return ""
+	}
+	return *m.CountryID
+}
+
+// GetIPAddress returns the IPAddress field if it's non-nil, zero value otherwise.
+func (m *Monitor) GetIPAddress() string {
+	if m == nil || m.IPAddress == nil {
+		return ""
+	}
+	return *m.IPAddress
+}
+
+// GetMonitorID returns the MonitorID field if it's non-nil, zero value otherwise.
+func (m *Monitor) GetMonitorID() int {
+	if m == nil || m.MonitorID == nil {
+		return 0
+	}
+	return *m.MonitorID
+}
+
+// GetMonitorName returns the MonitorName field if it's non-nil, zero value otherwise.
+func (m *Monitor) GetMonitorName() string {
+	if m == nil || m.MonitorName == nil {
+		return ""
+	}
+	return *m.MonitorName
+}
+
+// GetMonitorType returns the MonitorType field if it's non-nil, zero value otherwise.
+func (m *Monitor) GetMonitorType() string {
+	if m == nil || m.MonitorType == nil {
+		return ""
+	}
+	return *m.MonitorType
+}
+
+// GetNetwork returns the Network field if it's non-nil, zero value otherwise.
+func (m *Monitor) GetNetwork() string {
+	if m == nil || m.Network == nil {
+		return ""
+	}
+	return *m.Network
+}
+
+// GetEmail returns the Email field, or nil if the receiver is nil.
+func (n *Notification) GetEmail() *NotificationEmail {
+	if n == nil {
+		return nil
+	}
+	return n.Email
+}
+
+// GetThirdParty returns the ThirdParty field if it's non-nil, zero value otherwise.
+func (n *Notification) GetThirdParty() []NotificationThirdParty {
+	if n == nil || n.ThirdParty == nil {
+		return nil
+	}
+	return *n.ThirdParty
+}
+
+// GetWebhook returns the Webhook field if it's non-nil, zero value otherwise.
+func (n *Notification) GetWebhook() []NotificationWebhook {
+	if n == nil || n.Webhook == nil {
+		return nil
+	}
+	return *n.Webhook
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (n *NotificationEmail) GetMessage() string {
+	if n == nil || n.Message == nil {
+		return ""
+	}
+	return *n.Message
+}
+
+// GetRecipient returns the Recipient field if it's non-nil, zero value otherwise.
+func (n *NotificationEmail) GetRecipient() []string {
+	if n == nil || n.Recipient == nil {
+		return nil
+	}
+	return *n.Recipient
+}
+
+// GetIntegrationID returns the IntegrationID field if it's non-nil, zero value otherwise.
+func (n *NotificationThirdParty) GetIntegrationID() string {
+	if n == nil || n.IntegrationID == nil {
+		return ""
+	}
+	return *n.IntegrationID
+}
+
+// GetIntegrationType returns the IntegrationType field if it's non-nil, zero value otherwise.
+func (n *NotificationThirdParty) GetIntegrationType() IntegrationType {
+	if n == nil || n.IntegrationType == nil {
+		return ""
+	}
+	return *n.IntegrationType
+}
+
+// GetIntegrationID returns the IntegrationID field if it's non-nil, zero value otherwise.
+func (n *NotificationWebhook) GetIntegrationID() string {
+	if n == nil || n.IntegrationID == nil {
+		return ""
+	}
+	return *n.IntegrationID
+}
+
+// GetIntegrationType returns the IntegrationType field if it's non-nil, zero value otherwise.
+func (n *NotificationWebhook) GetIntegrationType() IntegrationType {
+	if n == nil || n.IntegrationType == nil {
+		return ""
+	}
+	return *n.IntegrationType
+}
+
+// GetAPILinks returns the APILinks field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetAPILinks() []APILink {
+	if t == nil || t.APILinks == nil {
+		return nil
+	}
+	return *t.APILinks
+}
+
+// GetAgents returns the Agents field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetAgents() []Agent {
+	if t == nil || t.Agents == nil {
+		return nil
+	}
+	return *t.Agents
+}
+
+// GetAlertRules returns the AlertRules field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetAlertRules() []AlertRule {
+	if t == nil || t.AlertRules == nil {
+		return nil
+	}
+	return *t.AlertRules
+}
+
+// GetAlertsEnabled returns the AlertsEnabled field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetAlertsEnabled() bool {
+	if t == nil || t.AlertsEnabled == nil {
+		return false
+	}
+	return *t.AlertsEnabled
+}
+
+// GetAuthType returns the AuthType field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetAuthType() AuthType {
+	if t == nil || t.AuthType == nil {
+		return ""
+	}
+	return *t.AuthType
+}
+
+// GetBGPMeasurements returns the BGPMeasurements field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetBGPMeasurements() bool {
+	if t == nil || t.BGPMeasurements == nil {
+		return false
+	}
+	return *t.BGPMeasurements
+}
+
+// GetBGPMonitors returns the BGPMonitors field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetBGPMonitors() []BGPMonitor {
+	if t == nil || t.BGPMonitors == nil {
+		return nil
+	}
+	return *t.BGPMonitors
+}
+
+// GetBandwidthMeasurements returns the BandwidthMeasurements field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetBandwidthMeasurements() bool {
+	if t == nil || t.BandwidthMeasurements == nil {
+		return false
+	}
+	return *t.BandwidthMeasurements
+}
+
+// GetContentRegex returns the ContentRegex field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetContentRegex() string {
+	if t == nil || t.ContentRegex == nil {
+		return ""
+	}
+	return *t.ContentRegex
+}
+
+// GetCreatedBy returns the CreatedBy field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetCreatedBy() string {
+	if t == nil || t.CreatedBy == nil {
+		return ""
+	}
+	return *t.CreatedBy
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetCreatedDate() string {
+	if t == nil || t.CreatedDate == nil {
+		return ""
+	}
+	return *t.CreatedDate
+}
+
+// GetCustomHeaders returns the CustomHeaders field, or nil if the receiver is nil.
+func (t *PageLoad) GetCustomHeaders() *CustomHeaders {
+	if t == nil {
+		return nil
+	}
+	return t.CustomHeaders
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetDescription() string {
+	if t == nil || t.Description == nil {
+		return ""
+	}
+	return *t.Description
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return false
+	}
+	return *t.Enabled
+}
+
+// GetFollowRedirects returns the FollowRedirects field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetFollowRedirects() bool {
+	if t == nil || t.FollowRedirects == nil {
+		return false
+	}
+	return *t.FollowRedirects
+}
+
+// GetGroups returns the Groups field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetGroups() []GroupLabel {
+	if t == nil || t.Groups == nil {
+		return nil
+	}
+	return *t.Groups
+}
+
+// GetHTTPInterval returns the HTTPInterval field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetHTTPInterval() int {
+	if t == nil || t.HTTPInterval == nil {
+		return 0
+	}
+	return *t.HTTPInterval
+}
+
+// GetHTTPTargetTime returns the HTTPTargetTime field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetHTTPTargetTime() int {
+	if t == nil || t.HTTPTargetTime == nil {
+		return 0
+	}
+	return *t.HTTPTargetTime
+}
+
+// GetHTTPTimeLimit returns the HTTPTimeLimit field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetHTTPTimeLimit() int {
+	if t == nil || t.HTTPTimeLimit == nil {
+		return 0
+	}
+	return *t.HTTPTimeLimit
+}
+
+// GetHTTPVersion returns the HTTPVersion field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetHTTPVersion() int {
+	if t == nil || t.HTTPVersion == nil {
+		return 0
+	}
+	return *t.HTTPVersion
+}
+
+// GetIncludeHeaders returns the IncludeHeaders field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetIncludeHeaders() bool {
+	if t == nil || t.IncludeHeaders == nil {
+		return false
+	}
+	return *t.IncludeHeaders
+}
+
+// GetInterval returns the Interval field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetInterval() int {
+	if t == nil || t.Interval == nil {
+		return 0
+	}
+	return *t.Interval
+}
+
+// GetLiveShare returns the LiveShare field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetLiveShare() bool {
+	if t == nil || t.LiveShare == nil {
+		return false
+	}
+	return *t.LiveShare
+}
+
+// GetMTUMeasurements returns the MTUMeasurements field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetMTUMeasurements() bool {
+	if t == nil || t.MTUMeasurements == nil {
+		return false
+	}
+	return *t.MTUMeasurements
+}
+
+// GetModifiedBy returns the ModifiedBy field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetModifiedBy() string {
+	if t == nil || t.ModifiedBy == nil {
+		return ""
+	}
+	return *t.ModifiedBy
+}
+
+// GetModifiedDate returns the ModifiedDate field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetModifiedDate() string {
+	if t == nil || t.ModifiedDate == nil {
+		return ""
+	}
+	return *t.ModifiedDate
+}
+
+// GetNetworkMeasurements returns the NetworkMeasurements field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetNetworkMeasurements() bool {
+	if t == nil || t.NetworkMeasurements == nil {
+		return false
+	}
+	return *t.NetworkMeasurements
+}
+
+// GetNumPathTraces returns the NumPathTraces field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetNumPathTraces() int {
+	if t == nil || t.NumPathTraces == nil {
+		return 0
+	}
+	return *t.NumPathTraces
+}
+
+// GetPageLoadTargetTime returns the PageLoadTargetTime field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetPageLoadTargetTime() int {
+	if t == nil || t.PageLoadTargetTime == nil {
+		return 0
+	}
+	return *t.PageLoadTargetTime
+}
+
+// GetPageLoadTimeLimit returns the PageLoadTimeLimit field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetPageLoadTimeLimit() int {
+	if t == nil || t.PageLoadTimeLimit == nil {
+		return 0
+	}
+	return *t.PageLoadTimeLimit
+}
+
+// GetPassword returns the Password field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetPassword() string {
+	if t == nil || t.Password == nil {
+		return ""
+	}
+	return *t.Password
+}
+
+// GetPathTraceMode returns the PathTraceMode field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetPathTraceMode() PathTraceMode {
+	if t == nil || t.PathTraceMode == nil {
+		return ""
+	}
+	return *t.PathTraceMode
+}
+
+// GetProbeMode returns the ProbeMode field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetProbeMode() ProbeMode {
+	if t == nil || t.ProbeMode == nil {
+		return ""
+	}
+	return *t.ProbeMode
+}
+
+// GetProtocol returns the Protocol field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetProtocol() Protocol {
+	if t == nil || t.Protocol == nil {
+		return ""
+	}
+	return *t.Protocol
+}
+
+// GetSSLVersion returns the SSLVersion field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetSSLVersion() string {
+	if t == nil || t.SSLVersion == nil {
+		return ""
+	}
+	return *t.SSLVersion
+}
+
+// GetSSLVersionID returns the SSLVersionID field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetSSLVersionID() int {
+	if t == nil || t.SSLVersionID == nil {
+		return 0
+	}
+	return *t.SSLVersionID
+}
+
+// GetSavedEvent returns the SavedEvent field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetSavedEvent() bool {
+	if t == nil || t.SavedEvent == nil {
+		return false
+	}
+	return *t.SavedEvent
+}
+
+// GetSharedWithAccounts returns the SharedWithAccounts field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetSharedWithAccounts() []SharedWithAccount {
+	if t == nil || t.SharedWithAccounts == nil {
+		return nil
+	}
+	return *t.SharedWithAccounts
+}
+
+// GetSubinterval returns the Subinterval field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetSubinterval() int {
+	if t == nil || t.Subinterval == nil {
+		return 0
+	}
+	return *t.Subinterval
+}
+
+// GetTestID returns the TestID field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetTestID() int64 {
+	if t == nil || t.TestID == nil {
+		return 0
+	}
+	return *t.TestID
+}
+
+// GetTestName returns the TestName field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetTestName() string {
+	if t == nil || t.TestName == nil {
+		return ""
+	}
+	return *t.TestName
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetType() string {
+	if t == nil || t.Type == nil {
+		return ""
+	}
+	return *t.Type
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetURL() string {
+	if t == nil || t.URL == nil {
+		return ""
+	}
+	return *t.URL
+}
+
+// GetUseNTLM returns the UseNTLM field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetUseNTLM() bool {
+	if t == nil || t.UseNTLM == nil {
+		return false
+	}
+	return *t.UseNTLM
+}
+
+// GetUsePublicBGP returns the UsePublicBGP field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetUsePublicBGP() bool {
+	if t == nil || t.UsePublicBGP == nil {
+		return false
+	}
+	return *t.UsePublicBGP
+}
+
+// GetUserAgent returns the UserAgent field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetUserAgent() string {
+	if t == nil || t.UserAgent == nil {
+		return ""
+	}
+	return *t.UserAgent
+}
+
+// GetUsername returns the Username field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetUsername() string {
+	if t == nil || t.Username == nil {
+		return ""
+	}
+	return *t.Username
+}
+
+// GetVerifyCertificate returns the VerifyCertificate field if it's non-nil, zero value otherwise.
+func (t *PageLoad) GetVerifyCertificate() bool {
+	if t == nil || t.VerifyCertificate == nil {
+		return false
+	}
+	return *t.VerifyCertificate
+}
+
+// GetIsManagementPermission returns the IsManagementPermission field if it's non-nil, zero value otherwise.
+func (t *Permission) GetIsManagementPermission() bool {
+	if t == nil || t.IsManagementPermission == nil {
+		return false
+	}
+	return *t.IsManagementPermission
+}
+
+// GetLabel returns the Label field if it's non-nil, zero value otherwise.
+func (t *Permission) GetLabel() string {
+	if t == nil || t.Label == nil {
+		return ""
+	}
+	return *t.Label
+}
+
+// GetPermissionID returns the PermissionID field if it's non-nil, zero value otherwise.
+func (t *Permission) GetPermissionID() int {
+	if t == nil || t.PermissionID == nil {
+		return 0
+	}
+	return *t.PermissionID
+}
+
+// GetAPILinks returns the APILinks field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetAPILinks() []APILink {
+	if t == nil || t.APILinks == nil {
+		return nil
+	}
+	return *t.APILinks
+}
+
+// GetAgents returns the Agents field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetAgents() []Agent {
+	if t == nil || t.Agents == nil {
+		return nil
+	}
+	return *t.Agents
+}
+
+// GetAlertRules returns the AlertRules field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetAlertRules() []AlertRule {
+	if t == nil || t.AlertRules == nil {
+		return nil
+	}
+	return *t.AlertRules
+}
+
+// GetAlertsEnabled returns the AlertsEnabled field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetAlertsEnabled() bool {
+	if t == nil || t.AlertsEnabled == nil {
+		return false
+	}
+	return *t.AlertsEnabled
+}
+
+// GetBGPMeasurements returns the BGPMeasurements field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetBGPMeasurements() bool {
+	if t == nil || t.BGPMeasurements == nil {
+		return false
+	}
+	return *t.BGPMeasurements
+}
+
+// GetBGPMonitors returns the BGPMonitors field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetBGPMonitors() []BGPMonitor {
+	if t == nil || t.BGPMonitors == nil {
+		return nil
+	}
+	return *t.BGPMonitors
+}
+
+// GetCodec returns the Codec field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetCodec() Codec {
+	if t == nil || t.Codec == nil {
+		return ""
+	}
+	return *t.Codec
+}
+
+// GetCodecID returns the CodecID field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetCodecID() int {
+	if t == nil || t.CodecID == nil {
+		return 0
+	}
+	return *t.CodecID
+}
+
+// GetCreatedBy returns the CreatedBy field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetCreatedBy() string {
+	if t == nil || t.CreatedBy == nil {
+		return ""
+	}
+	return *t.CreatedBy
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetCreatedDate() string {
+	if t == nil || t.CreatedDate == nil {
+		return ""
+	}
+	return *t.CreatedDate
+}
+
+// GetDSCP returns the DSCP field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetDSCP() DSCP {
+	if t == nil || t.DSCP == nil {
+		return ""
+	}
+	return *t.DSCP
+}
+
+// GetDSCPID returns the DSCPID field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetDSCPID() int {
+	if t == nil || t.DSCPID == nil {
+		return 0
+	}
+	return *t.DSCPID
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetDescription() string {
+	if t == nil || t.Description == nil {
+		return ""
+	}
+	return *t.Description
+}
+
+// GetDuration returns the Duration field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetDuration() int {
+	if t == nil || t.Duration == nil {
+		return 0
+	}
+	return *t.Duration
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return false
+	}
+	return *t.Enabled
+}
+
+// GetGroups returns the Groups field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetGroups() []GroupLabel {
+	if t == nil || t.Groups == nil {
+		return nil
+	}
+	return *t.Groups
+}
+
+// GetInterval returns the Interval field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetInterval() int {
+	if t == nil || t.Interval == nil {
+		return 0
+	}
+	return *t.Interval
+}
+
+// GetJitterBuffer returns the JitterBuffer field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetJitterBuffer() int {
+	if t == nil || t.JitterBuffer == nil {
+		return 0
+	}
+	return *t.JitterBuffer
+}
+
+// GetLiveShare returns the LiveShare field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetLiveShare() bool {
+	if t == nil || t.LiveShare == nil {
+		return false
+	}
+	return *t.LiveShare
+}
+
+// GetMTUMeasurements returns the MTUMeasurements field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetMTUMeasurements() bool {
+	if t == nil || t.MTUMeasurements == nil {
+		return false
+	}
+	return *t.MTUMeasurements
+}
+
+// GetModifiedBy returns the ModifiedBy field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetModifiedBy() string {
+	if t == nil || t.ModifiedBy == nil {
+		return ""
+	}
+	return *t.ModifiedBy
+}
+
+// GetModifiedDate returns the ModifiedDate field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetModifiedDate() string {
+	if t == nil || t.ModifiedDate == nil {
+		return ""
+	}
+	return *t.ModifiedDate
+}
+
+// GetNumPathTraces returns the NumPathTraces field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetNumPathTraces() int {
+	if t == nil || t.NumPathTraces == nil {
+		return 0
+	}
+	return *t.NumPathTraces
+}
+
+// GetSavedEvent returns the SavedEvent field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetSavedEvent() bool {
+	if t == nil || t.SavedEvent == nil {
+		return false
+	}
+	return *t.SavedEvent
+}
+
+// GetSharedWithAccounts returns the SharedWithAccounts field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetSharedWithAccounts() []SharedWithAccount {
+	if t == nil || t.SharedWithAccounts == nil {
+		return nil
+	}
+	return *t.SharedWithAccounts
+}
+
+// GetTargetAgentID returns the TargetAgentID field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetTargetAgentID() int {
+	if t == nil || t.TargetAgentID == nil {
+		return 0
+	}
+	return *t.TargetAgentID
+}
+
+// GetTestID returns the TestID field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetTestID() int64 {
+	if t == nil || t.TestID == nil {
+		return 0
+	}
+	return *t.TestID
+}
+
+// GetTestName returns the TestName field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetTestName() string {
+	if t == nil || t.TestName == nil {
+		return ""
+	}
+	return *t.TestName
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetType() string {
+	if t == nil || t.Type == nil {
+		return ""
+	}
+	return *t.Type
+}
+
+// GetUsePublicBGP returns the UsePublicBGP field if it's non-nil, zero value otherwise.
+func (t *RTPStream) GetUsePublicBGP() bool {
+	if t == nil || t.UsePublicBGP == nil {
+		return false
+	}
+	return *t.UsePublicBGP
+}
+
+// GetAuthUser returns the AuthUser field if it's non-nil, zero value otherwise.
+func (s *SIPAuthData) GetAuthUser() string {
+	if s == nil || s.AuthUser == nil {
+		return ""
+	}
+	return *s.AuthUser
+}
+
+// GetCredentialsID returns the CredentialsID field if it's non-nil, zero value otherwise.
+func (s *SIPAuthData) GetCredentialsID() int {
+	if s == nil || s.CredentialsID == nil {
+		return 0
+	}
+	return *s.CredentialsID
+}
+
+// GetPassword returns the Password field if it's non-nil, zero value otherwise.
+func (s *SIPAuthData) GetPassword() string {
+	if s == nil || s.Password == nil {
+		return ""
+	}
+	return *s.Password
+}
+
+// GetPort returns the Port field if it's non-nil, zero value otherwise.
+func (s *SIPAuthData) GetPort() int {
+	if s == nil || s.Port == nil {
+		return 0
+	}
+	return *s.Port
+}
+
+// GetProtocol returns the Protocol field if it's non-nil, zero value otherwise.
+func (s *SIPAuthData) GetProtocol() Protocol {
+	if s == nil || s.Protocol == nil {
+		return ""
+	}
+	return *s.Protocol
+}
+
+// GetSIPProxy returns the SIPProxy field if it's non-nil, zero value otherwise.
+func (s *SIPAuthData) GetSIPProxy() string {
+	if s == nil || s.SIPProxy == nil {
+		return ""
+	}
+	return *s.SIPProxy
+}
+
+// GetSIPRegistrar returns the SIPRegistrar field if it's non-nil, zero value otherwise.
+func (s *SIPAuthData) GetSIPRegistrar() string {
+	if s == nil || s.SIPRegistrar == nil {
+		return ""
+	}
+	return *s.SIPRegistrar
+}
+
+// GetUser returns the User field if it's non-nil, zero value otherwise.
+func (s *SIPAuthData) GetUser() string {
+	if s == nil || s.User == nil {
+		return ""
+	}
+	return *s.User
+}
+
+// GetAPILinks returns the APILinks field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetAPILinks() []APILink {
+	if t == nil || t.APILinks == nil {
+		return nil
+	}
+	return *t.APILinks
+}
+
+// GetAgents returns the Agents field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetAgents() []Agent {
+	if t == nil || t.Agents == nil {
+		return nil
+	}
+	return *t.Agents
+}
+
+// GetAlertRules returns the AlertRules field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetAlertRules() []AlertRule {
+	if t == nil || t.AlertRules == nil {
+		return nil
+	}
+	return *t.AlertRules
+}
+
+// GetAlertsEnabled returns the AlertsEnabled field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetAlertsEnabled() bool {
+	if t == nil || t.AlertsEnabled == nil {
+		return false
+	}
+	return *t.AlertsEnabled
+}
+
+// GetBGPMeasurements returns the BGPMeasurements field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetBGPMeasurements() bool {
+	if t == nil || t.BGPMeasurements == nil {
+		return false
+	}
+	return *t.BGPMeasurements
+}
+
+// GetBandwidthMeasurements returns the BandwidthMeasurements field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetBandwidthMeasurements() bool {
+	if t == nil || t.BandwidthMeasurements == nil {
+		return false
+	}
+	return *t.BandwidthMeasurements
+}
+
+// GetCreatedBy returns the CreatedBy field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetCreatedBy() string {
+	if t == nil || t.CreatedBy == nil {
+		return ""
+	}
+	return *t.CreatedBy
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetCreatedDate() string {
+	if t == nil || t.CreatedDate == nil {
+		return ""
+	}
+	return *t.CreatedDate
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetDescription() string {
+	if t == nil || t.Description == nil {
+		return ""
+	}
+	return *t.Description
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return false
+	}
+	return *t.Enabled
+}
+
+// GetGroups returns the Groups field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetGroups() []GroupLabel {
+	if t == nil || t.Groups == nil {
+		return nil
+	}
+	return *t.Groups
+}
+
+// GetInterval returns the Interval field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetInterval() int {
+	if t == nil || t.Interval == nil {
+		return 0
+	}
+	return *t.Interval
+}
+
+// GetLiveShare returns the LiveShare field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetLiveShare() bool {
+	if t == nil || t.LiveShare == nil {
+		return false
+	}
+	return *t.LiveShare
+}
+
+// GetMTUMeasurements returns the MTUMeasurements field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetMTUMeasurements() bool {
+	if t == nil || t.MTUMeasurements == nil {
+		return false
+	}
+	return *t.MTUMeasurements
+}
+
+// GetModifiedBy returns the ModifiedBy field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetModifiedBy() string {
+	if t == nil || t.ModifiedBy == nil {
+		return ""
+	}
+	return *t.ModifiedBy
+}
+
+// GetModifiedDate returns the ModifiedDate field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetModifiedDate() string {
+	if t == nil || t.ModifiedDate == nil {
+		return ""
+	}
+	return *t.ModifiedDate
+}
+
+// GetNetworkMeasurements returns the NetworkMeasurements field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetNetworkMeasurements() bool {
+	if t == nil || t.NetworkMeasurements == nil {
+		return false
+	}
+	return *t.NetworkMeasurements
+}
+
+// GetNumPathTraces returns the NumPathTraces field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetNumPathTraces() int {
+	if t == nil || t.NumPathTraces == nil {
+		return 0
+	}
+	return *t.NumPathTraces
+}
+
+// GetOptionsRegex returns the OptionsRegex field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetOptionsRegex() string {
+	if t == nil || t.OptionsRegex == nil {
+		return ""
+	}
+	return *t.OptionsRegex
+}
+
+// GetPathTraceMode returns the PathTraceMode field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetPathTraceMode() PathTraceMode {
+	if t == nil || t.PathTraceMode == nil {
+		return ""
+	}
+	return *t.PathTraceMode
+}
+
+// GetProbeMode returns the ProbeMode field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetProbeMode() ProbeMode {
+	if t == nil || t.ProbeMode == nil {
+		return ""
+	}
+	return *t.ProbeMode
+}
+
+// GetRegisterEnabled returns the RegisterEnabled field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetRegisterEnabled() bool {
+	if t == nil || t.RegisterEnabled == nil {
+		return false
+	}
+	return *t.RegisterEnabled
+}
+
+// GetSIPTargetTime returns the SIPTargetTime field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetSIPTargetTime() int {
+	if t == nil || t.SIPTargetTime == nil {
+		return 0
+	}
+	return *t.SIPTargetTime
+}
+
+// GetSIPTimeLimit returns the SIPTimeLimit field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetSIPTimeLimit() int {
+	if t == nil || t.SIPTimeLimit == nil {
+		return 0
+	}
+	return *t.SIPTimeLimit
+}
+
+// GetSavedEvent returns the SavedEvent field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetSavedEvent() bool {
+	if t == nil || t.SavedEvent == nil {
+		return false
+	}
+	return *t.SavedEvent
+}
+
+// GetSharedWithAccounts returns the SharedWithAccounts field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetSharedWithAccounts() []SharedWithAccount {
+	if t == nil || t.SharedWithAccounts == nil {
+		return nil
+	}
+	return *t.SharedWithAccounts
+}
+
+// GetTargetSIPCredentials returns the TargetSIPCredentials field, or nil if the receiver is nil.
+func (t *SIPServer) GetTargetSIPCredentials() *SIPAuthData {
+	if t == nil {
+		return nil
+	}
+	return t.TargetSIPCredentials
+}
+
+// GetTestID returns the TestID field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetTestID() int64 {
+	if t == nil || t.TestID == nil {
+		return 0
+	}
+	return *t.TestID
+}
+
+// GetTestName returns the TestName field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetTestName() string {
+	if t == nil || t.TestName == nil {
+		return ""
+	}
+	return *t.TestName
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetType() string {
+	if t == nil || t.Type == nil {
+		return ""
+	}
+	return *t.Type
+}
+
+// GetUsePublicBGP returns the UsePublicBGP field if it's non-nil, zero value otherwise.
+func (t *SIPServer) GetUsePublicBGP() bool {
+	if t == nil || t.UsePublicBGP == nil {
+		return false
+	}
+	return *t.UsePublicBGP
+}
+
+// GetServerID returns the ServerID field if it's non-nil, zero value otherwise.
+func (s *Server) GetServerID() int {
+	if s == nil || s.ServerID == nil {
+		return 0
+	}
+	return *s.ServerID
+}
+
+// GetServerName returns the ServerName field if it's non-nil, zero value otherwise.
+func (s *Server) GetServerName() string {
+	if s == nil || s.ServerName == nil {
+		return ""
+	}
+	return *s.ServerName
+}
+
+// GetAID returns the AID field if it's non-nil, zero value otherwise.
+func (s *SharedWithAccount) GetAID() int {
+	if s == nil || s.AID == nil {
+		return 0
+	}
+	return *s.AID
+}
+
+// GetAccountGroupName returns the AccountGroupName field if it's non-nil, zero value otherwise.
+func (s *SharedWithAccount) GetAccountGroupName() string {
+	if s == nil || s.AccountGroupName == nil {
+		return ""
+	}
+	return *s.AccountGroupName
+}
+
+// GetCreatedBy returns the CreatedBy field if it's non-nil, zero value otherwise.
+func (t *Snapshot) GetCreatedBy() string {
+	if t == nil || t.CreatedBy == nil {
+		return ""
+	}
+	return *t.CreatedBy
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (t *Snapshot) GetCreatedDate() string {
+	if t == nil || t.CreatedDate == nil {
+		return ""
+	}
+	return *t.CreatedDate
+}
+
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (t *Snapshot) GetDisplayName() string {
+	if t == nil || t.DisplayName == nil {
+		return ""
+	}
+	return *t.DisplayName
+}
+
+// GetExpirationDate returns the ExpirationDate field if it's non-nil, zero value otherwise.
+func (t *Snapshot) GetExpirationDate() string {
+	if t == nil || t.ExpirationDate == nil {
+		return ""
+	}
+	return *t.ExpirationDate
+}
+
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (t *Snapshot) GetFrom() string {
+	if t == nil || t.From == nil {
+		return ""
+	}
+	return *t.From
+}
+
+// GetIsPublic returns the IsPublic field if it's non-nil, zero value otherwise.
+func (t *Snapshot) GetIsPublic() bool {
+	if t == nil || t.IsPublic == nil {
+		return false
+	}
+	return *t.IsPublic
+}
+
+// GetSnapshotID returns the SnapshotID field if it's non-nil, zero value otherwise.
+func (t *Snapshot) GetSnapshotID() int64 {
+	if t == nil || t.SnapshotID == nil {
+		return 0
+	}
+	return *t.SnapshotID
+}
+
+// GetTestID returns the TestID field if it's non-nil, zero value otherwise.
+func (t *Snapshot) GetTestID() int64 {
+	if t == nil || t.TestID == nil {
+		return 0
+	}
+	return *t.TestID
+}
+
+// GetTo returns the To field if it's non-nil, zero value otherwise.
+func (t *Snapshot) GetTo() string {
+	if t == nil || t.To == nil {
+		return ""
+	}
+	return *t.To
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (t *Snapshot) GetURL() string {
+	if t == nil || t.URL == nil {
+		return ""
+	}
+	return *t.URL
+}
+
+// GetAID returns the AID field if it's non-nil, zero value otherwise.
+func (t *TestUsage) GetAID() int {
+	if t == nil || t.AID == nil {
+		return 0
+	}
+	return *t.AID
+}
+
+// GetAccountGroupName returns the AccountGroupName field if it's non-nil, zero value otherwise.
+func (t *TestUsage) GetAccountGroupName() string {
+	if t == nil || t.AccountGroupName == nil {
+		return ""
+	}
+	return *t.AccountGroupName
+}
+
+// GetCloudUnitsProjected returns the CloudUnitsProjected field if it's non-nil, zero value otherwise.
+func (t *TestUsage) GetCloudUnitsProjected() int {
+	if t == nil || t.CloudUnitsProjected == nil {
+		return 0
+	}
+	return *t.CloudUnitsProjected
+}
+
+// GetCloudUnitsUsed returns the CloudUnitsUsed field if it's non-nil, zero value otherwise.
+func (t *TestUsage) GetCloudUnitsUsed() int {
+	if t == nil || t.CloudUnitsUsed == nil {
+		return 0
+	}
+	return *t.CloudUnitsUsed
+}
+
+// GetEnterpriseUnitsProjected returns the EnterpriseUnitsProjected field if it's non-nil, zero value otherwise.
+func (t *TestUsage) GetEnterpriseUnitsProjected() int {
+	if t == nil || t.EnterpriseUnitsProjected == nil {
+		return 0
+	}
+	return *t.EnterpriseUnitsProjected
+}
+
+// GetEnterpriseUnitsUsed returns the EnterpriseUnitsUsed field if it's non-nil, zero value otherwise.
+func (t *TestUsage) GetEnterpriseUnitsUsed() int {
+	if t == nil || t.EnterpriseUnitsUsed == nil {
+		return 0
+	}
+	return *t.EnterpriseUnitsUsed
+}
+
+// GetTestID returns the TestID field if it's non-nil, zero value otherwise.
+func (t *TestUsage) GetTestID() int64 {
+	if t == nil || t.TestID == nil {
+		return 0
+	}
+	return *t.TestID
+}
+
+// GetTestName returns the TestName field if it's non-nil, zero value otherwise.
+func (t *TestUsage) GetTestName() string {
+	if t == nil || t.TestName == nil {
+		return ""
+	}
+	return *t.TestName
+}
+
+// GetCloudUnitsNextBillingPeriod returns the CloudUnitsNextBillingPeriod field if it's non-nil, zero value otherwise.
+func (u *Usage) GetCloudUnitsNextBillingPeriod() int {
+	if u == nil || u.CloudUnitsNextBillingPeriod == nil {
+		return 0
+	}
+	return *u.CloudUnitsNextBillingPeriod
+}
+
+// GetCloudUnitsProjected returns the CloudUnitsProjected field if it's non-nil, zero value otherwise.
+func (u *Usage) GetCloudUnitsProjected() int {
+	if u == nil || u.CloudUnitsProjected == nil {
+		return 0
+	}
+	return *u.CloudUnitsProjected
+}
+
+// GetCloudUnitsUsed returns the CloudUnitsUsed field if it's non-nil, zero value otherwise.
+func (u *Usage) GetCloudUnitsUsed() int {
+	if u == nil || u.CloudUnitsUsed == nil {
+		return 0
+	}
+	return *u.CloudUnitsUsed
+}
+
+// GetEndpointAgents returns the EndpointAgents field if it's non-nil, zero value otherwise.
+func (u *Usage) GetEndpointAgents() []EndpointAgentUsage {
+	if u == nil || u.EndpointAgents == nil {
+		return nil
+	}
+	return *u.EndpointAgents
+}
+
+// GetEndpointAgentsUsed returns the EndpointAgentsUsed field if it's non-nil, zero value otherwise.
+func (u *Usage) GetEndpointAgentsUsed() int {
+	if u == nil || u.EndpointAgentsUsed == nil {
+		return 0
+	}
+	return *u.EndpointAgentsUsed
+}
+
+// GetEnterpriseAgents returns the EnterpriseAgents field if it's non-nil, zero value otherwise.
+func (u *Usage) GetEnterpriseAgents() []EnterpriseAgentUsage {
+	if u == nil || u.EnterpriseAgents == nil {
+		return nil
+	}
+	return *u.EnterpriseAgents
+}
+
+// GetEnterpriseAgentsUsed returns the EnterpriseAgentsUsed field if it's non-nil, zero value otherwise.
+func (u *Usage) GetEnterpriseAgentsUsed() int {
+	if u == nil || u.EnterpriseAgentsUsed == nil {
+		return 0
+	}
+	return *u.EnterpriseAgentsUsed
+}
+
+// GetEnterpriseUnitsProjected returns the EnterpriseUnitsProjected field if it's non-nil, zero value otherwise.
+func (u *Usage) GetEnterpriseUnitsProjected() int {
+	if u == nil || u.EnterpriseUnitsProjected == nil {
+		return 0
+	}
+	return *u.EnterpriseUnitsProjected
+}
+
+// GetEnterpriseUnitsUsed returns the EnterpriseUnitsUsed field if it's non-nil, zero value otherwise.
+func (u *Usage) GetEnterpriseUnitsUsed() int {
+	if u == nil || u.EnterpriseUnitsUsed == nil {
+		return 0
+	}
+	return *u.EnterpriseUnitsUsed
+}
+
+// GetMonth returns the Month field if it's non-nil, zero value otherwise.
+func (u *Usage) GetMonth() string {
+	if u == nil || u.Month == nil {
+		return ""
+	}
+	return *u.Month
+}
+
+// GetQuota returns the Quota field, or nil if the receiver is nil.
+func (u *Usage) GetQuota() *UsageQuota {
+	if u == nil {
+		return nil
+	}
+	return u.Quota
+}
+
+// GetTests returns the Tests field if it's non-nil, zero value otherwise.
+func (u *Usage) GetTests() []TestUsage {
+	if u == nil || u.Tests == nil {
+		return nil
+	}
+	return *u.Tests
+}
+
+// GetUsageDate returns the UsageDate field if it's non-nil, zero value otherwise.
+func (u *Usage) GetUsageDate() string {
+	if u == nil || u.UsageDate == nil {
+		return ""
+	}
+	return *u.UsageDate
+}
+
+// GetCloudUnitsIncluded returns the CloudUnitsIncluded field if it's non-nil, zero value otherwise.
+func (u *UsageQuota) GetCloudUnitsIncluded() int {
+	if u == nil || u.CloudUnitsIncluded == nil {
+		return 0
+	}
+	return *u.CloudUnitsIncluded
+}
+
+// GetEndpointAgentsIncluded returns the EndpointAgentsIncluded field if it's non-nil, zero value otherwise.
+func (u *UsageQuota) GetEndpointAgentsIncluded() int {
+	if u == nil || u.EndpointAgentsIncluded == nil {
+		return 0
+	}
+	return *u.EndpointAgentsIncluded
+}
+
+// GetEnterpriseAgentsIncluded returns the EnterpriseAgentsIncluded field if it's non-nil, zero value otherwise.
+func (u *UsageQuota) GetEnterpriseAgentsIncluded() int {
+	if u == nil || u.EnterpriseAgentsIncluded == nil {
+		return 0
+	}
+	return *u.EnterpriseAgentsIncluded
+}
+
+// GetMonthEnd returns the MonthEnd field if it's non-nil, zero value otherwise.
+func (u *UsageQuota) GetMonthEnd() string {
+	if u == nil || u.MonthEnd == nil {
+		return ""
+	}
+	return *u.MonthEnd
+}
+
+// GetMonthStart returns the MonthStart field if it's non-nil, zero value otherwise.
+func (u *UsageQuota) GetMonthStart() string {
+	if u == nil || u.MonthStart == nil {
+		return ""
+	}
+	return *u.MonthStart
+}
+
+// GetAccountGroupRoles returns the AccountGroupRoles field if it's non-nil, zero value otherwise.
+func (u *User) GetAccountGroupRoles() []UserAccountGroupRole {
+	if u == nil || u.AccountGroupRoles == nil {
+		return nil
+	}
+	return *u.AccountGroupRoles
+}
+
+// GetAllAccountGroupRoles returns the AllAccountGroupRoles field if it's non-nil, zero value otherwise.
+func (u *User) GetAllAccountGroupRoles() []UserAccountGroupRole {
+	if u == nil || u.AllAccountGroupRoles == nil {
+		return nil
+	}
+	return *u.AllAccountGroupRoles
+}
+
+// GetDateRegistered returns the DateRegistered field, or nil if the receiver is nil.
+func (u *User) GetDateRegistered() *time.Time {
+	if u == nil {
+		return nil
+	}
+	return u.DateRegistered
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (u *User) GetEmail() string {
+	if u == nil || u.Email == nil {
+		return ""
+	}
+	return *u.Email
+}
+
+// GetLastLogin returns the LastLogin field, or nil if the receiver is nil.
+func (u *User) GetLastLogin() *time.Time {
+	if u == nil {
+		return nil
+	}
+	return u.LastLogin
+}
+
+// GetLoginAccountGroup returns the LoginAccountGroup field, or nil if the receiver is nil.
+func (u *User) GetLoginAccountGroup() *AccountGroup {
+	if u == nil {
+		return nil
+	}
+	return u.LoginAccountGroup
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (u *User) GetName() string {
+	if u == nil || u.Name == nil {
+		return ""
+	}
+	return *u.Name
+}
+
+// GetUID returns the UID field if it's non-nil, zero value otherwise.
+func (u *User) GetUID() int {
+	if u == nil || u.UID == nil {
+		return 0
+	}
+	return *u.UID
+}
+
+// GetAccountGroup returns the AccountGroup field, or nil if the receiver is nil.
+func (u *UserAccountGroupRole) GetAccountGroup() *AccountGroup {
+	if u == nil {
+		return nil
+	}
+	return u.AccountGroup
+}
+
+// GetRoles returns the Roles field if it's non-nil, zero value otherwise.
+func (u *UserAccountGroupRole) GetRoles() []AccountGroupRole {
+	if u == nil || u.Roles == nil {
+		return nil
+	}
+	return *u.Roles
+}
+
+// GetUID returns the UID field if it's non-nil, zero value otherwise.
+func (u *UserSyncChange) GetUID() int {
+	if u == nil || u.UID == nil {
+		return 0
+	}
+	return *u.UID
+}
+
+// GetAPILinks returns the APILinks field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetAPILinks() []APILink {
+	if t == nil || t.APILinks == nil {
+		return nil
+	}
+	return *t.APILinks
+}
+
+// GetAgents returns the Agents field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetAgents() []Agent {
+	if t == nil || t.Agents == nil {
+		return nil
+	}
+	return *t.Agents
+}
+
+// GetAlertRules returns the AlertRules field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetAlertRules() []AlertRule {
+	if t == nil || t.AlertRules == nil {
+		return nil
+	}
+	return *t.AlertRules
+}
+
+// GetAlertsEnabled returns the AlertsEnabled field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetAlertsEnabled() bool {
+	if t == nil || t.AlertsEnabled == nil {
+		return false
+	}
+	return *t.AlertsEnabled
+}
+
+// GetBGPMeasurements returns the BGPMeasurements field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetBGPMeasurements() bool {
+	if t == nil || t.BGPMeasurements == nil {
+		return false
+	}
+	return *t.BGPMeasurements
+}
+
+// GetCodec returns the Codec field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetCodec() Codec {
+	if t == nil || t.Codec == nil {
+		return ""
+	}
+	return *t.Codec
+}
+
+// GetCodecID returns the CodecID field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetCodecID() int {
+	if t == nil || t.CodecID == nil {
+		return 0
+	}
+	return *t.CodecID
+}
+
+// GetCreatedBy returns the CreatedBy field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetCreatedBy() string {
+	if t == nil || t.CreatedBy == nil {
+		return ""
+	}
+	return *t.CreatedBy
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetCreatedDate() string {
+	if t == nil || t.CreatedDate == nil {
+		return ""
+	}
+	return *t.CreatedDate
+}
+
+// GetDSCP returns the DSCP field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetDSCP() DSCP {
+	if t == nil || t.DSCP == nil {
+		return ""
+	}
+	return *t.DSCP
+}
+
+// GetDSCPID returns the DSCPID field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetDSCPID() int {
+	if t == nil || t.DSCPID == nil {
+		return 0
+	}
+	return *t.DSCPID
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetDescription() string {
+	if t == nil || t.Description == nil {
+		return ""
+	}
+	return *t.Description
+}
+
+// GetDuration returns the Duration field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetDuration() int {
+	if t == nil || t.Duration == nil {
+		return 0
+	}
+	return *t.Duration
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return false
+	}
+	return *t.Enabled
+}
+
+// GetGroups returns the Groups field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetGroups() []GroupLabel {
+	if t == nil || t.Groups == nil {
+		return nil
+	}
+	return *t.Groups
+}
+
+// GetInterval returns the Interval field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetInterval() int {
+	if t == nil || t.Interval == nil {
+		return 0
+	}
+	return *t.Interval
+}
+
+// GetJitterBuffer returns the JitterBuffer field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetJitterBuffer() int {
+	if t == nil || t.JitterBuffer == nil {
+		return 0
+	}
+	return *t.JitterBuffer
+}
+
+// GetLiveShare returns the LiveShare field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetLiveShare() bool {
+	if t == nil || t.LiveShare == nil {
+		return false
+	}
+	return *t.LiveShare
+}
+
+// GetModifiedBy returns the ModifiedBy field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetModifiedBy() string {
+	if t == nil || t.ModifiedBy == nil {
+		return ""
+	}
+	return *t.ModifiedBy
+}
+
+// GetModifiedDate returns the ModifiedDate field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetModifiedDate() string {
+	if t == nil || t.ModifiedDate == nil {
+		return ""
+	}
+	return *t.ModifiedDate
+}
+
+// GetNumPathTraces returns the NumPathTraces field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetNumPathTraces() int {
+	if t == nil || t.NumPathTraces == nil {
+		return 0
+	}
+	return *t.NumPathTraces
+}
+
+// GetSIPTargetTime returns the SIPTargetTime field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetSIPTargetTime() int {
+	if t == nil || t.SIPTargetTime == nil {
+		return 0
+	}
+	return *t.SIPTargetTime
+}
+
+// GetSIPTimeLimit returns the SIPTimeLimit field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetSIPTimeLimit() int {
+	if t == nil || t.SIPTimeLimit == nil {
+		return 0
+	}
+	return *t.SIPTimeLimit
+}
+
+// GetSavedEvent returns the SavedEvent field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetSavedEvent() bool {
+	if t == nil || t.SavedEvent == nil {
+		return false
+	}
+	return *t.SavedEvent
+}
+
+// GetSharedWithAccounts returns the SharedWithAccounts field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetSharedWithAccounts() []SharedWithAccount {
+	if t == nil || t.SharedWithAccounts == nil {
+		return nil
+	}
+	return *t.SharedWithAccounts
+}
+
+// GetSourceSIPCredentials returns the SourceSIPCredentials field, or nil if the receiver is nil.
+func (t *VoiceCall) GetSourceSIPCredentials() *SIPAuthData {
+	if t == nil {
+		return nil
+	}
+	return t.SourceSIPCredentials
+}
+
+// GetTargetAgentID returns the TargetAgentID field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetTargetAgentID() int {
+	if t == nil || t.TargetAgentID == nil {
+		return 0
+	}
+	return *t.TargetAgentID
+}
+
+// GetTargetSIPCredentials returns the TargetSIPCredentials field, or nil if the receiver is nil.
+func (t *VoiceCall) GetTargetSIPCredentials() *SIPAuthData {
+	if t == nil {
+		return nil
+	}
+	return t.TargetSIPCredentials
+}
+
+// GetTestID returns the TestID field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetTestID() int64 {
+	if t == nil || t.TestID == nil {
+		return 0
+	}
+	return *t.TestID
+}
+
+// GetTestName returns the TestName field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetTestName() string {
+	if t == nil || t.TestName == nil {
+		return ""
+	}
+	return *t.TestName
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetType() string {
+	if t == nil || t.Type == nil {
+		return ""
+	}
+	return *t.Type
+}
+
+// GetUsePublicBGP returns the UsePublicBGP field if it's non-nil, zero value otherwise.
+func (t *VoiceCall) GetUsePublicBGP() bool {
+	if t == nil || t.UsePublicBGP == nil {
+		return false
+	}
+	return *t.UsePublicBGP
+}
+
+// GetAPILinks returns the APILinks field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetAPILinks() []APILink {
+	if t == nil || t.APILinks == nil {
+		return nil
+	}
+	return *t.APILinks
+}
+
+// GetAgents returns the Agents field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetAgents() []Agent {
+	if t == nil || t.Agents == nil {
+		return nil
+	}
+	return *t.Agents
+}
+
+// GetAlertRules returns the AlertRules field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetAlertRules() []AlertRule {
+	if t == nil || t.AlertRules == nil {
+		return nil
+	}
+	return *t.AlertRules
+}
+
+// GetAlertsEnabled returns the AlertsEnabled field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetAlertsEnabled() bool {
+	if t == nil || t.AlertsEnabled == nil {
+		return false
+	}
+	return *t.AlertsEnabled
+}
+
+// GetAuthType returns the AuthType field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetAuthType() AuthType {
+	if t == nil || t.AuthType == nil {
+		return ""
+	}
+	return *t.AuthType
+}
+
+// GetBandwidthMeasurements returns the BandwidthMeasurements field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetBandwidthMeasurements() bool {
+	if t == nil || t.BandwidthMeasurements == nil {
+		return false
+	}
+	return *t.BandwidthMeasurements
+}
+
+// GetContentRegex returns the ContentRegex field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetContentRegex() string {
+	if t == nil || t.ContentRegex == nil {
+		return ""
+	}
+	return *t.ContentRegex
+}
+
+// GetCreatedBy returns the CreatedBy field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetCreatedBy() string {
+	if t == nil || t.CreatedBy == nil {
+		return ""
+	}
+	return *t.CreatedBy
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetCreatedDate() string {
+	if t == nil || t.CreatedDate == nil {
+		return ""
+	}
+	return *t.CreatedDate
+}
+
+// GetCredentials returns the Credentials field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetCredentials() []int {
+	if t == nil || t.Credentials == nil {
+		return nil
+	}
+	return *t.Credentials
+}
+
+// GetCustomHeaders returns the CustomHeaders field, or nil if the receiver is nil.
+func (t *WebTransaction) GetCustomHeaders() *CustomHeaders {
+	if t == nil {
+		return nil
+	}
+	return t.CustomHeaders
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetDescription() string {
+	if t == nil || t.Description == nil {
+		return ""
+	}
+	return *t.Description
+}
+
+// GetDesiredStatusCode returns the DesiredStatusCode field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetDesiredStatusCode() string {
+	if t == nil || t.DesiredStatusCode == nil {
+		return ""
+	}
+	return *t.DesiredStatusCode
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return false
+	}
+	return *t.Enabled
+}
+
+// GetGroups returns the Groups field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetGroups() []GroupLabel {
+	if t == nil || t.Groups == nil {
+		return nil
+	}
+	return *t.Groups
+}
+
+// GetHTTPTargetTime returns the HTTPTargetTime field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetHTTPTargetTime() int {
+	if t == nil || t.HTTPTargetTime == nil {
+		return 0
+	}
+	return *t.HTTPTargetTime
+}
+
+// GetHTTPTimeLimit returns the HTTPTimeLimit field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetHTTPTimeLimit() int {
+	if t == nil || t.HTTPTimeLimit == nil {
+		return 0
+	}
+	return *t.HTTPTimeLimit
+}
+
+// GetHTTPVersion returns the HTTPVersion field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetHTTPVersion() int {
+	if t == nil || t.HTTPVersion == nil {
+		return 0
+	}
+	return *t.HTTPVersion
+}
+
+// GetIncludeHeaders returns the IncludeHeaders field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetIncludeHeaders() bool {
+	if t == nil || t.IncludeHeaders == nil {
+		return false
+	}
+	return *t.IncludeHeaders
+}
+
+// GetInterval returns the Interval field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetInterval() int {
+	if t == nil || t.Interval == nil {
+		return 0
+	}
+	return *t.Interval
+}
+
+// GetLiveShare returns the LiveShare field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetLiveShare() bool {
+	if t == nil || t.LiveShare == nil {
+		return false
+	}
+	return *t.LiveShare
+}
+
+// GetMTUMeasurements returns the MTUMeasurements field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetMTUMeasurements() bool {
+	if t == nil || t.MTUMeasurements == nil {
+		return false
+	}
+	return *t.MTUMeasurements
+}
+
+// GetModifiedBy returns the ModifiedBy field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetModifiedBy() string {
+	if t == nil || t.ModifiedBy == nil {
+		return ""
+	}
+	return *t.ModifiedBy
+}
+
+// GetModifiedDate returns the ModifiedDate field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetModifiedDate() string {
+	if t == nil || t.ModifiedDate == nil {
+		return ""
+	}
+	return *t.ModifiedDate
+}
+
+// GetNetworkMeasurements returns the NetworkMeasurements field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetNetworkMeasurements() bool {
+	if t == nil || t.NetworkMeasurements == nil {
+		return false
+	}
+	return *t.NetworkMeasurements
+}
+
+// GetNumPathTraces returns the NumPathTraces field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetNumPathTraces() int {
+	if t == nil || t.NumPathTraces == nil {
+		return 0
+	}
+	return *t.NumPathTraces
+}
+
+// GetPassword returns the Password field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetPassword() string {
+	if t == nil || t.Password == nil {
+		return ""
+	}
+	return *t.Password
+}
+
+// GetPathTraceMode returns the PathTraceMode field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetPathTraceMode() PathTraceMode {
+	if t == nil || t.PathTraceMode == nil {
+		return ""
+	}
+	return *t.PathTraceMode
+}
+
+// GetProbeMode returns the ProbeMode field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetProbeMode() ProbeMode {
+	if t == nil || t.ProbeMode == nil {
+		return ""
+	}
+	return *t.ProbeMode
+}
+
+// GetProtocol returns the Protocol field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetProtocol() Protocol {
+	if t == nil || t.Protocol == nil {
+		return ""
+	}
+	return *t.Protocol
+}
+
+// GetSSLVersionID returns the SSLVersionID field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetSSLVersionID() int {
+	if t == nil || t.SSLVersionID == nil {
+		return 0
+	}
+	return *t.SSLVersionID
+}
+
+// GetSavedEvent returns the SavedEvent field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetSavedEvent() bool {
+	if t == nil || t.SavedEvent == nil {
+		return false
+	}
+	return *t.SavedEvent
+}
+
+// GetSharedWithAccounts returns the SharedWithAccounts field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetSharedWithAccounts() []SharedWithAccount {
+	if t == nil || t.SharedWithAccounts == nil {
+		return nil
+	}
+	return *t.SharedWithAccounts
+}
+
+// GetSubInterval returns the SubInterval field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetSubInterval() int {
+	if t == nil || t.SubInterval == nil {
+		return 0
+	}
+	return *t.SubInterval
+}
+
+// GetTargetTime returns the TargetTime field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetTargetTime() int {
+	if t == nil || t.TargetTime == nil {
+		return 0
+	}
+	return *t.TargetTime
+}
+
+// GetTestID returns the TestID field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetTestID() int64 {
+	if t == nil || t.TestID == nil {
+		return 0
+	}
+	return *t.TestID
+}
+
+// GetTestName returns the TestName field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetTestName() string {
+	if t == nil || t.TestName == nil {
+		return ""
+	}
+	return *t.TestName
+}
+
+// GetTimeLimit returns the TimeLimit field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetTimeLimit() int {
+	if t == nil || t.TimeLimit == nil {
+		return 0
+	}
+	return *t.TimeLimit
+}
+
+// GetTransactionScript returns the TransactionScript field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetTransactionScript() string {
+	if t == nil || t.TransactionScript == nil {
+		return ""
+	}
+	return *t.TransactionScript
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetType() string {
+	if t == nil || t.Type == nil {
+		return ""
+	}
+	return *t.Type
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetURL() string {
+	if t == nil || t.URL == nil {
+		return ""
+	}
+	return *t.URL
+}
+
+// GetUseNTLM returns the UseNTLM field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetUseNTLM() bool {
+	if t == nil || t.UseNTLM == nil {
+		return false
+	}
+	return *t.UseNTLM
+}
+
+// GetUserAgent returns the UserAgent field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetUserAgent() string {
+	if t == nil || t.UserAgent == nil {
+		return ""
+	}
+	return *t.UserAgent
+}
+
+// GetUsername returns the Username field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetUsername() string {
+	if t == nil || t.Username == nil {
+		return ""
+	}
+	return *t.Username
+}
+
+// GetVerifyCertificate returns the VerifyCertificate field if it's non-nil, zero value otherwise.
+func (t *WebTransaction) GetVerifyCertificate() bool {
+	if t == nil || t.VerifyCertificate == nil {
+		return false
+	}
+	return *t.VerifyCertificate
+}
@@ -0,0 +1,39 @@
+package thousandeyes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessors(t *testing.T) {
+	var agent *Agent
+	assert.Equal(t, "", agent.GetLocation())
+	assert.Equal(t, 0, agent.GetAgentID())
+	assert.False(t, agent.GetEnabled())
+	assert.Nil(t, agent.GetIPAddresses())
+
+	agent = &Agent{AgentName: String("a"), Enabled: Bool(true), IPAddresses: &[]string{"192.0.2.1"}}
+	assert.Equal(t, "a", agent.GetAgentName())
+	assert.Equal(t, "", agent.GetLocation())
+	assert.True(t, agent.GetEnabled())
+	assert.Equal(t, []string{"192.0.2.1"}, agent.GetIPAddresses())
+
+	// Struct fields are returned as pointers, so accessors chain.
+	sip := &SIPServer{}
+	assert.Equal(t, 0, sip.GetTargetSIPCredentials().GetPort())
+	sip.TargetSIPCredentials = &SIPAuthData{Port: Int(5060)}
+	assert.Equal(t, 5060, sip.GetTargetSIPCredentials().GetPort())
+
+	test := HTTPServer{Protocol: Ptr(ProtocolTCP)}
+	assert.Equal(t, ProtocolTCP, test.GetProtocol())
+	assert.Equal(t, ProbeMode(""), test.GetProbeMode())
+}
+
+func TestPtrValue(t *testing.T) {
+	assert.Equal(t, "a", *Ptr("a"))
+	assert.Equal(t, int64(1), *Ptr[int64](1))
+	assert.Equal(t, "a", Value(Ptr("a")))
+	assert.Equal(t, 0, Value[int](nil))
+	assert.Nil(t, Value[[]string](nil))
+}
@@ -1,14 +1,37 @@
 package thousandeyes
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+)
 
 // AccountGroups - list of account groups
 type AccountGroups []AccountGroup
 
 // AccountGroup - An account within a ThousandEyes organization
 type AccountGroup struct {
-	AccountGroupName *string `json:"accountGroupName,omitempty"`
-	AID              *int    `json:"aid,omitempty"`
+	AccountGroupName *string             `json:"accountGroupName,omitempty"`
+	AID              *int                `json:"aid,omitempty"`
+	OrganizationName *string             `json:"organizationName,omitempty"`
+	Current          *bool               `json:"current,omitempty" te:"int-bool"`
+	Default          *bool               `json:"default,omitempty" te:"int-bool"`
+	Agents           *[]Agent            `json:"agents,omitempty"`
+	Users            *[]AccountGroupUser `json:"users,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// AccountGroupUser - a user assigned to an account group, together with the
+// roles that user holds in the account group
+type AccountGroupUser struct {
+	Email *string             `json:"email,omitempty"`
+	Name  *string             `json:"name,omitempty"`
+	UID   *int                `json:"uid,omitempty"`
+	Roles *[]AccountGroupRole `json:"roles,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // SharedWithAccount describes accounts with which a resource is shared.
@@ -17,6 +40,29 @@ type AccountGroup struct {
 type SharedWithAccount struct {
 	AccountGroupName *string `json:"name,omitempty"`
 	AID              *int    `json:"aid,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// AddAgent - assign an agent to the account group
+func (t *AccountGroup) AddAgent(id int) {
+	if t.Agents == nil {
+		t.Agents = &[]Agent{}
+	}
+	*t.Agents = append(*t.Agents, Agent{AgentID: Int(id)})
+}
+
+// AddUser - assign a user to the account group with the given role IDs
+func (t *AccountGroup) AddUser(email string, roleIDs ...int) {
+	if t.Users == nil {
+		t.Users = &[]AccountGroupUser{}
+	}
+	roles := []AccountGroupRole{}
+	for _, id := range roleIDs {
+		roles = append(roles, AccountGroupRole{RoleID: Int(id)})
+	}
+	*t.Users = append(*t.Users, AccountGroupUser{Email: String(email), Roles: &roles})
 }
 
 // GetAccountGroups - Get third party and webhook integrations
@@ -35,7 +81,7 @@ func (c *Client) GetAccountGroups() (*[]SharedWithAccount, error) {
 	}
 
 	// Since the use of this is for the SharedWithAccount configuration,
-	// we will return that type.
+	// we will return that type.  Use GetAccountGroup for the full details.
 	var accountGroups []SharedWithAccount
 	for _, v := range target["accountGroups"] {
 		account := SharedWithAccount{
@@ -46,3 +92,70 @@ func (c *Client) GetAccountGroups() (*[]SharedWithAccount, error) {
 	}
 	return &accountGroups, nil
 }
+
+// GetAccountGroup - Get account group details, including agents and users
+func (c *Client) GetAccountGroup(aid int) (*AccountGroup, error) {
+	resp, err := c.get(fmt.Sprintf("/account-groups/%d", aid))
+	if err != nil {
+		return nil, err
+	}
+	var target map[string][]AccountGroup
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	if len(target["accountGroups"]) < 1 {
+		return nil, fmt.Errorf("Could not get account group %v", aid)
+	}
+	accountGroup := target["accountGroups"][0]
+	return &accountGroup, nil
+}
+
+// CreateAccountGroup - Create account group
+func (c *Client) CreateAccountGroup(a AccountGroup) (*AccountGroup, error) {
+	resp, err := c.post("/account-groups/new", a, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 201 {
+		return nil, fmt.Errorf("failed to create account group, response code %d", resp.StatusCode)
+	}
+	var target map[string][]AccountGroup
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	if len(target["accountGroups"]) < 1 {
+		return nil, fmt.Errorf("'accountGroups' not found in JSON response")
+	}
+	return &target["accountGroups"][0], nil
+}
+
+// UpdateAccountGroup - Update account group
+func (c *Client) UpdateAccountGroup(aid int, a AccountGroup) (*AccountGroup, error) {
+	resp, err := c.post(fmt.Sprintf("/account-groups/%d/update", aid), a, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("failed to update account group, response code %d", resp.StatusCode)
+	}
+	var target map[string][]AccountGroup
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	if len(target["accountGroups"]) < 1 {
+		return nil, fmt.Errorf("'accountGroups' not found in JSON response")
+	}
+	return &target["accountGroups"][0], nil
+}
+
+// DeleteAccountGroup - Delete account group
+func (c *Client) DeleteAccountGroup(aid int) error {
+	resp, err := c.post(fmt.Sprintf("/account-groups/%d/delete", aid), nil, nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != 204 {
+		return fmt.Errorf("failed to delete account group, response code %d", resp.StatusCode)
+	}
+	return nil
+}
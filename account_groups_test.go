@@ -1,6 +1,7 @@
 package thousandeyes
 
 import (
+	"io"
 	"net/http"
 	"testing"
 
@@ -62,3 +63,106 @@ func TestClient_GetAccountGroupsJsonError(t *testing.T) {
 	assert.Error(t, err)
 	assert.EqualError(t, err, "Could not decode JSON response: invalid character 'a' looking for beginning of object key string")
 }
+
+func TestClient_GetAccountGroup(t *testing.T) {
+	out := `{"accountGroups":[{"accountGroupName":"Test Account","aid":1,"organizationName":"Org","current":1,"default":0,"agents":[{"agentId":10}],"users":[{"email":"user@example.com","uid":5,"roles":[{"roleId":57}]}]}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/account-groups/1.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		_, _ = w.Write([]byte(out))
+	})
+
+	expected := AccountGroup{
+		AccountGroupName: String("Test Account"),
+		AID:              Int(1),
+		OrganizationName: String("Org"),
+		Current:          Bool(true),
+		Default:          Bool(false),
+		Agents:           &[]Agent{{AgentID: Int(10)}},
+		Users: &[]AccountGroupUser{
+			{
+				Email: String("user@example.com"),
+				UID:   Int(5),
+				Roles: &[]AccountGroupRole{{RoleID: Int(57)}},
+			},
+		},
+	}
+	res, err := client.GetAccountGroup(1)
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &expected, res)
+}
+
+func TestClient_CreateAccountGroup(t *testing.T) {
+	out := `{"accountGroups":[{"accountGroupName":"New Account","aid":2}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/account-groups/new.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"accountGroupName":"New Account","agents":[{"agentId":10}],"users":[{"email":"user@example.com","roles":[{"roleId":57}]}]}`, string(body))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(out))
+	})
+
+	create := AccountGroup{AccountGroupName: String("New Account")}
+	create.AddAgent(10)
+	create.AddUser("user@example.com", 57)
+	res, err := client.CreateAccountGroup(create)
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &AccountGroup{AccountGroupName: String("New Account"), AID: Int(2)}, res)
+}
+
+func TestClient_UpdateAccountGroup(t *testing.T) {
+	out := `{"accountGroups":[{"accountGroupName":"Renamed","aid":2}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/account-groups/2/update.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		_, _ = w.Write([]byte(out))
+	})
+
+	res, err := client.UpdateAccountGroup(2, AccountGroup{AccountGroupName: String("Renamed")})
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &AccountGroup{AccountGroupName: String("Renamed"), AID: Int(2)}, res)
+}
+
+func TestClient_DeleteAccountGroup(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/account-groups/2/delete.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := client.DeleteAccountGroup(2)
+	teardown()
+	assert.Nil(t, err)
+}
+
+func TestClient_CreateAccountGroupStatusCode(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/account-groups/new.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	_, err := client.CreateAccountGroup(AccountGroup{})
+	teardown()
+	assert.EqualError(t, err, "failed to create account group, response code 200")
+}
+
+func TestClient_DeleteAccountGroupStatusCode(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/account-groups/2/delete.json", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	err := client.DeleteAccountGroup(2)
+	teardown()
+	assert.EqualError(t, err, "failed to delete account group, response code 200")
+}
@@ -31,6 +31,9 @@ type Agent struct {
 	Utilization           *int                 `json:"utilization,omitempty"`
 	Ipv6Policy            *string              `json:"IPV6Policy,omitempty"`
 	TargetForTests        *string              `json:"targetForTests,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 //ClusterMember - ClusterMember struct
@@ -45,41 +48,18 @@ type ClusterMember struct {
 	AgentState        *string   `json:"agentState,omitempty"`
 	Utilization       *int      `json:"utilization,omitempty"`
 	TargetForTests    *string   `json:"targetForTests,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // AgentErrorDetails - Agent error details
 type AgentErrorDetails struct {
 	Code        *string `json:"code,omitempty"`
 	Description *string `json:"description,omitempty"`
-}
-
-// MarshalJSON implements the json.Marshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t Agent) MarshalJSON() ([]byte, error) {
-	type alias Agent
-
-	data, err := json.Marshal((alias)(t))
-	if err != nil {
-		return nil, err
-	}
 
-	return jsonBoolToInt(&t, data)
-}
-
-// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t *Agent) UnmarshalJSON(data []byte) error {
-	type alias Agent
-	test := (*alias)(t)
-
-	data, err := jsonIntToBool(t, data)
-	if err != nil {
-		return err
-	}
-
-	return json.Unmarshal(data, &test)
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetAgents - Get agents
@@ -143,3 +123,76 @@ func (c *Client) RemoveAgentsFromCluster(cluster int, ids []int) (*[]Agent, erro
 	agent := target["agents"]
 	return &agent, nil
 }
+
+// ClusterReconcileReport - result of reconciling the members of a cluster
+type ClusterReconcileReport struct {
+	ClusterID int
+	Added     []int
+	Removed   []int
+	Unchanged []int
+	Agent     *Agent
+}
+
+// ReconcileCluster - Add and remove members so that the cluster contains exactly
+// the desired member IDs.  Members are added before any are removed so that the
+// cluster is never left empty, and a cluster cannot be reconciled to no members.
+func (c *Client) ReconcileCluster(clusterID int, desiredMemberIDs []int) (*ClusterReconcileReport, error) {
+	if len(desiredMemberIDs) == 0 {
+		return nil, fmt.Errorf("refusing to remove the last member of cluster %d", clusterID)
+	}
+	agent, err := c.GetAgent(clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	current := map[int]bool{}
+	if agent.ClusterMembers != nil {
+		for _, m := range *agent.ClusterMembers {
+			if m.MemberID != nil {
+				current[*m.MemberID] = true
+			}
+		}
+	}
+	desired := map[int]bool{}
+	report := ClusterReconcileReport{ClusterID: clusterID, Agent: agent}
+	for _, id := range desiredMemberIDs {
+		if desired[id] {
+			continue
+		}
+		desired[id] = true
+		if current[id] {
+			report.Unchanged = append(report.Unchanged, id)
+		} else {
+			report.Added = append(report.Added, id)
+		}
+	}
+	if agent.ClusterMembers != nil {
+		for _, m := range *agent.ClusterMembers {
+			if m.MemberID != nil && !desired[*m.MemberID] {
+				report.Removed = append(report.Removed, *m.MemberID)
+			}
+		}
+	}
+
+	// The API rejects removing every member of a cluster, so additions must
+	// be applied first.
+	if len(report.Added) > 0 {
+		agents, err := c.AddAgentsToCluster(clusterID, report.Added)
+		if err != nil {
+			return &report, err
+		}
+		if len(*agents) > 0 {
+			report.Agent = &(*agents)[0]
+		}
+	}
+	if len(report.Removed) > 0 {
+		agents, err := c.RemoveAgentsFromCluster(clusterID, report.Removed)
+		if err != nil {
+			return &report, err
+		}
+		if len(*agents) > 0 {
+			report.Agent = &(*agents)[0]
+		}
+	}
+	return &report, nil
+}
@@ -26,65 +26,57 @@ type AgentAgent struct {
 	LiveShare          *bool                `json:"liveShare,omitempty" te:"int-bool"`
 
 	// Fields unique to this test
-	Agents                 *[]Agent      `json:"agents,omitempty"`
-	BGPMeasurements        *bool         `json:"bgpMeasurements,omitempty" te:"int-bool"`
-	BGPMonitors            *[]BGPMonitor `json:"bgpMonitors,omitempty"`
-	Direction              *string       `json:"direction,omitempty"`
-	DSCP                   *string       `json:"dscp,omitempty"`
-	DSCPID                 *int          `json:"dscpId"`
-	Interval               *int          `json:"interval,omitempty"`
-	MSS                    *int          `json:"mss,omitempty"`
-	NetworkMeasurements    *bool         `json:"networkMeasurements,omitempty" te:"int-bool"`
-	MTUMeasurements        *bool         `json:"mtuMeasurements,omitempty" te:"int-bool"`
-	NumPathTraces          *int          `json:"numPathTraces,omitempty"`
-	PathTraceMode          *string       `json:"pathTraceMode,omitempty"`
-	Port                   *int          `json:"port,omitempty"`
-	Protocol               *string       `json:"protocol,omitempty"`
-	TargetAgentID          *int          `json:"targetAgentId,omitempty"`
-	ThroughputDuration     *int          `json:"throughputDuration,omitempty"`
-	ThroughputMeasurements *bool         `json:"throughputMeasurements,omitempty" te:"int-bool"`
-	ThroughputRate         *int          `json:"throughputRate,omitempty"`
-	UsePublicBGP           *bool         `json:"usePublicBgp,omitempty" te:"int-bool"`
-}
-
-// MarshalJSON implements the json.Marshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t AgentAgent) MarshalJSON() ([]byte, error) {
-	type aliasTest AgentAgent
-
-	data, err := json.Marshal((aliasTest)(t))
-	if err != nil {
-		return nil, err
-	}
+	Agents                 *[]Agent       `json:"agents,omitempty"`
+	BGPMeasurements        *bool          `json:"bgpMeasurements,omitempty" te:"int-bool"`
+	BGPMonitors            *[]BGPMonitor  `json:"bgpMonitors,omitempty"`
+	Direction              *Direction     `json:"direction,omitempty"`
+	DSCP                   *DSCP          `json:"dscp,omitempty"`
+	DSCPID                 *int           `json:"dscpId"`
+	Interval               *int           `json:"interval,omitempty"`
+	MSS                    *int           `json:"mss,omitempty"`
+	NetworkMeasurements    *bool          `json:"networkMeasurements,omitempty" te:"int-bool"`
+	MTUMeasurements        *bool          `json:"mtuMeasurements,omitempty" te:"int-bool"`
+	NumPathTraces          *int           `json:"numPathTraces,omitempty"`
+	PathTraceMode          *PathTraceMode `json:"pathTraceMode,omitempty"`
+	Port                   *int           `json:"port,omitempty"`
+	Protocol               *Protocol      `json:"protocol,omitempty"`
+	TargetAgentID          *int           `json:"targetAgentId,omitempty"`
+	ThroughputDuration     *int           `json:"throughputDuration,omitempty"`
+	ThroughputMeasurements *bool          `json:"throughputMeasurements,omitempty" te:"int-bool"`
+	ThroughputRate         *int           `json:"throughputRate,omitempty"`
+	UsePublicBGP           *bool          `json:"usePublicBgp,omitempty" te:"int-bool"`
 
-	return jsonBoolToInt(&t, data)
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
-// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t *AgentAgent) UnmarshalJSON(data []byte) error {
-	type aliasTest AgentAgent
-	test := (*aliasTest)(t)
-
-	data, err := jsonIntToBool(t, data)
-	if err != nil {
-		return err
-	}
+// beforeMarshalJSON fills in whichever of the DSCP name and ID is missing
+// from the other.
+func (t *AgentAgent) beforeMarshalJSON() {
+	fillDSCP(&t.DSCP, &t.DSCPID)
+}
 
-	return json.Unmarshal(data, &test)
+// afterUnmarshalJSON fills in whichever of the DSCP name and ID the API did
+// not return.
+func (t *AgentAgent) afterUnmarshalJSON() {
+	fillDSCP(&t.DSCP, &t.DSCPID)
 }
 
 // AddAgent - Adds an agent to agent test
 func (t *AgentAgent) AddAgent(id int) {
 	agent := Agent{AgentID: Int(id)}
+	if t.Agents == nil {
+		t.Agents = &[]Agent{}
+	}
 	*t.Agents = append(*t.Agents, agent)
 }
 
 // AddAlertRule - Adds an alert to agent test
 func (t *AgentAgent) AddAlertRule(id int) {
 	alertRule := AlertRule{RuleID: Int(id)}
+	if t.AlertRules == nil {
+		t.AlertRules = &[]AlertRule{}
+	}
 	*t.AlertRules = append(*t.AlertRules, alertRule)
 }
 
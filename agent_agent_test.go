@@ -38,14 +38,14 @@ func TestClient_CreateAgentAgent(t *testing.T) {
 		CreatedDate:            String("2020-02-06 15:28:07"),
 		CreatedBy:              String("ThousandEyes SRE (test.example@thousandeyes.com)"),
 		Port:                   Int(8090),
-		PathTraceMode:          String("classic"),
+		PathTraceMode:          PathTraceMode("classic").Ptr(),
 		ThroughputMeasurements: Bool(true),
 	}
 
 	create := AgentAgent{
 		TestName:      String("test"),
 		Port:          Int(8090),
-		PathTraceMode: String("classic"),
+		PathTraceMode: PathTraceMode("classic").Ptr(),
 	}
 	res, err := client.CreateAgentAgent(create)
 	assert.Nil(t, err)
@@ -28,49 +28,23 @@ type AgentServer struct {
 	LiveShare          *bool                `json:"liveShare,omitempty" te:"int-bool"`
 
 	// Fields unique to this test
-	Agents                *[]Agent      `json:"agents,omitempty"`
-	BandwidthMeasurements *bool         `json:"bandwidthMeasurements,omitempty" te:"int-bool"`
-	BGPMeasurements       *bool         `json:"bgpMeasurements,omitempty" te:"int-bool"`
-	BGPMonitors           *[]BGPMonitor `json:"bgpMonitors,omitempty"`
-	Interval              *int          `json:"interval,omitempty"`
-	MTUMeasurements       *bool         `json:"mtuMeasurements,omitempty" te:"int-bool"`
-	NetworkMeasurements   *bool         `json:"networkMeasurements,omitempty" te:"int-bool"`
-	NumPathTraces         *int          `json:"numPathTraces,omitempty"`
-	PathTraceMode         *string       `json:"pathTraceMode,omitempty"`
-	Port                  *int          `json:"port,omitempty"`
-	ProbeMode             *string       `json:"probeMode,omitempty"`
-	Protocol              *string       `json:"protocol,omitempty"`
-	Server                *string       `json:"server,omitempty"`
-	UsePublicBGP          *bool         `json:"usePublicBgp,omitempty" te:"int-bool"`
-}
-
-// MarshalJSON implements the json.Marshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t AgentServer) MarshalJSON() ([]byte, error) {
-	type aliasTest AgentServer
-
-	data, err := json.Marshal((aliasTest)(t))
-	if err != nil {
-		return nil, err
-	}
-
-	return jsonBoolToInt(&t, data)
-}
-
-// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t *AgentServer) UnmarshalJSON(data []byte) error {
-	type aliasTest AgentServer
-	test := (*aliasTest)(t)
-
-	data, err := jsonIntToBool(t, data)
-	if err != nil {
-		return err
-	}
-
-	return json.Unmarshal(data, &test)
+	Agents                *[]Agent       `json:"agents,omitempty"`
+	BandwidthMeasurements *bool          `json:"bandwidthMeasurements,omitempty" te:"int-bool"`
+	BGPMeasurements       *bool          `json:"bgpMeasurements,omitempty" te:"int-bool"`
+	BGPMonitors           *[]BGPMonitor  `json:"bgpMonitors,omitempty"`
+	Interval              *int           `json:"interval,omitempty"`
+	MTUMeasurements       *bool          `json:"mtuMeasurements,omitempty" te:"int-bool"`
+	NetworkMeasurements   *bool          `json:"networkMeasurements,omitempty" te:"int-bool"`
+	NumPathTraces         *int           `json:"numPathTraces,omitempty"`
+	PathTraceMode         *PathTraceMode `json:"pathTraceMode,omitempty"`
+	Port                  *int           `json:"port,omitempty"`
+	ProbeMode             *ProbeMode     `json:"probeMode,omitempty"`
+	Protocol              *Protocol      `json:"protocol,omitempty"`
+	Server                *string        `json:"server,omitempty"`
+	UsePublicBGP          *bool          `json:"usePublicBgp,omitempty" te:"int-bool"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // extractPort - Set Server and Port fields if they are combined in the Server field.
@@ -96,12 +70,18 @@ func extractPort(test AgentServer) (AgentServer, error) {
 // AddAgent - Add agent to server test
 func (t *AgentServer) AddAgent(id int) {
 	agent := Agent{AgentID: Int(id)}
+	if t.Agents == nil {
+		t.Agents = &[]Agent{}
+	}
 	*t.Agents = append(*t.Agents, agent)
 }
 
 // AddAlertRule - Adds an alert to agent test
 func (t *AgentServer) AddAlertRule(id int) {
 	alertRule := AlertRule{RuleID: Int(id)}
+	if t.AlertRules == nil {
+		t.AlertRules = &[]AlertRule{}
+	}
 	*t.AlertRules = append(*t.AlertRules, alertRule)
 }
 
@@ -220,3 +220,52 @@ func TestClient_AddAgentToCluster(t *testing.T) {
 	}
 	assert.Equal(t, res, &exp)
 }
+
+func TestClient_ReconcileCluster(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	var calls []string
+	mux.HandleFunc("/agents/1.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		_, _ = w.Write([]byte(`{"agents":[{"agentId": 1, "clusterMembers": [{"memberId": 80001}, {"memberId": 80002}]}]}`))
+	})
+	mux.HandleFunc("/agents/1/add-to-cluster.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		calls = append(calls, "add")
+		_, _ = w.Write([]byte(`{"agents":[{"agentId": 1, "clusterMembers": [{"memberId": 80001}, {"memberId": 80002}, {"memberId": 80003}]}]}`))
+	})
+	mux.HandleFunc("/agents/1/remove-from-cluster.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		calls = append(calls, "remove")
+		_, _ = w.Write([]byte(`{"agents":[{"agentId": 1, "clusterMembers": [{"memberId": 80002}, {"memberId": 80003}]}]}`))
+	})
+
+	res, err := client.ReconcileCluster(1, []int{80002, 80003})
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"add", "remove"}, calls)
+	assert.Equal(t, []int{80003}, res.Added)
+	assert.Equal(t, []int{80001}, res.Removed)
+	assert.Equal(t, []int{80002}, res.Unchanged)
+	assert.Equal(t, 2, len(*res.Agent.ClusterMembers))
+}
+
+func TestClient_ReconcileClusterNoChanges(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/agents/1.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"agents":[{"agentId": 1, "clusterMembers": [{"memberId": 80001}]}]}`))
+	})
+
+	res, err := client.ReconcileCluster(1, []int{80001})
+	teardown()
+	assert.Nil(t, err)
+	assert.Nil(t, res.Added)
+	assert.Nil(t, res.Removed)
+	assert.Equal(t, []int{80001}, res.Unchanged)
+}
+
+func TestClient_ReconcileClusterLastMember(t *testing.T) {
+	_, err := client.ReconcileCluster(1, []int{})
+	assert.EqualError(t, err, "refusing to remove the last member of cluster 1")
+}
@@ -1,6 +1,7 @@
 package thousandeyes
 
 import (
+	"io"
 	"net/http"
 	"testing"
 
@@ -161,3 +162,52 @@ func TestClient_DeleteAlertRuleStatusCode(t *testing.T) {
 	teardown()
 	assert.ErrorContains(t, err, "Response did not contain formatted error: %!s(<nil>). HTTP response code: 400")
 }
+
+func TestClient_CreateAlertRuleNotifications(t *testing.T) {
+	setup()
+	out := `{"alertRuleId": 1, "ruleName": "test", "notifications": {"email": {"recipient": ["noc@example.com"]}, "thirdParty": [{"integrationId": "pgd-1", "integrationType": "PAGER_DUTY"}], "webhook": [{"integrationId": "wb-1", "integrationType": "WEBHOOK"}]}}`
+	mux.HandleFunc("/alert-rules/new.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"ruleName": "test", "notifications": {"email": {"recipient": ["noc@example.com"]}, "thirdParty": [{"integrationId": "pgd-1", "integrationType": "PAGER_DUTY"}], "webhook": [{"integrationId": "wb-1", "integrationType": "WEBHOOK"}]}}`, string(body))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(out))
+	})
+
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	notifications := &Notification{
+		Email:      &NotificationEmail{Recipient: &[]string{"noc@example.com"}},
+		ThirdParty: &[]NotificationThirdParty{{IntegrationID: String("pgd-1"), IntegrationType: Ptr(IntegrationTypePagerDuty)}},
+		Webhook:    &[]NotificationWebhook{{IntegrationID: String("wb-1"), IntegrationType: Ptr(IntegrationTypeWebhook)}},
+	}
+	res, err := client.CreateAlertRule(AlertRule{RuleName: String("test"), Notifications: notifications})
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &AlertRule{RuleID: Int(1), RuleName: String("test"), Notifications: notifications}, res)
+}
+
+func TestClient_ValidateAlertRuleNotifications(t *testing.T) {
+	setup()
+	out := `{"integrations":{"thirdParty":[{"integrationId":"pgd-1","integrationType":"PAGER_DUTY"}],"webhook":[{"integrationId":"wb-1","integrationType":"WEBHOOK"}]}}`
+	mux.HandleFunc("/integrations.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(out))
+	})
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+
+	valid := AlertRule{Notifications: &Notification{
+		ThirdParty: &[]NotificationThirdParty{{IntegrationID: String("pgd-1")}},
+		Webhook:    &[]NotificationWebhook{{IntegrationID: String("wb-1")}},
+	}}
+	assert.Nil(t, client.ValidateAlertRuleNotifications(valid))
+
+	invalid := AlertRule{Notifications: &Notification{
+		ThirdParty: &[]NotificationThirdParty{{IntegrationID: String("wb-1")}, {IntegrationID: String("slk-9")}},
+		Webhook:    &[]NotificationWebhook{{}},
+	}}
+	err := client.ValidateAlertRuleNotifications(invalid)
+	teardown()
+	assert.EqualError(t, err, "invalid alert rule notifications: integration wb-1 is a webhook integration, not thirdParty; thirdParty integration slk-9 does not exist; webhook notification has no integration ID")
+
+	// Email-only rules do not need to look up integrations.
+	assert.Nil(t, client.ValidateAlertRuleNotifications(AlertRule{Notifications: &Notification{Email: &NotificationEmail{}}}))
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"strings"
 )
 
 // Alerts - list of alerts
@@ -25,6 +26,9 @@ type Alert struct {
 	Agents         *[]Agent   `json:"agents,omitempty"`
 	Monitors       *[]Monitor `json:"monitors,omitempty"`
 	APILinks       *[]APILink `json:"apiLinks,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // AlertRules - list of alert rules
@@ -34,11 +38,37 @@ type AlertRules []AlertRule
 type NotificationEmail struct {
 	Message   *string   `json:"message,omitempty"`
 	Recipient *[]string `json:"recipient,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// NotificationThirdParty - Alert Rule Notification to a third party integration
+type NotificationThirdParty struct {
+	IntegrationID   *string          `json:"integrationId,omitempty"`
+	IntegrationType *IntegrationType `json:"integrationType,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// NotificationWebhook - Alert Rule Notification to a webhook integration
+type NotificationWebhook struct {
+	IntegrationID   *string          `json:"integrationId,omitempty"`
+	IntegrationType *IntegrationType `json:"integrationType,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // Notification - Alert Rule Notification structure
 type Notification struct {
-	Email *NotificationEmail `json:"email,omitempty"`
+	Email      *NotificationEmail        `json:"email,omitempty"`
+	ThirdParty *[]NotificationThirdParty `json:"thirdParty,omitempty"`
+	Webhook    *[]NotificationWebhook    `json:"webhook,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // AlertRule - An alert rule
@@ -59,35 +89,9 @@ type AlertRule struct {
 	RuleName                *string       `json:"ruleName,omitempty"`
 	TestIds                 *[]int        `json:"testIds,omitempty"`
 	Notifications           *Notification `json:"notifications,omitempty"`
-}
-
-// MarshalJSON implements the json.Marshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t AlertRule) MarshalJSON() ([]byte, error) {
-	type alias AlertRule
-
-	data, err := json.Marshal((alias)(t))
-	if err != nil {
-		return nil, err
-	}
 
-	return jsonBoolToInt(&t, data)
-}
-
-// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t *AlertRule) UnmarshalJSON(data []byte) error {
-	type alias AlertRule
-	test := (*alias)(t)
-
-	data, err := jsonIntToBool(t, data)
-	if err != nil {
-		return err
-	}
-
-	return json.Unmarshal(data, &test)
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // CreateAlertRule - Create alert rule
@@ -177,3 +181,49 @@ func (c Client) UpdateAlertRule(id int, a AlertRule) (*AlertRule, error) {
 	}
 	return &target, nil
 }
+
+// ValidateAlertRuleNotifications - Check that every integration referenced by the
+// alert rule's notifications exists, and is listed in the expected category.
+func (c *Client) ValidateAlertRuleNotifications(a AlertRule) error {
+	if a.Notifications == nil || (a.Notifications.ThirdParty == nil && a.Notifications.Webhook == nil) {
+		return nil
+	}
+	integrations, err := c.GetIntegrations()
+	if err != nil {
+		return err
+	}
+	existing := map[string]IntegrationCategory{}
+	for _, i := range *integrations {
+		if i.IntegrationID != nil {
+			existing[*i.IntegrationID] = i.Category
+		}
+	}
+
+	var problems []string
+	check := func(id *string, category IntegrationCategory) {
+		if id == nil {
+			problems = append(problems, fmt.Sprintf("%s notification has no integration ID", category))
+			return
+		}
+		found, ok := existing[*id]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%s integration %s does not exist", category, *id))
+		} else if found != category {
+			problems = append(problems, fmt.Sprintf("integration %s is a %s integration, not %s", *id, found, category))
+		}
+	}
+	if a.Notifications.ThirdParty != nil {
+		for _, n := range *a.Notifications.ThirdParty {
+			check(n.IntegrationID, IntegrationCategoryThirdParty)
+		}
+	}
+	if a.Notifications.Webhook != nil {
+		for _, n := range *a.Notifications.Webhook {
+			check(n.IntegrationID, IntegrationCategoryWebhook)
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid alert rule notifications: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,743 @@
+package thousandeyes
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ArchiveVersion - the version of the archive format written by
+// ExportAccountGroup.  ImportAccountGroup reads archives up to this version.
+const ArchiveVersion = 1
+
+// archiveManifestFile is the name of the manifest in an archive directory
+const archiveManifestFile = "manifest.json"
+
+// Archive object kinds.  Tests use the test type reported by GetTests.
+const (
+	ArchiveKindRole      = "role"
+	ArchiveKindLabel     = "label"
+	ArchiveKindAlertRule = "alert-rule"
+)
+
+// archiveReadOnlyFields - fields of tests which are set by the API
+var archiveReadOnlyFields = []string{
+	"TestID", "CreatedBy", "CreatedDate", "ModifiedBy", "ModifiedDate", "APILinks",
+	"SavedEvent", "LiveShare", "SharedWithAccounts", "Type",
+}
+
+// ArchiveManifest - the index of an account group archive.  An archive is
+// a directory holding the manifest, as manifest.json, and one YAML file per
+// object.  Objects are listed in the order ImportAccountGroup creates them:
+// roles, labels, alert rules and then tests.
+//
+// Agents, alert rules and labels are referenced by name in the object
+// files.  The agents assigned to the account group are listed in Agents.
+type ArchiveManifest struct {
+	Version        int                    `json:"version"`
+	AccountGroupID string                 `json:"accountGroupId"`
+	Exported       time.Time              `json:"exported"`
+	Integrations   []IntegrationReference `json:"integrations,omitempty"`
+	Objects        []ArchiveObject        `json:"objects"`
+
+	// Agents lists the enterprise agents assigned to the account group.
+	// Cloud agents are available to every account group, so they are not
+	// listed.
+	Agents []AgentReference `json:"agents,omitempty"`
+
+	// Skipped lists objects which were not exported, such as tests of
+	// types archives do not support.  They have no file.
+	Skipped []ArchiveObject `json:"skipped,omitempty"`
+}
+
+// ArchiveObject - an object of an account group archive
+type ArchiveObject struct {
+	Kind string `json:"kind"`
+	// ID is the object's ID in the exported account group
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// File is the slash separated path of the object's file in the archive
+	File string `json:"file,omitempty"`
+}
+
+// AgentReference - an agent assigned to the account group of an archive.
+// Agents are assigned by the organization, with UpdateAccountGroup, so
+// ImportAccountGroup does not assign them: it reports the agents missing
+// from the target account group instead.
+type AgentReference struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// IntegrationReference - an integration notified by alert rules of an
+// archive.  Integrations hold credentials, so they are not exported:
+// imported alert rules notify the integrations of the same name and
+// category in the target account group.
+type IntegrationReference struct {
+	ID       string              `json:"id"`
+	Name     string              `json:"name"`
+	Type     IntegrationType     `json:"type"`
+	Category IntegrationCategory `json:"category"`
+}
+
+// ExportAccountGroup - Write the roles, labels, alert rules and tests of the
+// account group aid, and the agents assigned to it, to an archive in dir,
+// which is created if it does not exist.  Builtin roles and labels, saved
+// events and tests shared by other account groups are not exported.
+func (c *Client) ExportAccountGroup(aid, dir string) (*ArchiveManifest, error) {
+	source := *c
+	source.AccountGroupID = aid
+	manifest := &ArchiveManifest{Version: ArchiveVersion, AccountGroupID: aid, Exported: time.Now().UTC()}
+	write := func(obj ArchiveObject, v interface{}) error {
+		data, err := yaml.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("Could not encode %s %s: %v", obj.Kind, obj.ID, err)
+		}
+		file := filepath.Join(dir, filepath.FromSlash(obj.File))
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(file, data, 0644); err != nil {
+			return err
+		}
+		manifest.Objects = append(manifest.Objects, obj)
+		return nil
+	}
+
+	agents, err := source.GetAgents()
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range *agents {
+		if a.AgentID != nil && a.GetAgentType() != "Cloud" {
+			manifest.Agents = append(manifest.Agents, AgentReference{ID: *a.AgentID, Name: a.GetAgentName()})
+		}
+	}
+
+	roles, err := source.GetRoles()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range *roles {
+		if r.RoleID == nil || r.GetBuiltin() {
+			continue
+		}
+		role, err := source.GetRole(*r.RoleID)
+		if err != nil {
+			return nil, err
+		}
+		role.RoleID, role.Builtin = nil, nil
+		id := strconv.Itoa(*r.RoleID)
+		if err := write(ArchiveObject{Kind: ArchiveKindRole, ID: id, Name: role.GetRoleName(), File: "roles/" + id + ".yaml"}, role); err != nil {
+			return nil, err
+		}
+	}
+
+	labels, err := source.GetGroupLabels()
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range *labels {
+		if l.GroupID == nil || l.GetBuiltin() {
+			continue
+		}
+		label, err := source.GetGroupLabel(int(*l.GroupID))
+		if err != nil {
+			return nil, err
+		}
+		// Test members are kept with the tests.  Endpoint agents and
+		// tests and dashboards have no names to match in another
+		// account group.
+		label.GroupID, label.Builtin, label.Tests = nil, nil, nil
+		label.EndpointAgents, label.EndpointTests, label.Dashboards = nil, nil, nil
+		id := strconv.FormatInt(*l.GroupID, 10)
+		if err := write(ArchiveObject{Kind: ArchiveKindLabel, ID: id, Name: label.GetName(), File: "labels/" + id + ".yaml"}, label); err != nil {
+			return nil, err
+		}
+	}
+
+	integrations, err := source.GetIntegrations()
+	if err != nil {
+		return nil, err
+	}
+	referenced := map[string]bool{}
+	reference := func(id *string) {
+		if id == nil || referenced[*id] {
+			return
+		}
+		referenced[*id] = true
+		ref := IntegrationReference{ID: *id}
+		for _, i := range *integrations {
+			if i.GetIntegrationID() == *id {
+				ref.Name, ref.Type, ref.Category = i.GetIntegrationName(), i.GetIntegrationType(), i.Category
+			}
+		}
+		manifest.Integrations = append(manifest.Integrations, ref)
+	}
+	rules, err := source.GetAlertRules()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range *rules {
+		if r.RuleID == nil {
+			continue
+		}
+		rule, err := source.GetAlertRule(*r.RuleID)
+		if err != nil {
+			return nil, err
+		}
+		rule.RuleID, rule.AlertRuleID, rule.TestIds = nil, nil, nil
+		for _, n := range rule.GetNotifications().GetThirdParty() {
+			reference(n.IntegrationID)
+		}
+		for _, n := range rule.GetNotifications().GetWebhook() {
+			reference(n.IntegrationID)
+		}
+		id := strconv.Itoa(*r.RuleID)
+		if err := write(ArchiveObject{Kind: ArchiveKindAlertRule, ID: id, Name: rule.GetRuleName(), File: "alert-rules/" + id + ".yaml"}, rule); err != nil {
+			return nil, err
+		}
+	}
+
+	tests, err := source.GetTests()
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range *tests {
+		if t.TestID == nil || t.GetSavedEvent() || t.GetLiveShare() {
+			continue
+		}
+		obj := ArchiveObject{Kind: t.GetType(), ID: strconv.FormatInt(*t.TestID, 10), Name: t.GetTestName()}
+		if newArchiveTest(obj.Kind) == nil {
+			manifest.Skipped = append(manifest.Skipped, obj)
+			continue
+		}
+		test, err := source.getArchiveTest(obj.Kind, int(*t.TestID))
+		if err != nil {
+			return nil, err
+		}
+		clearFields(test, archiveReadOnlyFields...)
+		obj.File = "tests/" + obj.Kind + "/" + obj.ID + ".yaml"
+		if err := write(obj, test); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(filepath.Join(dir, archiveManifestFile), append(data, '\n'), 0644); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// ReadArchiveManifest - Read the manifest of the archive in dir
+func ReadArchiveManifest(dir string) (*ArchiveManifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, archiveManifestFile))
+	if err != nil {
+		return nil, err
+	}
+	var manifest ArchiveManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("Could not decode archive manifest: %v", err)
+	}
+	if manifest.Version < 1 || manifest.Version > ArchiveVersion {
+		return nil, fmt.Errorf("unsupported archive version %d", manifest.Version)
+	}
+	for _, obj := range manifest.Objects {
+		file := path.Clean(obj.File)
+		if obj.File == "" || path.IsAbs(file) || file == ".." || strings.HasPrefix(file, "../") {
+			return nil, fmt.Errorf("invalid file %q for %s %s", obj.File, obj.Kind, obj.ID)
+		}
+	}
+	return &manifest, nil
+}
+
+// ImportOptions - options for ImportAccountGroup
+type ImportOptions struct {
+	// DryRun reports what an import would do without changing the target
+	// account group.
+	DryRun bool
+	// ProgressLog is the path of a file listing the objects which have
+	// been imported.  An import stopped by an error can be resumed by
+	// running it again with the same log: objects in the log are not
+	// imported again.  The log is not written by dry runs.
+	ProgressLog string
+}
+
+// ImportAction - what ImportAccountGroup does with an archive object
+type ImportAction string
+
+// Import actions
+const (
+	// ImportCreate - the object is created
+	ImportCreate ImportAction = "create"
+	// ImportExisting - an object of the same kind and name exists in the
+	// target account group and is used as is
+	ImportExisting ImportAction = "existing"
+	// ImportResumed - the object is listed in the progress log
+	ImportResumed ImportAction = "resumed"
+)
+
+// ImportResult - the import of a single archive object
+type ImportResult struct {
+	ArchiveObject
+	Action ImportAction
+	// TargetID is the ID of the object in the target account group.  It
+	// is empty for objects a dry run would create.
+	TargetID string
+	// Unresolved lists the agents, alert rules, labels and integrations
+	// referenced by the object which a dry run found neither in the target
+	// account group nor in the archive.
+	Unresolved []string
+}
+
+// ImportReport - the result of ImportAccountGroup
+type ImportReport struct {
+	DryRun  bool
+	Results []ImportResult
+	// UnassignedAgents lists the agents of the archive's manifest which
+	// are not assigned to the target account group, by name
+	UnassignedAgents []string
+}
+
+// Complete reports whether every agent of the archive is assigned to the
+// target account group and every reference of every object was resolved
+func (r ImportReport) Complete() bool {
+	if len(r.UnassignedAgents) > 0 {
+		return false
+	}
+	for _, result := range r.Results {
+		if len(result.Unresolved) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// ImportAccountGroup - Create the objects of the archive in dir in the
+// account group aid.  Objects which exist in the target account group
+// under the same name are not changed.  References to agents, alert rules,
+// labels and integrations are matched by name in the target account group.
+// Agents are not assigned to the target account group; the report lists
+// the agents of the archive which are not.
+//
+// Objects are imported in the order of the manifest, and the import stops
+// at the first error.  The report lists the objects handled until then.
+func (c *Client) ImportAccountGroup(dir, aid string, opts ImportOptions) (*ImportReport, error) {
+	manifest, err := ReadArchiveManifest(dir)
+	if err != nil {
+		return nil, err
+	}
+	target := *c
+	target.AccountGroupID = aid
+
+	done, err := readImportProgress(opts.ProgressLog)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := target.archiveExisting()
+	if err != nil {
+		return nil, err
+	}
+	integrationIDs, err := target.archiveIntegrationIDs(manifest.Integrations)
+	if err != nil {
+		return nil, err
+	}
+	var progress *os.File
+	if !opts.DryRun && opts.ProgressLog != "" {
+		progress, err = os.OpenFile(opts.ProgressLog, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			return nil, err
+		}
+		defer progress.Close()
+	}
+
+	report := &ImportReport{DryRun: opts.DryRun}
+	var names *stateNames
+	if len(manifest.Agents) > 0 {
+		if names, err = target.getStateNames(); err != nil {
+			return nil, err
+		}
+		for _, a := range manifest.Agents {
+			if _, ok := names.agents[a.Name]; !ok {
+				report.UnassignedAgents = append(report.UnassignedAgents, a.Name)
+			}
+		}
+	}
+	for _, obj := range manifest.Objects {
+		result := ImportResult{ArchiveObject: obj}
+		if id, ok := done[obj.Kind+"/"+obj.ID]; ok {
+			result.Action, result.TargetID = ImportResumed, id
+			report.Results = append(report.Results, result)
+			continue
+		}
+		v, err := readArchiveObject(dir, obj)
+		if err != nil {
+			return report, err
+		}
+		key := archiveKey(obj.Kind, v)
+		if id, ok := existing[key]; ok {
+			result.Action, result.TargetID = ImportExisting, id
+			report.Results = append(report.Results, result)
+			continue
+		}
+		result.Action = ImportCreate
+
+		if names == nil {
+			if names, err = target.getStateNames(); err != nil {
+				return report, err
+			}
+		}
+		unresolved := names.resolve(v)
+		if rule, ok := v.(*AlertRule); ok {
+			unresolved = append(unresolved, remapNotifications(rule, manifest.Integrations, integrationIDs)...)
+		}
+		if opts.DryRun {
+			result.Unresolved = unresolved
+			// Later objects may refer to the ones this import creates.
+			switch o := v.(type) {
+			case *AlertRule:
+				names.alertRules[o.GetRuleName()] = 0
+			case *GroupLabel:
+				if o.GetType() == LabelTypeTests {
+					names.groups[o.GetName()] = 0
+				}
+			}
+			existing[key] = ""
+			report.Results = append(report.Results, result)
+			continue
+		}
+		if len(unresolved) > 0 {
+			return report, fmt.Errorf("Could not import %s %q: not found: %s", obj.Kind, obj.Name, strings.Join(unresolved, ", "))
+		}
+
+		id, err := target.createArchiveObject(v)
+		if err != nil {
+			return report, fmt.Errorf("Could not import %s %q: %v", obj.Kind, obj.Name, err)
+		}
+		result.TargetID = id
+		existing[key] = id
+		if progress != nil {
+			if _, err := fmt.Fprintf(progress, "%s\t%s\t%s\n", obj.Kind, obj.ID, id); err != nil {
+				return report, err
+			}
+		}
+		switch v.(type) {
+		case *AlertRule, *GroupLabel:
+			names = nil
+		}
+		report.Results = append(report.Results, result)
+	}
+	return report, nil
+}
+
+// readImportProgress returns the target IDs of the objects listed in a
+// progress log by kind and source ID.  A missing log lists no objects.
+func readImportProgress(file string) (map[string]string, error) {
+	done := map[string]string{}
+	if file == "" {
+		return done, nil
+	}
+	f, err := os.Open(file)
+	if errors.Is(err, os.ErrNotExist) {
+		return done, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Split(scanner.Text(), "\t")
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("invalid progress log line %d: %q", line, scanner.Text())
+		}
+		done[fields[0]+"/"+fields[1]] = fields[2]
+	}
+	return done, scanner.Err()
+}
+
+// readArchiveObject decodes the file of an archive object
+func readArchiveObject(dir string, obj ArchiveObject) (interface{}, error) {
+	var v interface{}
+	switch obj.Kind {
+	case ArchiveKindRole:
+		v = &AccountGroupRole{}
+	case ArchiveKindLabel:
+		v = &GroupLabel{}
+	case ArchiveKindAlertRule:
+		v = &AlertRule{}
+	default:
+		if v = newArchiveTest(obj.Kind); v == nil {
+			return nil, fmt.Errorf("unsupported archive object kind %s", obj.Kind)
+		}
+	}
+	data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(obj.File)))
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, v); err != nil {
+		return nil, fmt.Errorf("Could not decode %s: %v", obj.File, err)
+	}
+	return v, nil
+}
+
+// archiveKey returns the key objects are matched by in the target account
+// group: their kind and name, and for labels their type
+func archiveKey(kind string, v interface{}) string {
+	switch o := v.(type) {
+	case *AccountGroupRole:
+		return kind + "/" + o.GetRoleName()
+	case *GroupLabel:
+		return kind + "/" + string(o.GetType()) + "/" + o.GetName()
+	case *AlertRule:
+		return kind + "/" + o.GetRuleName()
+	case interface{ GetTestName() string }:
+		return kind + "/" + o.GetTestName()
+	}
+	return kind
+}
+
+// archiveExisting returns the IDs of the roles, labels, alert rules and
+// tests of the account group by archiveKey
+func (c *Client) archiveExisting() (map[string]string, error) {
+	existing := map[string]string{}
+	roles, err := c.GetRoles()
+	if err != nil {
+		return nil, err
+	}
+	for i, r := range *roles {
+		existing[archiveKey(ArchiveKindRole, &(*roles)[i])] = strconv.Itoa(r.GetRoleID())
+	}
+	labels, err := c.GetGroupLabels()
+	if err != nil {
+		return nil, err
+	}
+	for i, l := range *labels {
+		existing[archiveKey(ArchiveKindLabel, &(*labels)[i])] = strconv.FormatInt(l.GetGroupID(), 10)
+	}
+	rules, err := c.GetAlertRules()
+	if err != nil {
+		return nil, err
+	}
+	for i, r := range *rules {
+		existing[archiveKey(ArchiveKindAlertRule, &(*rules)[i])] = strconv.Itoa(r.GetRuleID())
+	}
+	tests, err := c.GetTests()
+	if err != nil {
+		return nil, err
+	}
+	for i, t := range *tests {
+		existing[archiveKey(t.GetType(), &(*tests)[i])] = strconv.FormatInt(t.GetTestID(), 10)
+	}
+	return existing, nil
+}
+
+// archiveIntegrationIDs returns the IDs of the account group's
+// integrations with the names and categories of refs, by the IDs of refs
+func (c *Client) archiveIntegrationIDs(refs []IntegrationReference) (map[string]string, error) {
+	ids := map[string]string{}
+	if len(refs) == 0 {
+		return ids, nil
+	}
+	integrations, err := c.GetIntegrations()
+	if err != nil {
+		return nil, err
+	}
+	for _, ref := range refs {
+		for _, i := range *integrations {
+			if ref.Name != "" && i.GetIntegrationName() == ref.Name && i.Category == ref.Category {
+				ids[ref.ID] = i.GetIntegrationID()
+			}
+		}
+	}
+	return ids, nil
+}
+
+// remapNotifications replaces the integration IDs of an alert rule's
+// notifications, and returns the integrations it could not find
+func remapNotifications(rule *AlertRule, refs []IntegrationReference, ids map[string]string) []string {
+	var unresolved []string
+	remap := func(id **string) {
+		if *id == nil {
+			return
+		}
+		if mapped, ok := ids[**id]; ok {
+			*id = String(mapped)
+			return
+		}
+		name := **id
+		for _, ref := range refs {
+			if ref.ID == **id && ref.Name != "" {
+				name = ref.Name
+			}
+		}
+		unresolved = append(unresolved, fmt.Sprintf("integration %q", name))
+	}
+	notifications := rule.GetNotifications()
+	for i := range notifications.GetThirdParty() {
+		remap(&(*notifications.ThirdParty)[i].IntegrationID)
+	}
+	for i := range notifications.GetWebhook() {
+		remap(&(*notifications.Webhook)[i].IntegrationID)
+	}
+	return unresolved
+}
+
+// createArchiveObject creates an object read from an archive and returns
+// its ID
+func (c *Client) createArchiveObject(v interface{}) (string, error) {
+	switch o := v.(type) {
+	case *AccountGroupRole:
+		created, err := c.CreateRole(*o)
+		if err != nil {
+			return "", err
+		}
+		return strconv.Itoa(created.GetRoleID()), nil
+	case *GroupLabel:
+		created, err := c.CreateGroupLabel(*o)
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatInt(created.GetGroupID(), 10), nil
+	case *AlertRule:
+		created, err := c.CreateAlertRule(*o)
+		if err != nil {
+			return "", err
+		}
+		return strconv.Itoa(created.GetRuleID()), nil
+	}
+	created, err := c.createArchiveTest(v)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(created.(interface{ GetTestID() int64 }).GetTestID(), 10), nil
+}
+
+// newArchiveTest returns a pointer to a new test of the given type, or nil
+// if archives do not support it
+func newArchiveTest(testType string) interface{} {
+	switch testType {
+	case "agent-to-agent":
+		return &AgentAgent{}
+	case "agent-to-server":
+		return &AgentServer{}
+	case "bgp":
+		return &BGP{}
+	case "dns-dnssec":
+		return &DNSSec{}
+	case "dns-server":
+		return &DNSServer{}
+	case "dns-trace":
+		return &DNSTrace{}
+	case "ftp-server":
+		return &FTPServer{}
+	case "http-server":
+		return &HTTPServer{}
+	case "page-load":
+		return &PageLoad{}
+	case "sip-server":
+		return &SIPServer{}
+	case "voice":
+		return &RTPStream{}
+	case "voice-call":
+		return &VoiceCall{}
+	case "web-transactions":
+		return &WebTransaction{}
+	}
+	return nil
+}
+
+// getArchiveTest gets a test as the struct of its type
+func (c *Client) getArchiveTest(testType string, id int) (interface{}, error) {
+	switch testType {
+	case "agent-to-agent":
+		return c.GetAgentAgent(id)
+	case "agent-to-server":
+		return c.GetAgentServer(id)
+	case "bgp":
+		return c.GetBGP(id)
+	case "dns-dnssec":
+		return c.GetDNSSec(id)
+	case "dns-server":
+		return c.GetDNSServer(id)
+	case "dns-trace":
+		return c.GetDNSTrace(id)
+	case "ftp-server":
+		return c.GetFTPServer(id)
+	case "http-server":
+		return c.GetHTTPServer(id)
+	case "page-load":
+		return c.GetPageLoad(id)
+	case "sip-server":
+		return c.GetSIPServer(id)
+	case "voice":
+		return c.GetRTPStream(id)
+	case "voice-call":
+		return c.GetVoiceCall(id)
+	case "web-transactions":
+		return c.GetWebTransaction(id)
+	}
+	return nil, fmt.Errorf("unsupported test type %s", testType)
+}
+
+// createArchiveTest creates a test of one of the types newArchiveTest
+// returns
+func (c *Client) createArchiveTest(test interface{}) (interface{}, error) {
+	switch t := test.(type) {
+	case *AgentAgent:
+		return c.CreateAgentAgent(*t)
+	case *AgentServer:
+		return c.CreateAgentServer(*t)
+	case *BGP:
+		return c.CreateBGP(*t)
+	case *DNSSec:
+		return c.CreateDNSSec(*t)
+	case *DNSServer:
+		return c.CreateDNSServer(*t)
+	case *DNSTrace:
+		return c.CreateDNSTrace(*t)
+	case *FTPServer:
+		return c.CreateFTPServer(*t)
+	case *HTTPServer:
+		return c.CreateHTTPServer(*t)
+	case *PageLoad:
+		return c.CreatePageLoad(*t)
+	case *SIPServer:
+		return c.CreateSIPServer(*t)
+	case *RTPStream:
+		return c.CreateRTPStream(*t)
+	case *VoiceCall:
+		return c.CreateVoiceCall(*t)
+	case *WebTransaction:
+		return c.CreateWebTransaction(*t)
+	}
+	return nil, fmt.Errorf("unsupported test %T", test)
+}
+
+// clearFields sets the named fields of the struct v points to, where it
+// has them, to their zero values
+func clearFields(v interface{}, names ...string) {
+	s := reflect.ValueOf(v).Elem()
+	for _, name := range names {
+		if f := s.FieldByName(name); f.IsValid() && f.CanSet() {
+			f.Set(reflect.Zero(f.Type()))
+		}
+	}
+}
@@ -0,0 +1,277 @@
+package thousandeyes
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// archiveSourceServer serves an account group with one enterprise agent,
+// one custom role, one test label, one alert rule notifying a Slack
+// integration, one HTTP server test and one test of a type archives do not
+// support.
+func archiveSourceServer(t *testing.T) {
+	mux.HandleFunc("/agents.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"agents":[{"agentId":3,"agentName":"London","agentType":"Enterprise"},{"agentId":4,"agentName":"Tokyo","agentType":"Cloud"}]}`))
+	})
+	mux.HandleFunc("/roles.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "100", r.URL.Query().Get("aid"))
+		_, _ = w.Write([]byte(`{"roles":[{"roleId":1,"roleName":"Admin","builtin":1},{"roleId":5,"roleName":"Ops","builtin":0}]}`))
+	})
+	mux.HandleFunc("/roles/5.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"roles":[{"roleId":5,"roleName":"Ops","builtin":0,"permissions":[{"isManagementPermission":0,"label":"View tests","permissionId":31}]}]}`))
+	})
+	mux.HandleFunc("/groups.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"groups":[{"groupId":2,"name":"All","type":"tests","builtin":1},{"groupId":7,"name":"web","type":"tests","builtin":0}]}`))
+	})
+	mux.HandleFunc("/groups/7.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"groups":[{"groupId":7,"name":"web","type":"tests","builtin":0,"tests":[{"testId":11,"testName":"checkout"}]}]}`))
+	})
+	mux.HandleFunc("/integrations.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"integrations":{"thirdParty":[{"integrationId":"sl-1","integrationName":"ops-slack","integrationType":"SLACK","authToken":"secret"}]}}`))
+	})
+	mux.HandleFunc("/alert-rules.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"alertRules":[{"ruleId":9,"ruleName":"Latency"}]}`))
+	})
+	mux.HandleFunc("/alert-rules/9.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"alertRules":[{"ruleId":9,"ruleName":"Latency","expression":"((responseTime >= 500 ms))","testIds":[11],"notifications":{"thirdParty":[{"integrationId":"sl-1","integrationType":"SLACK"}]}}]}`))
+	})
+	mux.HandleFunc("/tests.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"test":[
+			{"testId":11,"testName":"checkout","type":"http-server"},
+			{"testId":12,"testName":"branch","type":"network"},
+			{"testId":13,"testName":"shared","type":"http-server","liveShare":1}
+		]}`))
+	})
+	mux.HandleFunc("/tests/11.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"test":[{"testId":11,"testName":"checkout","type":"http-server","createdBy":"a@example.com","url":"https://example.com","interval":60,"enabled":1,
+			"agents":[{"agentId":3,"agentName":"London"}],"alertRules":[{"ruleId":9,"ruleName":"Latency"}],"groups":[{"groupId":7,"name":"web"}]}]}`))
+	})
+}
+
+func TestClient_ExportAccountGroup(t *testing.T) {
+	setup()
+	defer teardown()
+	archiveSourceServer(t)
+
+	dir := t.TempDir()
+	client := &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	manifest, err := client.ExportAccountGroup("100", dir)
+	assert.Nil(t, err)
+	assert.Equal(t, ArchiveVersion, manifest.Version)
+	assert.Equal(t, "100", manifest.AccountGroupID)
+	assert.Equal(t, []IntegrationReference{{ID: "sl-1", Name: "ops-slack", Type: "SLACK", Category: IntegrationCategoryThirdParty}}, manifest.Integrations)
+	assert.Equal(t, []ArchiveObject{
+		{Kind: "role", ID: "5", Name: "Ops", File: "roles/5.yaml"},
+		{Kind: "label", ID: "7", Name: "web", File: "labels/7.yaml"},
+		{Kind: "alert-rule", ID: "9", Name: "Latency", File: "alert-rules/9.yaml"},
+		{Kind: "http-server", ID: "11", Name: "checkout", File: "tests/http-server/11.yaml"},
+	}, manifest.Objects)
+	assert.Equal(t, []ArchiveObject{{Kind: "network", ID: "12", Name: "branch"}}, manifest.Skipped)
+	assert.Equal(t, []AgentReference{{ID: 3, Name: "London"}}, manifest.Agents)
+
+	read, err := ReadArchiveManifest(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, manifest.Objects, read.Objects)
+	assert.Equal(t, manifest.Agents, read.Agents)
+
+	data, err := os.ReadFile(filepath.Join(dir, "tests", "http-server", "11.yaml"))
+	assert.Nil(t, err)
+	assert.Equal(t, `alertRules:
+    - Latency
+enabled: true
+groups:
+    - web
+testName: checkout
+agents:
+    - London
+interval: 60
+url: https://example.com
+`, string(data))
+
+	data, err = os.ReadFile(filepath.Join(dir, "labels", "7.yaml"))
+	assert.Nil(t, err)
+	assert.Equal(t, "name: web\ntype: tests\n", string(data))
+
+	data, err = os.ReadFile(filepath.Join(dir, "alert-rules", "9.yaml"))
+	assert.Nil(t, err)
+	assert.NotContains(t, string(data), "testIds")
+	assert.Contains(t, string(data), "integrationId: sl-1")
+}
+
+func TestReadArchiveManifest(t *testing.T) {
+	dir := t.TempDir()
+	write := func(manifest string) {
+		assert.Nil(t, os.WriteFile(filepath.Join(dir, "manifest.json"), []byte(manifest), 0644))
+	}
+	write(`{"version":2,"objects":[]}`)
+	_, err := ReadArchiveManifest(dir)
+	assert.EqualError(t, err, "unsupported archive version 2")
+
+	write(`{"version":1,"objects":[{"kind":"role","id":"1","file":"../roles/1.yaml"}]}`)
+	_, err = ReadArchiveManifest(dir)
+	assert.EqualError(t, err, `invalid file "../roles/1.yaml" for role 1`)
+}
+
+// archiveTargetServer serves an empty account group with the London agent
+// and the ops-slack integration.  Objects created through the API are
+// returned by later list calls.  Creating tests fails while *fail is set.
+func archiveTargetServer(t *testing.T, fail *bool) *[]string {
+	var calls []string
+	ruleCreated, labelCreated := false, false
+	mux.HandleFunc("/roles.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "200", r.URL.Query().Get("aid"))
+		_, _ = w.Write([]byte(`{"roles":[{"roleId":1,"roleName":"Admin","builtin":1}]}`))
+	})
+	mux.HandleFunc("/groups.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"groups":[]}`))
+	})
+	mux.HandleFunc("/groups/tests.json", func(w http.ResponseWriter, r *http.Request) {
+		if labelCreated {
+			_, _ = w.Write([]byte(`{"groups":[{"groupId":60,"name":"web","type":"tests"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"groups":[]}`))
+	})
+	mux.HandleFunc("/alert-rules.json", func(w http.ResponseWriter, r *http.Request) {
+		if ruleCreated {
+			_, _ = w.Write([]byte(`{"alertRules":[{"ruleId":70,"ruleName":"Latency"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"alertRules":[]}`))
+	})
+	mux.HandleFunc("/tests.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"test":[]}`))
+	})
+	mux.HandleFunc("/agents.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"agents":[{"agentId":30,"agentName":"London"}]}`))
+	})
+	mux.HandleFunc("/integrations.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"integrations":{"thirdParty":[{"integrationId":"sl-2","integrationName":"ops-slack","integrationType":"SLACK"}]}}`))
+	})
+
+	mux.HandleFunc("/roles/new.json", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"roleName":"Ops","permissions":[{"isManagementPermission":0,"label":"View tests","permissionId":31}]}`, string(body))
+		calls = append(calls, "create role")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"roleId":50,"roleName":"Ops"}`))
+	})
+	mux.HandleFunc("/groups/tests/new.json", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "create label")
+		labelCreated = true
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"groups":[{"groupId":60,"name":"web","type":"tests"}]}`))
+	})
+	mux.HandleFunc("/alert-rules/new.json", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"ruleName":"Latency","expression":"((responseTime >= 500 ms))","notifications":{"thirdParty":[{"integrationId":"sl-2","integrationType":"SLACK"}]}}`, string(body))
+		calls = append(calls, "create alert rule")
+		ruleCreated = true
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"alertRuleId":70,"ruleName":"Latency"}`))
+	})
+	mux.HandleFunc("/tests/http-server/new.json", func(w http.ResponseWriter, r *http.Request) {
+		if *fail {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errorMessage":"test quota exceeded"}`))
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"testName":"checkout","url":"https://example.com","interval":60,"enabled":1,
+			"agents":[{"agentId":30,"agentName":"London"}],"alertRules":[{"ruleId":70,"ruleName":"Latency"}],"groups":[{"groupId":60,"name":"web"}]}`, string(body))
+		calls = append(calls, "create http-server")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"test":[{"testId":80,"testName":"checkout"}]}`))
+	})
+	return &calls
+}
+
+func TestClient_ImportAccountGroup(t *testing.T) {
+	setup()
+	archiveSourceServer(t)
+	dir := t.TempDir()
+	client := &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	_, err := client.ExportAccountGroup("100", dir)
+	assert.Nil(t, err)
+	teardown()
+
+	setup()
+	defer teardown()
+	fail := false
+	calls := archiveTargetServer(t, &fail)
+	client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+
+	report, err := client.ImportAccountGroup(dir, "200", ImportOptions{DryRun: true})
+	assert.Nil(t, err)
+	assert.True(t, report.DryRun)
+	assert.True(t, report.Complete())
+	assert.Nil(t, report.UnassignedAgents)
+	assert.Len(t, report.Results, 4)
+	for _, r := range report.Results {
+		assert.Equal(t, ImportCreate, r.Action)
+		assert.Equal(t, "", r.TargetID)
+	}
+	assert.Empty(t, *calls)
+
+	// The first import stops at the test, and the second resumes from the
+	// progress log.
+	progress := filepath.Join(t.TempDir(), "progress.log")
+	fail = true
+	report, err = client.ImportAccountGroup(dir, "200", ImportOptions{ProgressLog: progress})
+	assert.EqualError(t, err, `Could not import http-server "checkout": Failed call API endpoint. HTTP response code: 400. Error: test quota exceeded`)
+	assert.Len(t, report.Results, 3)
+	assert.Equal(t, []string{"create role", "create label", "create alert rule"}, *calls)
+	log, err := os.ReadFile(progress)
+	assert.Nil(t, err)
+	assert.Equal(t, "role\t5\t50\nlabel\t7\t60\nalert-rule\t9\t70\n", string(log))
+
+	fail = false
+	report, err = client.ImportAccountGroup(dir, "200", ImportOptions{ProgressLog: progress})
+	assert.Nil(t, err)
+	var actions []string
+	for _, r := range report.Results {
+		actions = append(actions, string(r.Action)+" "+r.Kind+" "+r.TargetID)
+	}
+	assert.Equal(t, []string{"resumed role 50", "resumed label 60", "resumed alert-rule 70", "create http-server 80"}, actions)
+	assert.Equal(t, "create http-server", (*calls)[3])
+}
+
+func TestClient_ImportAccountGroupUnresolved(t *testing.T) {
+	setup()
+	defer teardown()
+	fail := false
+	archiveTargetServer(t, &fail)
+
+	dir := t.TempDir()
+	manifest := ArchiveManifest{
+		Version:      1,
+		Agents:       []AgentReference{{ID: 3, Name: "London"}, {ID: 5, Name: "Paris"}},
+		Integrations: []IntegrationReference{{ID: "pd-1", Name: "pager", Type: "PAGER_DUTY", Category: IntegrationCategoryThirdParty}},
+		Objects: []ArchiveObject{
+			{Kind: "alert-rule", ID: "1", Name: "Loss", File: "rule.yaml"},
+			{Kind: "http-server", ID: "2", Name: "api", File: "test.yaml"},
+		},
+	}
+	data, _ := json.Marshal(manifest)
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "manifest.json"), data, 0644))
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "rule.yaml"), []byte("ruleName: Loss\nnotifications:\n  thirdParty:\n    - integrationId: pd-1\n"), 0644))
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "test.yaml"), []byte("testName: api\nagents: [Paris]\nalertRules: [Loss]\n"), 0644))
+
+	client := &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	report, err := client.ImportAccountGroup(dir, "200", ImportOptions{DryRun: true})
+	assert.Nil(t, err)
+	assert.False(t, report.Complete())
+	assert.Equal(t, []string{"Paris"}, report.UnassignedAgents)
+	assert.Equal(t, []string{`integration "pager"`}, report.Results[0].Unresolved)
+	// The alert rule is in the archive, so only the agent is missing.
+	assert.Equal(t, []string{`agent "Paris"`}, report.Results[1].Unresolved)
+
+	_, err = client.ImportAccountGroup(dir, "200", ImportOptions{})
+	assert.EqualError(t, err, `Could not import alert-rule "Loss": not found: integration "pager"`)
+}
@@ -0,0 +1,213 @@
+package thousandeyes
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// auditTimeFormat is the timestamp format used in audit event responses
+const auditTimeFormat = "2006-01-02 15:04:05"
+
+// auditQueryTimeFormat is the timestamp format of the from and to parameters
+const auditQueryTimeFormat = "2006-01-02T15:04:05"
+
+// AuditEvent - a user action recorded in the activity log
+type AuditEvent struct {
+	AID              *int    `json:"aid,omitempty"`
+	AccountGroupName *string `json:"accountGroupName,omitempty"`
+	Date             *string `json:"date,omitempty"`
+	Event            *string `json:"event,omitempty"`
+	IPAddress        *string `json:"ipAddress,omitempty"`
+	ResourceType     *string `json:"resourceType,omitempty"`
+	UID              *int    `json:"uid,omitempty"`
+	User             *string `json:"user,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// AuditEventsPage - a single page of audit events
+type AuditEventsPage struct {
+	From        *string      `json:"from,omitempty"`
+	To          *string      `json:"to,omitempty"`
+	AuditEvents []AuditEvent `json:"auditEvents,omitempty"`
+	Pages       *struct {
+		Current *int    `json:"current,omitempty"`
+		Next    *string `json:"next,omitempty"`
+	} `json:"pages,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// Time - parse the event date, which the API reports in UTC
+func (e AuditEvent) Time() (time.Time, error) {
+	if e.Date == nil {
+		return time.Time{}, fmt.Errorf("audit event has no date")
+	}
+	return time.ParseInLocation(auditTimeFormat, *e.Date, time.UTC)
+}
+
+// key identifies an event among events sharing the same timestamp.
+func (e AuditEvent) key() string {
+	str := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+	num := func(i *int) string {
+		if i == nil {
+			return ""
+		}
+		return strconv.Itoa(*i)
+	}
+	return fmt.Sprintf("%s|%s|%s|%s|%s|%s|%s", str(e.Date), num(e.AID), num(e.UID), str(e.User), str(e.IPAddress), str(e.ResourceType), str(e.Event))
+}
+
+// GetAuditEvents - get a single page of audit events between from and to.
+// Pages are numbered from 1.
+func (c *Client) GetAuditEvents(from, to time.Time, page int) (*AuditEventsPage, error) {
+	query := url.Values{}
+	query.Set("from", from.UTC().Format(auditQueryTimeFormat))
+	query.Set("to", to.UTC().Format(auditQueryTimeFormat))
+	if page > 1 {
+		query.Set("page", strconv.Itoa(page))
+	}
+	resp, err := c.getWithQuery("/audit-user-events", query)
+	if err != nil {
+		return nil, err
+	}
+	var target AuditEventsPage
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	return &target, nil
+}
+
+// AuditEventCursor - the position of an audit event collector.  It can be
+// persisted (for example as JSON) and passed to ResumeAuditEvents so that
+// the next run neither misses nor repeats events.
+type AuditEventCursor struct {
+	// From is the timestamp of the newest event seen so far.
+	From time.Time `json:"from"`
+	// Seen holds the events at From which were already returned, because
+	// timestamps only have a resolution of one second.
+	Seen []string `json:"seen,omitempty"`
+}
+
+// AuditEventIterator - iterates over audit events in a time window,
+// fetching pages on demand.
+//
+//	it := client.AuditEvents(from, to)
+//	for it.Next() {
+//		event := it.Event()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+//	cursor := it.Cursor()
+type AuditEventIterator struct {
+	client  *Client
+	from    time.Time
+	to      time.Time
+	page    int
+	done    bool
+	buffer  []AuditEvent
+	current AuditEvent
+	err     error
+	skip    map[string]bool
+	cursor  AuditEventCursor
+}
+
+// AuditEvents - iterate over audit events between from and to
+func (c *Client) AuditEvents(from, to time.Time) *AuditEventIterator {
+	return &AuditEventIterator{
+		client: c,
+		from:   from,
+		to:     to,
+		skip:   map[string]bool{},
+		cursor: AuditEventCursor{From: from},
+	}
+}
+
+// ResumeAuditEvents - iterate over audit events from a saved cursor up to to,
+// skipping events already returned before the cursor was saved.
+func (c *Client) ResumeAuditEvents(cursor AuditEventCursor, to time.Time) *AuditEventIterator {
+	it := c.AuditEvents(cursor.From, to)
+	for _, k := range cursor.Seen {
+		it.skip[k] = true
+	}
+	it.cursor = AuditEventCursor{From: cursor.From, Seen: append([]string{}, cursor.Seen...)}
+	return it
+}
+
+// Next advances to the next event.  It returns false when the window is
+// exhausted or an error occurred.
+func (it *AuditEventIterator) Next() bool {
+	for {
+		if it.err != nil {
+			return false
+		}
+		if len(it.buffer) == 0 {
+			if it.done {
+				return false
+			}
+			it.fetch()
+			continue
+		}
+
+		event := it.buffer[0]
+		it.buffer = it.buffer[1:]
+		eventTime, err := event.Time()
+		if err != nil {
+			it.err = err
+			return false
+		}
+		if eventTime.Before(it.from) || it.skip[event.key()] {
+			continue
+		}
+
+		switch {
+		case eventTime.After(it.cursor.From):
+			it.cursor = AuditEventCursor{From: eventTime, Seen: []string{event.key()}}
+		case eventTime.Equal(it.cursor.From):
+			it.cursor.Seen = append(it.cursor.Seen, event.key())
+		}
+		it.current = event
+		return true
+	}
+}
+
+func (it *AuditEventIterator) fetch() {
+	it.page++
+	page, err := it.client.GetAuditEvents(it.from, it.to, it.page)
+	if err != nil {
+		it.err = err
+		return
+	}
+	it.buffer = page.AuditEvents
+	if page.Pages == nil || page.Pages.Next == nil || *page.Pages.Next == "" || len(page.AuditEvents) == 0 {
+		it.done = true
+	}
+}
+
+// Event returns the current event.
+func (it *AuditEventIterator) Event() AuditEvent {
+	return it.current
+}
+
+// Err returns the error that stopped iteration, if any.
+func (it *AuditEventIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns the position after the newest event returned so far.
+// Because the API does not guarantee event order within a window, the
+// cursor should be persisted once Next has returned false without error.
+func (it *AuditEventIterator) Cursor() AuditEventCursor {
+	return AuditEventCursor{From: it.cursor.From, Seen: append([]string{}, it.cursor.Seen...)}
+}
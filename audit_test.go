@@ -0,0 +1,115 @@
+package thousandeyes
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func auditTestServer(t *testing.T) {
+	mux.HandleFunc("/audit-user-events.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "2022-06-02T00:00:00", r.URL.Query().Get("to"))
+		switch r.URL.Query().Get("page") {
+		case "":
+			_, _ = w.Write([]byte(`{"auditEvents":[
+				{"aid":1,"accountGroupName":"Default","date":"2022-06-01 10:00:00","event":"Test created","ipAddress":"10.0.0.1","uid":5,"user":"Alice"},
+				{"aid":1,"accountGroupName":"Default","date":"2022-06-01 11:00:00","event":"Test updated","ipAddress":"10.0.0.1","uid":5,"user":"Alice"}
+			],"pages":{"current":1,"next":"https://api.thousandeyes.com/v6/audit-user-events.json?page=2"}}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"auditEvents":[
+				{"aid":1,"accountGroupName":"Default","date":"2022-06-01 11:00:00","event":"Alert rule deleted","ipAddress":"10.0.0.2","uid":6,"user":"Bob"}
+			],"pages":{"current":2}}`))
+		default:
+			t.Fatalf("unexpected page %s", r.URL.Query().Get("page"))
+		}
+	})
+}
+
+func TestClient_GetAuditEvents(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	auditTestServer(t)
+
+	from := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	res, err := client.GetAuditEvents(from, from.Add(24*time.Hour), 2)
+	teardown()
+	assert.Nil(t, err)
+	expected := []AuditEvent{
+		{
+			AID:              Int(1),
+			AccountGroupName: String("Default"),
+			Date:             String("2022-06-01 11:00:00"),
+			Event:            String("Alert rule deleted"),
+			IPAddress:        String("10.0.0.2"),
+			UID:              Int(6),
+			User:             String("Bob"),
+		},
+	}
+	assert.Equal(t, expected, res.AuditEvents)
+}
+
+func TestClient_AuditEvents(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	auditTestServer(t)
+
+	from := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	it := client.AuditEvents(from, to)
+	var events []string
+	for it.Next() {
+		events = append(events, *it.Event().Event)
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []string{"Test created", "Test updated", "Alert rule deleted"}, events)
+
+	cursor := it.Cursor()
+	assert.Equal(t, time.Date(2022, 6, 1, 11, 0, 0, 0, time.UTC), cursor.From)
+	assert.Len(t, cursor.Seen, 2)
+
+	// Resuming from the cursor must not repeat events, even though the
+	// window starts at the second of the last event returned.
+	it = client.ResumeAuditEvents(cursor, to)
+	events = nil
+	for it.Next() {
+		events = append(events, *it.Event().Event)
+	}
+	teardown()
+	assert.Nil(t, it.Err())
+	assert.Nil(t, events)
+	assert.Equal(t, cursor, it.Cursor())
+}
+
+func TestClient_AuditEventsError(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/audit-user-events.json", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	from := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	it := client.AuditEvents(from, from.Add(time.Hour))
+	assert.False(t, it.Next())
+	teardown()
+	assert.Error(t, it.Err())
+}
+
+func TestAuditEvent_Key(t *testing.T) {
+	alice := AuditEvent{Date: String("2022-06-01 10:00:00"), Event: String("Test updated"), User: String("Alice")}
+	bob := alice
+	bob.User = String("Bob")
+	assert.NotEqual(t, alice.key(), bob.key())
+}
+
+func TestAuditEvent_Time(t *testing.T) {
+	event := AuditEvent{Date: String("2022-06-01 10:00:00")}
+	eventTime, err := event.Time()
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC), eventTime)
+
+	_, err = AuditEvent{}.Time()
+	assert.EqualError(t, err, "audit event has no date")
+}
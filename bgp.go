@@ -30,40 +30,17 @@ type BGP struct {
 	IncludeCoveredPrefixes *bool         `json:"includeCoveredPrefixes,omitempty" te:"int-bool"`
 	Prefix                 *string       `json:"prefix,omitempty"`
 	UsePublicBGP           *bool         `json:"usePublicBgp,omitempty" te:"int-bool"`
-}
-
-// MarshalJSON implements the json.Marshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t BGP) MarshalJSON() ([]byte, error) {
-	type aliasTest BGP
 
-	data, err := json.Marshal((aliasTest)(t))
-	if err != nil {
-		return nil, err
-	}
-
-	return jsonBoolToInt(&t, data)
-}
-
-// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t *BGP) UnmarshalJSON(data []byte) error {
-	type aliasTest BGP
-	test := (*aliasTest)(t)
-
-	data, err := jsonIntToBool(t, data)
-	if err != nil {
-		return err
-	}
-
-	return json.Unmarshal(data, &test)
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // AddAlertRule - Adds an alert to agent test
 func (t *BGP) AddAlertRule(id int) {
 	alertRule := AlertRule{RuleID: Int(id)}
+	if t.AlertRules == nil {
+		t.AlertRules = &[]AlertRule{}
+	}
 	*t.AlertRules = append(*t.AlertRules, alertRule)
 }
 
@@ -1,6 +1,9 @@
 package thousandeyes
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+)
 
 // BGPMonitors - list of bgp montors
 type BGPMonitors []BGPMonitor
@@ -12,6 +15,10 @@ type BGPMonitor struct {
 	Network     *string `json:"network,omitempty"`
 	MonitorType *string `json:"monitorType,omitempty"`
 	MonitorName *string `json:"monitorName,omitempty"`
+	CountryID   *string `json:"countryId,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetBPGMonitors - Get bgp monitors
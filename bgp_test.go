@@ -1,6 +1,7 @@
 package thousandeyes
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
 
@@ -63,6 +64,11 @@ func TestClient_GetBGP(t *testing.T) {
 				Rel:  String("data"),
 			},
 		},
+		Extra: map[string]json.RawMessage{
+			"agents":    json.RawMessage(`[{"agentId":48620,"agentName":"Seattle, WA (Trial) - IPv6","agentType":"Cloud","countryId":"US","ipAddresses":["135.84.184.153"],"location":"Seattle Area","network":"Astute Hosting Inc. (AS 54527)","prefix":"135.84.184.0/22"}]`),
+			"interval":  json.RawMessage(`300`),
+			"probeMode": json.RawMessage(`"AUTO"`),
+		},
 	}
 
 	res, err := client.GetBGP(122621)
@@ -135,6 +141,11 @@ func TestClient_CreateBGP(t *testing.T) {
 				Rel:  String("data"),
 			},
 		},
+		Extra: map[string]json.RawMessage{
+			"agents":    json.RawMessage(`[{"agentId":48620,"agentName":"Seattle, WA (Trial) - IPv6","agentType":"Cloud","countryId":"US","ipAddresses":["135.84.184.153"],"location":"Seattle Area","network":"Astute Hosting Inc. (AS 54527)","prefix":"135.84.184.0/22"}]`),
+			"interval":  json.RawMessage(`300`),
+			"probeMode": json.RawMessage(`"AUTO"`),
+		},
 	}
 	create := BGP{
 		TestName: String("test1"),
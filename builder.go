@@ -0,0 +1,499 @@
+package thousandeyes
+
+// The New...Test functions start building a test of each type.  Required
+// fields are arguments; the rest are set by fluent methods, and Build
+// validates the test and returns it, ready to pass to the Create method of
+// the type.  For example:
+//
+//	test, err := thousandeyes.NewHTTPServerTest("web", "https://example.com").
+//		Interval(60).
+//		Agents(1, 2).
+//		AlertRules(10).
+//		Build()
+//
+// Fields without a builder method can be set with With.  The methods
+// common to every builder are generated into builders_gen.go by
+// internal/cmd/buildergen; run go generate after adding a builder.
+
+// DefaultTestInterval - the interval, in seconds, of built tests.  These
+// common defaults apply to tests of every type: they are enabled, with
+// alerts enabled, and run every DefaultTestInterval seconds.  Some types
+// have further defaults, listed on their New...Test function.
+const DefaultTestInterval = 300
+
+// addAgents appends agents with the given IDs to *agents, allocating it if
+// needed.
+func addAgents(agents **[]Agent, ids []int) {
+	if *agents == nil {
+		*agents = &[]Agent{}
+	}
+	for _, id := range ids {
+		**agents = append(**agents, Agent{AgentID: Int(id)})
+	}
+}
+
+// addAlertRules appends alert rules with the given IDs to *rules,
+// allocating it if needed.
+func addAlertRules(rules **[]AlertRule, ids []int) {
+	if *rules == nil {
+		*rules = &[]AlertRule{}
+	}
+	for _, id := range ids {
+		**rules = append(**rules, AlertRule{RuleID: Int(id)})
+	}
+}
+
+// addGroups appends labels with the given IDs to *groups, allocating it if
+// needed.
+func addGroups(groups **[]GroupLabel, ids []int64) {
+	if *groups == nil {
+		*groups = &[]GroupLabel{}
+	}
+	for _, id := range ids {
+		**groups = append(**groups, GroupLabel{GroupID: Int64(id)})
+	}
+}
+
+// buildTest validates a copy of test, for the Build methods.  The copy
+// shares no pointers with test, so the builder can be used again.
+func buildTest[T interface{ Validate() error }](test T) (T, error) {
+	var built T
+	if err := copyState(test, &built); err != nil {
+		return built, err
+	}
+	return built, built.Validate()
+}
+
+// AgentAgentBuilder - builds an agent to agent test.  Create one with
+// NewAgentAgentTest.
+type AgentAgentBuilder struct {
+	test AgentAgent
+}
+
+// NewAgentAgentTest - start building an agent to agent test.  Beyond the
+// common defaults, it sets Protocol TCP and Port 49153.
+func NewAgentAgentTest(name string, targetAgentID int) *AgentAgentBuilder {
+	return &AgentAgentBuilder{test: AgentAgent{
+		TestName:      String(name),
+		TargetAgentID: Int(targetAgentID),
+		Enabled:       Bool(true),
+		AlertsEnabled: Bool(true),
+		Interval:      Int(DefaultTestInterval),
+		Protocol:      ProtocolTCP.Ptr(),
+		Port:          Int(49153),
+	}}
+}
+
+// Protocol - set the protocol of the test traffic
+func (b *AgentAgentBuilder) Protocol(protocol Protocol) *AgentAgentBuilder {
+	b.test.Protocol = protocol.Ptr()
+	return b
+}
+
+// Port - set the port of the target agent
+func (b *AgentAgentBuilder) Port(port int) *AgentAgentBuilder {
+	b.test.Port = Int(port)
+	return b
+}
+
+// Direction - set the direction of the test traffic
+func (b *AgentAgentBuilder) Direction(direction Direction) *AgentAgentBuilder {
+	b.test.Direction = direction.Ptr()
+	return b
+}
+
+// AgentServerBuilder - builds an agent to server test.  Create one with
+// NewAgentServerTest.
+type AgentServerBuilder struct {
+	test AgentServer
+}
+
+// NewAgentServerTest - start building an agent to server test.  Beyond the
+// common defaults, it sets Protocol TCP.
+func NewAgentServerTest(name, server string) *AgentServerBuilder {
+	return &AgentServerBuilder{test: AgentServer{
+		TestName:      String(name),
+		Server:        String(server),
+		Enabled:       Bool(true),
+		AlertsEnabled: Bool(true),
+		Interval:      Int(DefaultTestInterval),
+		Protocol:      ProtocolTCP.Ptr(),
+	}}
+}
+
+// Protocol - set the protocol of network measurements
+func (b *AgentServerBuilder) Protocol(protocol Protocol) *AgentServerBuilder {
+	b.test.Protocol = protocol.Ptr()
+	return b
+}
+
+// Port - set the port of the server
+func (b *AgentServerBuilder) Port(port int) *AgentServerBuilder {
+	b.test.Port = Int(port)
+	return b
+}
+
+// BGPBuilder - builds a BGP test.  Create one with
+// NewBGPTest.
+type BGPBuilder struct {
+	test BGP
+}
+
+// NewBGPTest - start building a BGP test.
+func NewBGPTest(name, prefix string) *BGPBuilder {
+	return &BGPBuilder{test: BGP{
+		TestName:      String(name),
+		Prefix:        String(prefix),
+		Enabled:       Bool(true),
+		AlertsEnabled: Bool(true),
+	}}
+}
+
+// IncludeCoveredPrefixes - set whether more specific prefixes are monitored
+func (b *BGPBuilder) IncludeCoveredPrefixes(include bool) *BGPBuilder {
+	b.test.IncludeCoveredPrefixes = Bool(include)
+	return b
+}
+
+// UsePublicBGP - set whether public BGP monitors are used
+func (b *BGPBuilder) UsePublicBGP(use bool) *BGPBuilder {
+	b.test.UsePublicBGP = Bool(use)
+	return b
+}
+
+// DNSSecBuilder - builds a DNSSEC test.  Create one with
+// NewDNSSecTest.
+type DNSSecBuilder struct {
+	test DNSSec
+}
+
+// NewDNSSecTest - start building a DNSSEC test.
+func NewDNSSecTest(name, domain string) *DNSSecBuilder {
+	return &DNSSecBuilder{test: DNSSec{
+		TestName:      String(name),
+		Domain:        String(domain),
+		Enabled:       Bool(true),
+		AlertsEnabled: Bool(true),
+		Interval:      Int(DefaultTestInterval),
+	}}
+}
+
+// DNSServerBuilder - builds a DNS server test.  Create one with
+// NewDNSServerTest.
+type DNSServerBuilder struct {
+	test DNSServer
+}
+
+// NewDNSServerTest - start building a DNS server test.
+func NewDNSServerTest(name, domain string, servers ...string) *DNSServerBuilder {
+	b := &DNSServerBuilder{test: DNSServer{
+		TestName:      String(name),
+		Domain:        String(domain),
+		Enabled:       Bool(true),
+		AlertsEnabled: Bool(true),
+		Interval:      Int(DefaultTestInterval),
+	}}
+	return b.DNSServers(servers...)
+}
+
+// DNSServers - add the DNS servers to query, by name
+func (b *DNSServerBuilder) DNSServers(names ...string) *DNSServerBuilder {
+	if b.test.DNSServers == nil {
+		b.test.DNSServers = &[]Server{}
+	}
+	for _, name := range names {
+		*b.test.DNSServers = append(*b.test.DNSServers, Server{ServerName: String(name)})
+	}
+	return b
+}
+
+// DNSTransportProtocol - set the transport of DNS queries
+func (b *DNSServerBuilder) DNSTransportProtocol(protocol DNSTransportProtocol) *DNSServerBuilder {
+	b.test.DNSTransportProtocol = protocol.Ptr()
+	return b
+}
+
+// RecursiveQueries - set whether queries are recursive
+func (b *DNSServerBuilder) RecursiveQueries(recursive bool) *DNSServerBuilder {
+	b.test.RecursiveQueries = Bool(recursive)
+	return b
+}
+
+// DNSTraceBuilder - builds a DNS trace test.  Create one with
+// NewDNSTraceTest.
+type DNSTraceBuilder struct {
+	test DNSTrace
+}
+
+// NewDNSTraceTest - start building a DNS trace test.
+func NewDNSTraceTest(name, domain string) *DNSTraceBuilder {
+	return &DNSTraceBuilder{test: DNSTrace{
+		TestName:      String(name),
+		Domain:        String(domain),
+		Enabled:       Bool(true),
+		AlertsEnabled: Bool(true),
+		Interval:      Int(DefaultTestInterval),
+	}}
+}
+
+// DNSTransportProtocol - set the transport of DNS queries
+func (b *DNSTraceBuilder) DNSTransportProtocol(protocol DNSTransportProtocol) *DNSTraceBuilder {
+	b.test.DNSTransportProtocol = protocol.Ptr()
+	return b
+}
+
+// FTPServerBuilder - builds an FTP server test.  Create one with
+// NewFTPServerTest.
+type FTPServerBuilder struct {
+	test FTPServer
+}
+
+// NewFTPServerTest - start building an FTP server test.  Beyond the common
+// defaults, it sets RequestType Download.
+func NewFTPServerTest(name, url, username, password string) *FTPServerBuilder {
+	return &FTPServerBuilder{test: FTPServer{
+		TestName:      String(name),
+		URL:           String(url),
+		Username:      String(username),
+		Password:      String(password),
+		Enabled:       Bool(true),
+		AlertsEnabled: Bool(true),
+		Interval:      Int(DefaultTestInterval),
+		RequestType:   String("Download"),
+	}}
+}
+
+// RequestType - set the request, one of Download, Upload or List
+func (b *FTPServerBuilder) RequestType(requestType string) *FTPServerBuilder {
+	b.test.RequestType = String(requestType)
+	return b
+}
+
+// FTPTimeLimit - set the time limit of the request, in seconds
+func (b *FTPServerBuilder) FTPTimeLimit(seconds int) *FTPServerBuilder {
+	b.test.FTPTimeLimit = Int(seconds)
+	return b
+}
+
+// HTTPServerBuilder - builds an HTTP server test.  Create one with
+// NewHTTPServerTest.
+type HTTPServerBuilder struct {
+	test HTTPServer
+}
+
+// NewHTTPServerTest - start building an HTTP server test.
+func NewHTTPServerTest(name, url string) *HTTPServerBuilder {
+	return &HTTPServerBuilder{test: HTTPServer{
+		TestName:      String(name),
+		URL:           String(url),
+		Enabled:       Bool(true),
+		AlertsEnabled: Bool(true),
+		Interval:      Int(DefaultTestInterval),
+	}}
+}
+
+// HTTPTimeLimit - set the time limit of the request, in seconds
+func (b *HTTPServerBuilder) HTTPTimeLimit(seconds int) *HTTPServerBuilder {
+	b.test.HTTPTimeLimit = Int(seconds)
+	return b
+}
+
+// DesiredStatusCode - set the expected status code, or "default" for any 2xx or 3xx code
+func (b *HTTPServerBuilder) DesiredStatusCode(code string) *HTTPServerBuilder {
+	b.test.DesiredStatusCode = String(code)
+	return b
+}
+
+// FollowRedirects - set whether redirects are followed
+func (b *HTTPServerBuilder) FollowRedirects(follow bool) *HTTPServerBuilder {
+	b.test.FollowRedirects = Bool(follow)
+	return b
+}
+
+// VerifyCertificate - set whether the server's certificate is verified
+func (b *HTTPServerBuilder) VerifyCertificate(verify bool) *HTTPServerBuilder {
+	b.test.VerifyCertificate = Bool(verify)
+	return b
+}
+
+// ContentRegex - set a regular expression the response must match
+func (b *HTTPServerBuilder) ContentRegex(regex string) *HTTPServerBuilder {
+	b.test.ContentRegex = String(regex)
+	return b
+}
+
+// PageLoadBuilder - builds a page load test.  Create one with
+// NewPageLoadTest.
+type PageLoadBuilder struct {
+	test PageLoad
+}
+
+// NewPageLoadTest - start building a page load test.
+func NewPageLoadTest(name, url string) *PageLoadBuilder {
+	return &PageLoadBuilder{test: PageLoad{
+		TestName:      String(name),
+		URL:           String(url),
+		Enabled:       Bool(true),
+		AlertsEnabled: Bool(true),
+		Interval:      Int(DefaultTestInterval),
+	}}
+}
+
+// HTTPInterval - set how often the HTTP server part of the test runs, in seconds
+func (b *PageLoadBuilder) HTTPInterval(seconds int) *PageLoadBuilder {
+	b.test.HTTPInterval = Int(seconds)
+	return b
+}
+
+// PageLoadTimeLimit - set the time limit of the page load, in seconds
+func (b *PageLoadBuilder) PageLoadTimeLimit(seconds int) *PageLoadBuilder {
+	b.test.PageLoadTimeLimit = Int(seconds)
+	return b
+}
+
+// RTPStreamBuilder - builds an RTP stream test.  Create one with
+// NewRTPStreamTest.
+type RTPStreamBuilder struct {
+	test RTPStream
+}
+
+// NewRTPStreamTest - start building an RTP stream test.  Beyond the common
+// defaults, it sets Codec G.711 and DSCP EF.
+func NewRTPStreamTest(name string, targetAgentID int) *RTPStreamBuilder {
+	return &RTPStreamBuilder{test: RTPStream{
+		TestName:      String(name),
+		TargetAgentID: Int(targetAgentID),
+		Enabled:       Bool(true),
+		AlertsEnabled: Bool(true),
+		Interval:      Int(DefaultTestInterval),
+		Codec:         CodecG711.Ptr(),
+		DSCP:          DSCPEF.Ptr(),
+	}}
+}
+
+// Codec - set the simulated codec
+func (b *RTPStreamBuilder) Codec(codec Codec) *RTPStreamBuilder {
+	b.test.Codec = codec.Ptr()
+	return b
+}
+
+// DSCP - set the DSCP marking of the stream
+func (b *RTPStreamBuilder) DSCP(dscp DSCP) *RTPStreamBuilder {
+	b.test.DSCP = dscp.Ptr()
+	return b
+}
+
+// Duration - set the duration of the stream, in seconds
+func (b *RTPStreamBuilder) Duration(seconds int) *RTPStreamBuilder {
+	b.test.Duration = Int(seconds)
+	return b
+}
+
+// SIPServerBuilder - builds a SIP server test.  Create one with
+// NewSIPServerTest.
+type SIPServerBuilder struct {
+	test SIPServer
+}
+
+// NewSIPServerTest - start building a SIP server test.
+func NewSIPServerTest(name string, target SIPAuthData) *SIPServerBuilder {
+	return &SIPServerBuilder{test: SIPServer{
+		TestName:             String(name),
+		TargetSIPCredentials: &target,
+		Enabled:              Bool(true),
+		AlertsEnabled:        Bool(true),
+		Interval:             Int(DefaultTestInterval),
+	}}
+}
+
+// RegisterEnabled - set whether the test registers with the server
+func (b *SIPServerBuilder) RegisterEnabled(register bool) *SIPServerBuilder {
+	b.test.RegisterEnabled = Bool(register)
+	return b
+}
+
+// SIPTimeLimit - set the time limit of SIP requests, in seconds
+func (b *SIPServerBuilder) SIPTimeLimit(seconds int) *SIPServerBuilder {
+	b.test.SIPTimeLimit = Int(seconds)
+	return b
+}
+
+// VoiceCallBuilder - builds a voice call test.  Create one with
+// NewVoiceCallTest.
+type VoiceCallBuilder struct {
+	test VoiceCall
+}
+
+// NewVoiceCallTest - start building a voice call test.  Beyond the common
+// defaults, it sets Codec G.711 and DSCP EF.
+func NewVoiceCallTest(name string, targetAgentID int) *VoiceCallBuilder {
+	return &VoiceCallBuilder{test: VoiceCall{
+		TestName:      String(name),
+		TargetAgentID: Int(targetAgentID),
+		Enabled:       Bool(true),
+		AlertsEnabled: Bool(true),
+		Interval:      Int(DefaultTestInterval),
+		Codec:         CodecG711.Ptr(),
+		DSCP:          DSCPEF.Ptr(),
+	}}
+}
+
+// Codec - set the simulated codec
+func (b *VoiceCallBuilder) Codec(codec Codec) *VoiceCallBuilder {
+	b.test.Codec = codec.Ptr()
+	return b
+}
+
+// DSCP - set the DSCP marking of the call
+func (b *VoiceCallBuilder) DSCP(dscp DSCP) *VoiceCallBuilder {
+	b.test.DSCP = dscp.Ptr()
+	return b
+}
+
+// Duration - set the duration of the call, in seconds
+func (b *VoiceCallBuilder) Duration(seconds int) *VoiceCallBuilder {
+	b.test.Duration = Int(seconds)
+	return b
+}
+
+// SourceSIPCredentials - set the SIP credentials of the calling agent
+func (b *VoiceCallBuilder) SourceSIPCredentials(credentials SIPAuthData) *VoiceCallBuilder {
+	b.test.SourceSIPCredentials = &credentials
+	return b
+}
+
+// TargetSIPCredentials - set the SIP credentials of the target agent
+func (b *VoiceCallBuilder) TargetSIPCredentials(credentials SIPAuthData) *VoiceCallBuilder {
+	b.test.TargetSIPCredentials = &credentials
+	return b
+}
+
+// WebTransactionBuilder - builds a web transaction test.  Create one with
+// NewWebTransactionTest.
+type WebTransactionBuilder struct {
+	test WebTransaction
+}
+
+// NewWebTransactionTest - start building a web transaction test.
+func NewWebTransactionTest(name, url, script string) *WebTransactionBuilder {
+	return &WebTransactionBuilder{test: WebTransaction{
+		TestName:          String(name),
+		URL:               String(url),
+		TransactionScript: String(script),
+		Enabled:           Bool(true),
+		AlertsEnabled:     Bool(true),
+		Interval:          Int(DefaultTestInterval),
+	}}
+}
+
+// TimeLimit - set the time limit of the transaction, in seconds
+func (b *WebTransactionBuilder) TimeLimit(seconds int) *WebTransactionBuilder {
+	b.test.TimeLimit = Int(seconds)
+	return b
+}
+
+// HTTPTimeLimit - set the time limit of the initial request, in seconds
+func (b *WebTransactionBuilder) HTTPTimeLimit(seconds int) *WebTransactionBuilder {
+	b.test.HTTPTimeLimit = Int(seconds)
+	return b
+}
@@ -0,0 +1,76 @@
+package thousandeyes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHTTPServerTest(t *testing.T) {
+	test, err := NewHTTPServerTest("web", "https://example.com").
+		Description("health check").
+		Interval(60).
+		Agents(1, 2).
+		AlertRules(10).
+		Labels(5).
+		HTTPTimeLimit(10).
+		With(func(t *HTTPServer) { t.Headers = &[]string{"X-Probe: 1"} }).
+		Build()
+	assert.Nil(t, err)
+	assert.Equal(t, HTTPServer{
+		TestName:      String("web"),
+		Description:   String("health check"),
+		URL:           String("https://example.com"),
+		Enabled:       Bool(true),
+		AlertsEnabled: Bool(true),
+		Interval:      Int(60),
+		Agents:        &[]Agent{{AgentID: Int(1)}, {AgentID: Int(2)}},
+		AlertRules:    &[]AlertRule{{RuleID: Int(10)}},
+		Groups:        &[]GroupLabel{{GroupID: Int64(5)}},
+		HTTPTimeLimit: Int(10),
+		Headers:       &[]string{"X-Probe: 1"},
+	}, test)
+}
+
+func TestBuilderDefaults(t *testing.T) {
+	test, err := NewVoiceCallTest("call", 3).Build()
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultTestInterval, *test.Interval)
+	assert.Equal(t, CodecG711, *test.Codec)
+	assert.Equal(t, DSCPEF, *test.DSCP)
+	assert.True(t, *test.Enabled)
+	assert.Nil(t, test.Agents)
+
+	dns, err := NewDNSServerTest("dns", "example.com", "ns1.example.com", "ns2.example.com").Build()
+	assert.Nil(t, err)
+	assert.Equal(t, &[]Server{{ServerName: String("ns1.example.com")}, {ServerName: String("ns2.example.com")}}, dns.DNSServers)
+
+	bgp, err := NewBGPTest("prefix", "192.0.2.0/24").AlertRules(1).Build()
+	assert.Nil(t, err)
+	assert.Equal(t, &[]AlertRule{{RuleID: Int(1)}}, bgp.AlertRules)
+}
+
+func TestBuilderValidates(t *testing.T) {
+	_, err := NewAgentServerTest("ssh", "ssh.example.com").Interval(30).Protocol(ProtocolUDP).Build()
+	assert.EqualError(t, err, `invalid configuration: Interval: must be one of 60, 120, 300, 600, 900, 1800, 3600, got 30; Protocol: must be one of TCP, ICMP, got "UDP"`)
+}
+
+func TestBuilderReuse(t *testing.T) {
+	b := NewPageLoadTest("page", "https://example.com").Agents(1)
+	first, err := b.Build()
+	assert.Nil(t, err)
+	second, err := b.Agents(2).Build()
+	assert.Nil(t, err)
+	assert.Len(t, *first.Agents, 1)
+	assert.Len(t, *second.Agents, 2)
+}
+
+func TestAddAgentAndAlertRuleToEmptyTest(t *testing.T) {
+	test := HTTPServer{}
+	test.AddAgent(1)
+	assert.Equal(t, &[]Agent{{AgentID: Int(1)}}, test.Agents)
+
+	dns := DNSServer{}
+	dns.AddAlertRule(2)
+	assert.Equal(t, &[]AlertRule{{RuleID: Int(2)}}, dns.AlertRules)
+}
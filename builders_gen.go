@@ -0,0 +1,693 @@
+// Code generated by buildergen; DO NOT EDIT.
+
+package thousandeyes
+
+// Description - set the description of the test
+func (b *AgentAgentBuilder) Description(description string) *AgentAgentBuilder {
+	b.test.Description = String(description)
+	return b
+}
+
+// Interval - set how often the test runs, in seconds
+func (b *AgentAgentBuilder) Interval(seconds int) *AgentAgentBuilder {
+	b.test.Interval = Int(seconds)
+	return b
+}
+
+// Enabled - set whether the test runs
+func (b *AgentAgentBuilder) Enabled(enabled bool) *AgentAgentBuilder {
+	b.test.Enabled = Bool(enabled)
+	return b
+}
+
+// AlertsEnabled - set whether the test raises alerts
+func (b *AgentAgentBuilder) AlertsEnabled(enabled bool) *AgentAgentBuilder {
+	b.test.AlertsEnabled = Bool(enabled)
+	return b
+}
+
+// Agents - add the agents with the given IDs to the test
+func (b *AgentAgentBuilder) Agents(ids ...int) *AgentAgentBuilder {
+	addAgents(&b.test.Agents, ids)
+	return b
+}
+
+// AlertRules - add the alert rules with the given IDs to the test
+func (b *AgentAgentBuilder) AlertRules(ids ...int) *AgentAgentBuilder {
+	addAlertRules(&b.test.AlertRules, ids)
+	return b
+}
+
+// Labels - add the test to the labels with the given IDs
+func (b *AgentAgentBuilder) Labels(ids ...int64) *AgentAgentBuilder {
+	addGroups(&b.test.Groups, ids)
+	return b
+}
+
+// With - call f to set fields of the test which have no builder method
+func (b *AgentAgentBuilder) With(f func(*AgentAgent)) *AgentAgentBuilder {
+	f(&b.test)
+	return b
+}
+
+// Build - validate the test and return a copy of it.  The builder can be
+// used again, to build similar tests.
+func (b *AgentAgentBuilder) Build() (AgentAgent, error) {
+	return buildTest(b.test)
+}
+
+// Description - set the description of the test
+func (b *AgentServerBuilder) Description(description string) *AgentServerBuilder {
+	b.test.Description = String(description)
+	return b
+}
+
+// Interval - set how often the test runs, in seconds
+func (b *AgentServerBuilder) Interval(seconds int) *AgentServerBuilder {
+	b.test.Interval = Int(seconds)
+	return b
+}
+
+// Enabled - set whether the test runs
+func (b *AgentServerBuilder) Enabled(enabled bool) *AgentServerBuilder {
+	b.test.Enabled = Bool(enabled)
+	return b
+}
+
+// AlertsEnabled - set whether the test raises alerts
+func (b *AgentServerBuilder) AlertsEnabled(enabled bool) *AgentServerBuilder {
+	b.test.AlertsEnabled = Bool(enabled)
+	return b
+}
+
+// Agents - add the agents with the given IDs to the test
+func (b *AgentServerBuilder) Agents(ids ...int) *AgentServerBuilder {
+	addAgents(&b.test.Agents, ids)
+	return b
+}
+
+// AlertRules - add the alert rules with the given IDs to the test
+func (b *AgentServerBuilder) AlertRules(ids ...int) *AgentServerBuilder {
+	addAlertRules(&b.test.AlertRules, ids)
+	return b
+}
+
+// Labels - add the test to the labels with the given IDs
+func (b *AgentServerBuilder) Labels(ids ...int64) *AgentServerBuilder {
+	addGroups(&b.test.Groups, ids)
+	return b
+}
+
+// With - call f to set fields of the test which have no builder method
+func (b *AgentServerBuilder) With(f func(*AgentServer)) *AgentServerBuilder {
+	f(&b.test)
+	return b
+}
+
+// Build - validate the test and return a copy of it.  The builder can be
+// used again, to build similar tests.
+func (b *AgentServerBuilder) Build() (AgentServer, error) {
+	return buildTest(b.test)
+}
+
+// Description - set the description of the test
+func (b *BGPBuilder) Description(description string) *BGPBuilder {
+	b.test.Description = String(description)
+	return b
+}
+
+// Enabled - set whether the test runs
+func (b *BGPBuilder) Enabled(enabled bool) *BGPBuilder {
+	b.test.Enabled = Bool(enabled)
+	return b
+}
+
+// AlertsEnabled - set whether the test raises alerts
+func (b *BGPBuilder) AlertsEnabled(enabled bool) *BGPBuilder {
+	b.test.AlertsEnabled = Bool(enabled)
+	return b
+}
+
+// AlertRules - add the alert rules with the given IDs to the test
+func (b *BGPBuilder) AlertRules(ids ...int) *BGPBuilder {
+	addAlertRules(&b.test.AlertRules, ids)
+	return b
+}
+
+// Labels - add the test to the labels with the given IDs
+func (b *BGPBuilder) Labels(ids ...int64) *BGPBuilder {
+	addGroups(&b.test.Groups, ids)
+	return b
+}
+
+// With - call f to set fields of the test which have no builder method
+func (b *BGPBuilder) With(f func(*BGP)) *BGPBuilder {
+	f(&b.test)
+	return b
+}
+
+// Build - validate the test and return a copy of it.  The builder can be
+// used again, to build similar tests.
+func (b *BGPBuilder) Build() (BGP, error) {
+	return buildTest(b.test)
+}
+
+// Description - set the description of the test
+func (b *DNSSecBuilder) Description(description string) *DNSSecBuilder {
+	b.test.Description = String(description)
+	return b
+}
+
+// Interval - set how often the test runs, in seconds
+func (b *DNSSecBuilder) Interval(seconds int) *DNSSecBuilder {
+	b.test.Interval = Int(seconds)
+	return b
+}
+
+// Enabled - set whether the test runs
+func (b *DNSSecBuilder) Enabled(enabled bool) *DNSSecBuilder {
+	b.test.Enabled = Bool(enabled)
+	return b
+}
+
+// AlertsEnabled - set whether the test raises alerts
+func (b *DNSSecBuilder) AlertsEnabled(enabled bool) *DNSSecBuilder {
+	b.test.AlertsEnabled = Bool(enabled)
+	return b
+}
+
+// Agents - add the agents with the given IDs to the test
+func (b *DNSSecBuilder) Agents(ids ...int) *DNSSecBuilder {
+	addAgents(&b.test.Agents, ids)
+	return b
+}
+
+// AlertRules - add the alert rules with the given IDs to the test
+func (b *DNSSecBuilder) AlertRules(ids ...int) *DNSSecBuilder {
+	addAlertRules(&b.test.AlertRules, ids)
+	return b
+}
+
+// Labels - add the test to the labels with the given IDs
+func (b *DNSSecBuilder) Labels(ids ...int64) *DNSSecBuilder {
+	addGroups(&b.test.Groups, ids)
+	return b
+}
+
+// With - call f to set fields of the test which have no builder method
+func (b *DNSSecBuilder) With(f func(*DNSSec)) *DNSSecBuilder {
+	f(&b.test)
+	return b
+}
+
+// Build - validate the test and return a copy of it.  The builder can be
+// used again, to build similar tests.
+func (b *DNSSecBuilder) Build() (DNSSec, error) {
+	return buildTest(b.test)
+}
+
+// Description - set the description of the test
+func (b *DNSServerBuilder) Description(description string) *DNSServerBuilder {
+	b.test.Description = String(description)
+	return b
+}
+
+// Interval - set how often the test runs, in seconds
+func (b *DNSServerBuilder) Interval(seconds int) *DNSServerBuilder {
+	b.test.Interval = Int(seconds)
+	return b
+}
+
+// Enabled - set whether the test runs
+func (b *DNSServerBuilder) Enabled(enabled bool) *DNSServerBuilder {
+	b.test.Enabled = Bool(enabled)
+	return b
+}
+
+// AlertsEnabled - set whether the test raises alerts
+func (b *DNSServerBuilder) AlertsEnabled(enabled bool) *DNSServerBuilder {
+	b.test.AlertsEnabled = Bool(enabled)
+	return b
+}
+
+// Agents - add the agents with the given IDs to the test
+func (b *DNSServerBuilder) Agents(ids ...int) *DNSServerBuilder {
+	addAgents(&b.test.Agents, ids)
+	return b
+}
+
+// AlertRules - add the alert rules with the given IDs to the test
+func (b *DNSServerBuilder) AlertRules(ids ...int) *DNSServerBuilder {
+	addAlertRules(&b.test.AlertRules, ids)
+	return b
+}
+
+// Labels - add the test to the labels with the given IDs
+func (b *DNSServerBuilder) Labels(ids ...int64) *DNSServerBuilder {
+	addGroups(&b.test.Groups, ids)
+	return b
+}
+
+// With - call f to set fields of the test which have no builder method
+func (b *DNSServerBuilder) With(f func(*DNSServer)) *DNSServerBuilder {
+	f(&b.test)
+	return b
+}
+
+// Build - validate the test and return a copy of it.  The builder can be
+// used again, to build similar tests.
+func (b *DNSServerBuilder) Build() (DNSServer, error) {
+	return buildTest(b.test)
+}
+
+// Description - set the description of the test
+func (b *DNSTraceBuilder) Description(description string) *DNSTraceBuilder {
+	b.test.Description = String(description)
+	return b
+}
+
+// Interval - set how often the test runs, in seconds
+func (b *DNSTraceBuilder) Interval(seconds int) *DNSTraceBuilder {
+	b.test.Interval = Int(seconds)
+	return b
+}
+
+// Enabled - set whether the test runs
+func (b *DNSTraceBuilder) Enabled(enabled bool) *DNSTraceBuilder {
+	b.test.Enabled = Bool(enabled)
+	return b
+}
+
+// AlertsEnabled - set whether the test raises alerts
+func (b *DNSTraceBuilder) AlertsEnabled(enabled bool) *DNSTraceBuilder {
+	b.test.AlertsEnabled = Bool(enabled)
+	return b
+}
+
+// Agents - add the agents with the given IDs to the test
+func (b *DNSTraceBuilder) Agents(ids ...int) *DNSTraceBuilder {
+	addAgents(&b.test.Agents, ids)
+	return b
+}
+
+// AlertRules - add the alert rules with the given IDs to the test
+func (b *DNSTraceBuilder) AlertRules(ids ...int) *DNSTraceBuilder {
+	addAlertRules(&b.test.AlertRules, ids)
+	return b
+}
+
+// Labels - add the test to the labels with the given IDs
+func (b *DNSTraceBuilder) Labels(ids ...int64) *DNSTraceBuilder {
+	addGroups(&b.test.Groups, ids)
+	return b
+}
+
+// With - call f to set fields of the test which have no builder method
+func (b *DNSTraceBuilder) With(f func(*DNSTrace)) *DNSTraceBuilder {
+	f(&b.test)
+	return b
+}
+
+// Build - validate the test and return a copy of it.  The builder can be
+// used again, to build similar tests.
+func (b *DNSTraceBuilder) Build() (DNSTrace, error) {
+	return buildTest(b.test)
+}
+
+// Description - set the description of the test
+func (b *FTPServerBuilder) Description(description string) *FTPServerBuilder {
+	b.test.Description = String(description)
+	return b
+}
+
+// Interval - set how often the test runs, in seconds
+func (b *FTPServerBuilder) Interval(seconds int) *FTPServerBuilder {
+	b.test.Interval = Int(seconds)
+	return b
+}
+
+// Enabled - set whether the test runs
+func (b *FTPServerBuilder) Enabled(enabled bool) *FTPServerBuilder {
+	b.test.Enabled = Bool(enabled)
+	return b
+}
+
+// AlertsEnabled - set whether the test raises alerts
+func (b *FTPServerBuilder) AlertsEnabled(enabled bool) *FTPServerBuilder {
+	b.test.AlertsEnabled = Bool(enabled)
+	return b
+}
+
+// Agents - add the agents with the given IDs to the test
+func (b *FTPServerBuilder) Agents(ids ...int) *FTPServerBuilder {
+	addAgents(&b.test.Agents, ids)
+	return b
+}
+
+// AlertRules - add the alert rules with the given IDs to the test
+func (b *FTPServerBuilder) AlertRules(ids ...int) *FTPServerBuilder {
+	addAlertRules(&b.test.AlertRules, ids)
+	return b
+}
+
+// Labels - add the test to the labels with the given IDs
+func (b *FTPServerBuilder) Labels(ids ...int64) *FTPServerBuilder {
+	addGroups(&b.test.Groups, ids)
+	return b
+}
+
+// With - call f to set fields of the test which have no builder method
+func (b *FTPServerBuilder) With(f func(*FTPServer)) *FTPServerBuilder {
+	f(&b.test)
+	return b
+}
+
+// Build - validate the test and return a copy of it.  The builder can be
+// used again, to build similar tests.
+func (b *FTPServerBuilder) Build() (FTPServer, error) {
+	return buildTest(b.test)
+}
+
+// Description - set the description of the test
+func (b *HTTPServerBuilder) Description(description string) *HTTPServerBuilder {
+	b.test.Description = String(description)
+	return b
+}
+
+// Interval - set how often the test runs, in seconds
+func (b *HTTPServerBuilder) Interval(seconds int) *HTTPServerBuilder {
+	b.test.Interval = Int(seconds)
+	return b
+}
+
+// Enabled - set whether the test runs
+func (b *HTTPServerBuilder) Enabled(enabled bool) *HTTPServerBuilder {
+	b.test.Enabled = Bool(enabled)
+	return b
+}
+
+// AlertsEnabled - set whether the test raises alerts
+func (b *HTTPServerBuilder) AlertsEnabled(enabled bool) *HTTPServerBuilder {
+	b.test.AlertsEnabled = Bool(enabled)
+	return b
+}
+
+// Agents - add the agents with the given IDs to the test
+func (b *HTTPServerBuilder) Agents(ids ...int) *HTTPServerBuilder {
+	addAgents(&b.test.Agents, ids)
+	return b
+}
+
+// AlertRules - add the alert rules with the given IDs to the test
+func (b *HTTPServerBuilder) AlertRules(ids ...int) *HTTPServerBuilder {
+	addAlertRules(&b.test.AlertRules, ids)
+	return b
+}
+
+// Labels - add the test to the labels with the given IDs
+func (b *HTTPServerBuilder) Labels(ids ...int64) *HTTPServerBuilder {
+	addGroups(&b.test.Groups, ids)
+	return b
+}
+
+// With - call f to set fields of the test which have no builder method
+func (b *HTTPServerBuilder) With(f func(*HTTPServer)) *HTTPServerBuilder {
+	f(&b.test)
+	return b
+}
+
+// Build - validate the test and return a copy of it.  The builder can be
+// used again, to build similar tests.
+func (b *HTTPServerBuilder) Build() (HTTPServer, error) {
+	return buildTest(b.test)
+}
+
+// Description - set the description of the test
+func (b *PageLoadBuilder) Description(description string) *PageLoadBuilder {
+	b.test.Description = String(description)
+	return b
+}
+
+// Interval - set how often the test runs, in seconds
+func (b *PageLoadBuilder) Interval(seconds int) *PageLoadBuilder {
+	b.test.Interval = Int(seconds)
+	return b
+}
+
+// Enabled - set whether the test runs
+func (b *PageLoadBuilder) Enabled(enabled bool) *PageLoadBuilder {
+	b.test.Enabled = Bool(enabled)
+	return b
+}
+
+// AlertsEnabled - set whether the test raises alerts
+func (b *PageLoadBuilder) AlertsEnabled(enabled bool) *PageLoadBuilder {
+	b.test.AlertsEnabled = Bool(enabled)
+	return b
+}
+
+// Agents - add the agents with the given IDs to the test
+func (b *PageLoadBuilder) Agents(ids ...int) *PageLoadBuilder {
+	addAgents(&b.test.Agents, ids)
+	return b
+}
+
+// AlertRules - add the alert rules with the given IDs to the test
+func (b *PageLoadBuilder) AlertRules(ids ...int) *PageLoadBuilder {
+	addAlertRules(&b.test.AlertRules, ids)
+	return b
+}
+
+// Labels - add the test to the labels with the given IDs
+func (b *PageLoadBuilder) Labels(ids ...int64) *PageLoadBuilder {
+	addGroups(&b.test.Groups, ids)
+	return b
+}
+
+// With - call f to set fields of the test which have no builder method
+func (b *PageLoadBuilder) With(f func(*PageLoad)) *PageLoadBuilder {
+	f(&b.test)
+	return b
+}
+
+// Build - validate the test and return a copy of it.  The builder can be
+// used again, to build similar tests.
+func (b *PageLoadBuilder) Build() (PageLoad, error) {
+	return buildTest(b.test)
+}
+
+// Description - set the description of the test
+func (b *RTPStreamBuilder) Description(description string) *RTPStreamBuilder {
+	b.test.Description = String(description)
+	return b
+}
+
+// Interval - set how often the test runs, in seconds
+func (b *RTPStreamBuilder) Interval(seconds int) *RTPStreamBuilder {
+	b.test.Interval = Int(seconds)
+	return b
+}
+
+// Enabled - set whether the test runs
+func (b *RTPStreamBuilder) Enabled(enabled bool) *RTPStreamBuilder {
+	b.test.Enabled = Bool(enabled)
+	return b
+}
+
+// AlertsEnabled - set whether the test raises alerts
+func (b *RTPStreamBuilder) AlertsEnabled(enabled bool) *RTPStreamBuilder {
+	b.test.AlertsEnabled = Bool(enabled)
+	return b
+}
+
+// Agents - add the agents with the given IDs to the test
+func (b *RTPStreamBuilder) Agents(ids ...int) *RTPStreamBuilder {
+	addAgents(&b.test.Agents, ids)
+	return b
+}
+
+// AlertRules - add the alert rules with the given IDs to the test
+func (b *RTPStreamBuilder) AlertRules(ids ...int) *RTPStreamBuilder {
+	addAlertRules(&b.test.AlertRules, ids)
+	return b
+}
+
+// Labels - add the test to the labels with the given IDs
+func (b *RTPStreamBuilder) Labels(ids ...int64) *RTPStreamBuilder {
+	addGroups(&b.test.Groups, ids)
+	return b
+}
+
+// With - call f to set fields of the test which have no builder method
+func (b *RTPStreamBuilder) With(f func(*RTPStream)) *RTPStreamBuilder {
+	f(&b.test)
+	return b
+}
+
+// Build - validate the test and return a copy of it.  The builder can be
+// used again, to build similar tests.
+func (b *RTPStreamBuilder) Build() (RTPStream, error) {
+	return buildTest(b.test)
+}
+
+// Description - set the description of the test
+func (b *SIPServerBuilder) Description(description string) *SIPServerBuilder {
+	b.test.Description = String(description)
+	return b
+}
+
+// Interval - set how often the test runs, in seconds
+func (b *SIPServerBuilder) Interval(seconds int) *SIPServerBuilder {
+	b.test.Interval = Int(seconds)
+	return b
+}
+
+// Enabled - set whether the test runs
+func (b *SIPServerBuilder) Enabled(enabled bool) *SIPServerBuilder {
+	b.test.Enabled = Bool(enabled)
+	return b
+}
+
+// AlertsEnabled - set whether the test raises alerts
+func (b *SIPServerBuilder) AlertsEnabled(enabled bool) *SIPServerBuilder {
+	b.test.AlertsEnabled = Bool(enabled)
+	return b
+}
+
+// Agents - add the agents with the given IDs to the test
+func (b *SIPServerBuilder) Agents(ids ...int) *SIPServerBuilder {
+	addAgents(&b.test.Agents, ids)
+	return b
+}
+
+// AlertRules - add the alert rules with the given IDs to the test
+func (b *SIPServerBuilder) AlertRules(ids ...int) *SIPServerBuilder {
+	addAlertRules(&b.test.AlertRules, ids)
+	return b
+}
+
+// Labels - add the test to the labels with the given IDs
+func (b *SIPServerBuilder) Labels(ids ...int64) *SIPServerBuilder {
+	addGroups(&b.test.Groups, ids)
+	return b
+}
+
+// With - call f to set fields of the test which have no builder method
+func (b *SIPServerBuilder) With(f func(*SIPServer)) *SIPServerBuilder {
+	f(&b.test)
+	return b
+}
+
+// Build - validate the test and return a copy of it.  The builder can be
+// used again, to build similar tests.
+func (b *SIPServerBuilder) Build() (SIPServer, error) {
+	return buildTest(b.test)
+}
+
+// Description - set the description of the test
+func (b *VoiceCallBuilder) Description(description string) *VoiceCallBuilder {
+	b.test.Description = String(description)
+	return b
+}
+
+// Interval - set how often the test runs, in seconds
+func (b *VoiceCallBuilder) Interval(seconds int) *VoiceCallBuilder {
+	b.test.Interval = Int(seconds)
+	return b
+}
+
+// Enabled - set whether the test runs
+func (b *VoiceCallBuilder) Enabled(enabled bool) *VoiceCallBuilder {
+	b.test.Enabled = Bool(enabled)
+	return b
+}
+
+// AlertsEnabled - set whether the test raises alerts
+func (b *VoiceCallBuilder) AlertsEnabled(enabled bool) *VoiceCallBuilder {
+	b.test.AlertsEnabled = Bool(enabled)
+	return b
+}
+
+// Agents - add the agents with the given IDs to the test
+func (b *VoiceCallBuilder) Agents(ids ...int) *VoiceCallBuilder {
+	addAgents(&b.test.Agents, ids)
+	return b
+}
+
+// AlertRules - add the alert rules with the given IDs to the test
+func (b *VoiceCallBuilder) AlertRules(ids ...int) *VoiceCallBuilder {
+	addAlertRules(&b.test.AlertRules, ids)
+	return b
+}
+
+// Labels - add the test to the labels with the given IDs
+func (b *VoiceCallBuilder) Labels(ids ...int64) *VoiceCallBuilder {
+	addGroups(&b.test.Groups, ids)
+	return b
+}
+
+// With - call f to set fields of the test which have no builder method
+func (b *VoiceCallBuilder) With(f func(*VoiceCall)) *VoiceCallBuilder {
+	f(&b.test)
+	return b
+}
+
+// Build - validate the test and return a copy of it.  The builder can be
+// used again, to build similar tests.
+func (b *VoiceCallBuilder) Build() (VoiceCall, error) {
+	return buildTest(b.test)
+}
+
+// Description - set the description of the test
+func (b *WebTransactionBuilder) Description(description string) *WebTransactionBuilder {
+	b.test.Description = String(description)
+	return b
+}
+
+// Interval - set how often the test runs, in seconds
+func (b *WebTransactionBuilder) Interval(seconds int) *WebTransactionBuilder {
+	b.test.Interval = Int(seconds)
+	return b
+}
+
+// Enabled - set whether the test runs
+func (b *WebTransactionBuilder) Enabled(enabled bool) *WebTransactionBuilder {
+	b.test.Enabled = Bool(enabled)
+	return b
+}
+
+// AlertsEnabled - set whether the test raises alerts
+func (b *WebTransactionBuilder) AlertsEnabled(enabled bool) *WebTransactionBuilder {
+	b.test.AlertsEnabled = Bool(enabled)
+	return b
+}
+
+// Agents - add the agents with the given IDs to the test
+func (b *WebTransactionBuilder) Agents(ids ...int) *WebTransactionBuilder {
+	addAgents(&b.test.Agents, ids)
+	return b
+}
+
+// AlertRules - add the alert rules with the given IDs to the test
+func (b *WebTransactionBuilder) AlertRules(ids ...int) *WebTransactionBuilder {
+	addAlertRules(&b.test.AlertRules, ids)
+	return b
+}
+
+// Labels - add the test to the labels with the given IDs
+func (b *WebTransactionBuilder) Labels(ids ...int64) *WebTransactionBuilder {
+	addGroups(&b.test.Groups, ids)
+	return b
+}
+
+// With - call f to set fields of the test which have no builder method
+func (b *WebTransactionBuilder) With(f func(*WebTransaction)) *WebTransactionBuilder {
+	f(&b.test)
+	return b
+}
+
+// Build - validate the test and return a copy of it.  The builder can be
+// used again, to build similar tests.
+func (b *WebTransactionBuilder) Build() (WebTransaction, error) {
+	return buildTest(b.test)
+}
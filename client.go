@@ -7,6 +7,7 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -37,6 +38,9 @@ type APILinks []APILink
 type APILink struct {
 	Href *string `json:"href,omitempty"`
 	Rel  *string `json:"rel,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 type errorObject struct {
@@ -59,6 +63,12 @@ type ClientOptions struct {
 	AccountID string
 	AuthToken string
 	Timeout   time.Duration
+	// StrictEnums rejects unknown enum values, such as an unknown
+	// Protocol, in requests and responses.
+	StrictEnums bool
+	// StrictFields rejects responses with fields the SDK does not model
+	// with an *UnknownFieldsError, so API drift can be detected.
+	StrictFields bool
 }
 
 // Client wraps http client
@@ -68,6 +78,12 @@ type Client struct {
 	APIEndpoint    string
 	HTTPClient     http.Client
 	Limiter        Limiter
+	// StrictEnums rejects unknown enum values, such as an unknown
+	// Protocol, in requests and responses.
+	StrictEnums bool
+	// StrictFields rejects responses with fields the SDK does not model
+	// with an *UnknownFieldsError, so API drift can be detected.
+	StrictFields bool
 }
 
 // DefaultLimiter -  thousandeyes rate limit is 240 per minute
@@ -99,7 +115,9 @@ func NewClient(opts *ClientOptions) *Client {
 		HTTPClient: http.Client{
 			Timeout: timeout,
 		},
-		Limiter: opts.Limiter,
+		Limiter:      opts.Limiter,
+		StrictEnums:  opts.StrictEnums,
+		StrictFields: opts.StrictFields,
 	}
 }
 
@@ -109,7 +127,7 @@ func (c *Client) delete(path string) (*http.Response, error) {
 
 func (c *Client) put(path string, payload interface{}, headers *map[string]string) (*http.Response, error) {
 	if payload != nil {
-		data, err := json.Marshal(payload)
+		data, err := c.marshal(payload)
 		if err != nil {
 			return nil, err
 		}
@@ -119,7 +137,7 @@ func (c *Client) put(path string, payload interface{}, headers *map[string]strin
 }
 
 func (c *Client) post(path string, payload interface{}, headers *map[string]string) (*http.Response, error) {
-	data, err := json.Marshal(payload)
+	data, err := c.marshal(payload)
 	if err != nil {
 		return nil, err
 	}
@@ -130,15 +148,31 @@ func (c *Client) get(path string) (*http.Response, error) {
 	return c.do("GET", path, nil, nil)
 }
 
+// getWithQuery performs a GET request with additional query string parameters.
+func (c *Client) getWithQuery(path string, query url.Values) (*http.Response, error) {
+	return c.doWithQuery("GET", path, query, nil, nil)
+}
+
 func (c *Client) do(method, path string, body io.Reader, headers *map[string]string) (*http.Response, error) {
+	return c.doWithQuery(method, path, nil, body, headers)
+}
+
+func (c *Client) doWithQuery(method, path string, query url.Values, body io.Reader, headers *map[string]string) (*http.Response, error) {
 	if c.Limiter != nil {
 		c.Limiter.Wait()
 	}
 	endpoint := c.APIEndpoint + path + ".json"
 	req, _ := http.NewRequest(method, endpoint, body)
-	if c.AccountGroupID != "" {
+	if c.AccountGroupID != "" || len(query) > 0 {
 		q := req.URL.Query()
-		q.Add("aid", c.AccountGroupID)
+		for k, values := range query {
+			for _, v := range values {
+				q.Add(k, v)
+			}
+		}
+		if c.AccountGroupID != "" {
+			q.Add("aid", c.AccountGroupID)
+		}
 		req.URL.RawQuery = q.Encode()
 	}
 	req.Header.Set("accept", "application/json")
@@ -178,7 +212,34 @@ func (c *Client) do(method, path string, body io.Reader, headers *map[string]str
 func (c *Client) decodeJSON(resp *http.Response, payload interface{}) error {
 	defer resp.Body.Close()
 	decoder := json.NewDecoder(resp.Body)
-	return decoder.Decode(payload)
+	if err := decoder.Decode(payload); err != nil {
+		return err
+	}
+	return c.checkDecoded(payload)
+}
+
+// checkDecoded applies the strict mode checks to a decoded payload.
+func (c *Client) checkDecoded(payload interface{}) error {
+	if c.StrictFields {
+		if fields := UnknownFields(payload); len(fields) > 0 {
+			return &UnknownFieldsError{Fields: fields}
+		}
+	}
+	if c.StrictEnums {
+		return validateEnums(payload)
+	}
+	return nil
+}
+
+// marshal encodes a request payload, checking its enum values first in
+// strict mode.
+func (c *Client) marshal(payload interface{}) ([]byte, error) {
+	if c.StrictEnums {
+		if err := validateEnums(payload); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(payload)
 }
 
 func (c *Client) checkResponse(resp *http.Response, err error) (*http.Response, error) {
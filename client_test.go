@@ -3,6 +3,7 @@ package thousandeyes
 import (
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 
@@ -55,6 +56,19 @@ func Test_ClientAccountGroupNone(t *testing.T) {
 	_, _ = client.GetAgents()
 }
 
+func Test_ClientQueryParameters(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo", AccountGroupID: "test"}
+	mux.HandleFunc("/audit-user-events.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "test", r.URL.Query().Get("aid"))
+		assert.Equal(t, "2", r.URL.Query().Get("page"))
+		_, _ = w.Write([]byte(`{}`))
+	})
+	_, err := client.getWithQuery("/audit-user-events", url.Values{"page": []string{"2"}})
+	teardown()
+	assert.Nil(t, err)
+}
+
 func Test_setDelay(t *testing.T) {
 	setup()
 	now := time.Now()
@@ -196,4 +210,8 @@ func Test_isInstantTest(t *testing.T) {
 	assert.Equal(t, true, isInstantTest(req))
 	req, _ = http.NewRequest("GET", "https://api.thousandeyes.com/v6/agents.json", nil)
 	assert.Equal(t, false, isInstantTest(req))
+	req, _ = http.NewRequest("POST", "https://api.thousandeyes.com/v6/endpoint-instant/http-server.json", nil)
+	assert.Equal(t, true, isInstantTest(req))
+	req, _ = http.NewRequest("POST", "https://api.thousandeyes.com/v6/endpoint-tests/http-server/new.json", nil)
+	assert.Equal(t, false, isInstantTest(req))
 }
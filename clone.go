@@ -0,0 +1,235 @@
+package thousandeyes
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// CloneOverrides - fields to change on a cloned test.  Unset fields are
+// copied from the source test.
+type CloneOverrides struct {
+	TestName      *string
+	Description   *string
+	Enabled       *bool
+	AlertsEnabled *bool
+	Interval      *int
+}
+
+// CloneReport - the result of cloning a test into another account group.
+// The Unmapped fields list the names of source objects which were not found
+// in the target account group and so were left off the new test.
+type CloneReport struct {
+	TestID             int64
+	Type               string
+	UnmappedAgents     []string
+	UnmappedAlertRules []string
+	UnmappedGroups     []string
+}
+
+// Complete reports whether every agent, alert rule and label was mapped
+func (r CloneReport) Complete() bool {
+	return len(r.UnmappedAgents) == 0 && len(r.UnmappedAlertRules) == 0 && len(r.UnmappedGroups) == 0
+}
+
+// String summarises the objects which could not be mapped
+func (r CloneReport) String() string {
+	if r.Complete() {
+		return fmt.Sprintf("cloned %s test %d", r.Type, r.TestID)
+	}
+	var missing []string
+	if len(r.UnmappedAgents) > 0 {
+		missing = append(missing, "agents: "+strings.Join(r.UnmappedAgents, ", "))
+	}
+	if len(r.UnmappedAlertRules) > 0 {
+		missing = append(missing, "alert rules: "+strings.Join(r.UnmappedAlertRules, ", "))
+	}
+	if len(r.UnmappedGroups) > 0 {
+		missing = append(missing, "labels: "+strings.Join(r.UnmappedGroups, ", "))
+	}
+	return fmt.Sprintf("cloned %s test %d without %s", r.Type, r.TestID, strings.Join(missing, "; "))
+}
+
+// remapAgents replaces source agents with target agents of the same name
+func (t *stateNames) remapAgents(agents *[]Agent, report *CloneReport) *[]Agent {
+	if agents == nil {
+		return nil
+	}
+	mapped := []Agent{}
+	for _, a := range *agents {
+		if a.AgentName == nil {
+			report.UnmappedAgents = append(report.UnmappedAgents, fmt.Sprintf("agent %d", intValue(a.AgentID)))
+			continue
+		}
+		id, ok := t.agents[*a.AgentName]
+		if !ok {
+			report.UnmappedAgents = append(report.UnmappedAgents, *a.AgentName)
+			continue
+		}
+		mapped = append(mapped, Agent{AgentID: Int(id)})
+	}
+	return &mapped
+}
+
+// remapAlertRules replaces source alert rules with target rules of the same name
+func (t *stateNames) remapAlertRules(rules *[]AlertRule, report *CloneReport) *[]AlertRule {
+	if rules == nil {
+		return nil
+	}
+	mapped := []AlertRule{}
+	for _, r := range *rules {
+		if r.RuleName == nil {
+			report.UnmappedAlertRules = append(report.UnmappedAlertRules, fmt.Sprintf("rule %d", intValue(r.RuleID)))
+			continue
+		}
+		id, ok := t.alertRules[*r.RuleName]
+		if !ok {
+			report.UnmappedAlertRules = append(report.UnmappedAlertRules, *r.RuleName)
+			continue
+		}
+		mapped = append(mapped, AlertRule{RuleID: Int(id)})
+	}
+	return &mapped
+}
+
+// remapGroups replaces source labels with target labels of the same name
+func (t *stateNames) remapGroups(groups *[]GroupLabel, report *CloneReport) *[]GroupLabel {
+	if groups == nil {
+		return nil
+	}
+	mapped := []GroupLabel{}
+	for _, g := range *groups {
+		if g.Name == nil {
+			report.UnmappedGroups = append(report.UnmappedGroups, fmt.Sprintf("label %d", Value(g.GroupID)))
+			continue
+		}
+		id, ok := t.groups[*g.Name]
+		if !ok {
+			report.UnmappedGroups = append(report.UnmappedGroups, *g.Name)
+			continue
+		}
+		mapped = append(mapped, GroupLabel{GroupID: Int64(id)})
+	}
+	return &mapped
+}
+
+func intValue(i *int) int {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
+
+// getCloneSource gets a test and decodes it into the struct of its type.
+// Only HTTP server and page load tests are supported.
+func (c *Client) getCloneSource(testID int) (interface{}, string, error) {
+	resp, err := c.get(fmt.Sprintf("/tests/%d", testID))
+	if err != nil {
+		return nil, "", err
+	}
+	var target map[string][]json.RawMessage
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, "", fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	if len(target["test"]) < 1 {
+		return nil, "", fmt.Errorf("'test' not found in JSON response")
+	}
+	raw := target["test"][0]
+	var header struct {
+		Type *string `json:"type"`
+	}
+	if err := json.Unmarshal(raw, &header); err != nil {
+		return nil, "", fmt.Errorf("Could not decode JSON response: %v", err)
+	}
+	if header.Type == nil {
+		return nil, "", fmt.Errorf("test %d has no type", testID)
+	}
+	var test interface{}
+	switch *header.Type {
+	case "http-server":
+		test = &HTTPServer{}
+	case "page-load":
+		test = &PageLoad{}
+	default:
+		return nil, "", fmt.Errorf("cloning %s tests is not supported", *header.Type)
+	}
+	if err := json.Unmarshal(raw, test); err != nil {
+		return nil, "", fmt.Errorf("Could not decode JSON response: %v", err)
+	}
+	if err := c.checkDecoded(test); err != nil {
+		return nil, "", fmt.Errorf("Could not decode JSON response: %v", err)
+	}
+	return test, *header.Type, nil
+}
+
+// CloneTest - Copy an HTTP server or page load test into the account group
+// targetAID.  Read-only fields are cleared, as are fields the SDK does not
+// model, which may only apply to the source account group.  Agents, alert
+// rules and labels are matched by name in the target account group.
+// Objects which cannot be matched are left off the new test and listed in
+// the report.
+func (c *Client) CloneTest(testID int, targetAID string, overrides CloneOverrides) (*CloneReport, error) {
+	source, testType, err := c.getCloneSource(testID)
+	if err != nil {
+		return nil, err
+	}
+
+	target := *c
+	target.AccountGroupID = targetAID
+	names, err := target.getStateNames()
+	if err != nil {
+		return nil, err
+	}
+	report := &CloneReport{Type: testType}
+
+	switch t := source.(type) {
+	case *HTTPServer:
+		t.TestID, t.CreatedBy, t.CreatedDate, t.ModifiedBy, t.ModifiedDate, t.APILinks = nil, nil, nil, nil, nil, nil
+		t.SavedEvent, t.LiveShare, t.SharedWithAccounts, t.Type, t.Extra = nil, nil, nil, nil, nil
+		t.Agents = names.remapAgents(t.Agents, report)
+		t.AlertRules = names.remapAlertRules(t.AlertRules, report)
+		t.Groups = names.remapGroups(t.Groups, report)
+		overrides.apply(&t.TestName, &t.Description, &t.Enabled, &t.AlertsEnabled, &t.Interval)
+		created, err := target.CreateHTTPServer(*t)
+		if err != nil {
+			return nil, err
+		}
+		if created.TestID != nil {
+			report.TestID = *created.TestID
+		}
+	case *PageLoad:
+		t.TestID, t.CreatedBy, t.CreatedDate, t.ModifiedBy, t.ModifiedDate, t.APILinks = nil, nil, nil, nil, nil, nil
+		t.SavedEvent, t.LiveShare, t.SharedWithAccounts, t.Type, t.Extra = nil, nil, nil, nil, nil
+		t.Agents = names.remapAgents(t.Agents, report)
+		t.AlertRules = names.remapAlertRules(t.AlertRules, report)
+		t.Groups = names.remapGroups(t.Groups, report)
+		overrides.apply(&t.TestName, &t.Description, &t.Enabled, &t.AlertsEnabled, &t.Interval)
+		created, err := target.CreatePageLoad(*t)
+		if err != nil {
+			return nil, err
+		}
+		if created.TestID != nil {
+			report.TestID = *created.TestID
+		}
+	}
+	return report, nil
+}
+
+// apply sets the overridden fields of a test
+func (o CloneOverrides) apply(name, description **string, enabled, alertsEnabled **bool, interval **int) {
+	if o.TestName != nil {
+		*name = o.TestName
+	}
+	if o.Description != nil {
+		*description = o.Description
+	}
+	if o.Enabled != nil {
+		*enabled = o.Enabled
+	}
+	if o.AlertsEnabled != nil {
+		*alertsEnabled = o.AlertsEnabled
+	}
+	if o.Interval != nil {
+		*interval = o.Interval
+	}
+}
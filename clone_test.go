@@ -0,0 +1,103 @@
+package thousandeyes
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func cloneTargetServer(t *testing.T) {
+	mux.HandleFunc("/agents.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2", r.URL.Query().Get("aid"))
+		_, _ = w.Write([]byte(`{"agents":[{"agentId":30,"agentName":"London"},{"agentId":31,"agentName":"Tokyo"}]}`))
+	})
+	mux.HandleFunc("/alert-rules.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2", r.URL.Query().Get("aid"))
+		_, _ = w.Write([]byte(`{"alertRules":[{"ruleId":40,"ruleName":"Default HTTP"}]}`))
+	})
+	mux.HandleFunc("/groups/tests.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2", r.URL.Query().Get("aid"))
+		_, _ = w.Write([]byte(`{"groups":[{"groupId":50,"name":"web","type":"tests"}]}`))
+	})
+}
+
+func TestClient_CloneHTTPServer(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	cloneTargetServer(t)
+	reads := 0
+	mux.HandleFunc("/tests/1.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "", r.URL.Query().Get("aid"))
+		reads++
+		_, _ = w.Write([]byte(`{"test":[{"testId":1,"testName":"staging web","type":"http-server","createdBy":"Alice","createdDate":"2022-06-01 10:00:00","modifiedBy":"Bob","modifiedDate":"2022-06-02 10:00:00","apiLinks":[{"rel":"self","href":"https://api.thousandeyes.com/v6/tests/1"}],"url":"https://staging.example.com","interval":60,"enabled":1,"agents":[{"agentId":10,"agentName":"London"},{"agentId":11,"agentName":"Staging DC"}],"alertRules":[{"ruleId":20,"ruleName":"Default HTTP"},{"ruleId":21,"ruleName":"Staging only"}],"groups":[{"groupId":5,"name":"web"}],"sourceOnly":"x"}]}`))
+	})
+	mux.HandleFunc("/tests/http-server/new.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2", r.URL.Query().Get("aid"))
+		var payload map[string]interface{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&payload))
+		for _, key := range []string{"testId", "createdBy", "createdDate", "modifiedBy", "modifiedDate", "apiLinks", "type", "sourceOnly"} {
+			assert.NotContains(t, payload, key)
+		}
+		assert.Equal(t, "production web", payload["testName"])
+		assert.Equal(t, float64(300), payload["interval"])
+		assert.Equal(t, "https://staging.example.com", payload["url"])
+		assert.Equal(t, []interface{}{map[string]interface{}{"agentId": float64(30)}}, payload["agents"])
+		assert.Equal(t, []interface{}{map[string]interface{}{"ruleId": float64(40)}}, payload["alertRules"])
+		assert.Equal(t, []interface{}{map[string]interface{}{"groupId": float64(50)}}, payload["groups"])
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"test":[{"testId":100,"testName":"production web","type":"http-server"}]}`))
+	})
+
+	report, err := client.CloneTest(1, "2", CloneOverrides{TestName: String("production web"), Interval: Int(300)})
+	teardown()
+	assert.Nil(t, err)
+	expected := CloneReport{
+		TestID:             100,
+		Type:               "http-server",
+		UnmappedAgents:     []string{"Staging DC"},
+		UnmappedAlertRules: []string{"Staging only"},
+	}
+	assert.Equal(t, &expected, report)
+	assert.False(t, report.Complete())
+	assert.Equal(t, "cloned http-server test 100 without agents: Staging DC; alert rules: Staging only", report.String())
+	assert.Equal(t, 1, reads)
+}
+
+func TestClient_ClonePageLoad(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	cloneTargetServer(t)
+	mux.HandleFunc("/tests/2.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"test":[{"testId":2,"testName":"home page","type":"page-load","url":"https://example.com","agents":[{"agentId":12,"agentName":"Tokyo"}],"groups":[{"groupId":5,"name":"web"},{"groupId":6}]}]}`))
+	})
+	mux.HandleFunc("/tests/page-load/new.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2", r.URL.Query().Get("aid"))
+		var payload PageLoad
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Nil(t, payload.TestID)
+		assert.Equal(t, &[]Agent{{AgentID: Int(31)}}, payload.Agents)
+		assert.Equal(t, &[]GroupLabel{{GroupID: Int64(50)}}, payload.Groups)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"test":[{"testId":200,"type":"page-load"}]}`))
+	})
+
+	report, err := client.CloneTest(2, "2", CloneOverrides{})
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &CloneReport{TestID: 200, Type: "page-load", UnmappedGroups: []string{"label 6"}}, report)
+	assert.False(t, report.Complete())
+}
+
+func TestClient_CloneTestUnsupported(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/tests/3.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"test":[{"testId":3,"type":"dns-server"}]}`))
+	})
+
+	_, err := client.CloneTest(3, "2", CloneOverrides{})
+	teardown()
+	assert.EqualError(t, err, "cloning dns-server tests is not supported")
+}
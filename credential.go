@@ -0,0 +1,141 @@
+package thousandeyes
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Credential - a secret stored for use by web transaction scripts through
+// credentials.get().  Value is write-only: it is sent when creating or
+// updating a credential, is never returned by the API, and is redacted when
+// the credential is formatted.
+type Credential struct {
+	CredentialID *int    `json:"credentialId,omitempty"`
+	Name         *string `json:"name,omitempty"`
+	Value        *string `json:"value,omitempty"`
+	CreatedBy    *string `json:"createdBy,omitempty"`
+	CreatedDate  *string `json:"createdDate,omitempty"`
+	ModifiedBy   *string `json:"modifiedBy,omitempty"`
+	ModifiedDate *string `json:"modifiedDate,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// String implements fmt.Stringer, omitting the credential value.
+func (c Credential) String() string {
+	id, name := "<nil>", "<nil>"
+	if c.CredentialID != nil {
+		id = fmt.Sprint(*c.CredentialID)
+	}
+	if c.Name != nil {
+		name = *c.Name
+	}
+	value := "<nil>"
+	if c.Value != nil {
+		value = "<redacted>"
+	}
+	return fmt.Sprintf("Credential{CredentialID: %s, Name: %s, Value: %s}", id, name, value)
+}
+
+// GoString implements fmt.GoStringer so that %#v does not print the value.
+func (c Credential) GoString() string {
+	return c.String()
+}
+
+// decodeCredentials decodes the credentials in a response, discarding any
+// values so that they are not held in memory.
+func (c *Client) decodeCredentials(resp *http.Response) ([]Credential, error) {
+	var target map[string][]Credential
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	credentials := target["credentials"]
+	for i := range credentials {
+		credentials[i].Value = nil
+	}
+	return credentials, nil
+}
+
+// GetCredentials - Get credentials.  Values are not included.
+func (c *Client) GetCredentials() (*[]Credential, error) {
+	resp, err := c.get("/credentials")
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := c.decodeCredentials(resp)
+	if err != nil {
+		return nil, err
+	}
+	return &credentials, nil
+}
+
+// GetCredential - Get credential.  The value is not included.
+func (c *Client) GetCredential(id int) (*Credential, error) {
+	resp, err := c.get(fmt.Sprintf("/credentials/%d", id))
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := c.decodeCredentials(resp)
+	if err != nil {
+		return nil, err
+	}
+	if len(credentials) < 1 {
+		return nil, fmt.Errorf("Could not get credential %d", id)
+	}
+	return &credentials[0], nil
+}
+
+// CreateCredential - Create credential
+func (c *Client) CreateCredential(cred Credential) (*Credential, error) {
+	if cred.Name == nil || cred.Value == nil {
+		return nil, fmt.Errorf("credential name and value are required")
+	}
+	resp, err := c.post("/credentials/new", cred, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 201 {
+		return nil, fmt.Errorf("failed to create credential, response code %d", resp.StatusCode)
+	}
+	credentials, err := c.decodeCredentials(resp)
+	if err != nil {
+		return nil, err
+	}
+	if len(credentials) < 1 {
+		return nil, fmt.Errorf("'credentials' not found in JSON response")
+	}
+	return &credentials[0], nil
+}
+
+// UpdateCredential - Update credential.  Set Value to rotate the secret.
+func (c *Client) UpdateCredential(id int, cred Credential) (*Credential, error) {
+	resp, err := c.post(fmt.Sprintf("/credentials/%d/update", id), cred, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("failed to update credential, response code %d", resp.StatusCode)
+	}
+	credentials, err := c.decodeCredentials(resp)
+	if err != nil {
+		return nil, err
+	}
+	if len(credentials) < 1 {
+		return nil, fmt.Errorf("'credentials' not found in JSON response")
+	}
+	return &credentials[0], nil
+}
+
+// DeleteCredential - Delete credential
+func (c *Client) DeleteCredential(id int) error {
+	resp, err := c.post(fmt.Sprintf("/credentials/%d/delete", id), nil, nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != 204 {
+		return fmt.Errorf("failed to delete credential, response code %d", resp.StatusCode)
+	}
+	return nil
+}
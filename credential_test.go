@@ -0,0 +1,105 @@
+package thousandeyes
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetCredentials(t *testing.T) {
+	out := `{"credentials":[{"credentialId":1,"name":"login","createdBy":"Alice"},{"credentialId":2,"name":"api key","value":"leaked"}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/credentials.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		_, _ = w.Write([]byte(out))
+	})
+
+	res, err := client.GetCredentials()
+	teardown()
+	assert.Nil(t, err)
+	expected := []Credential{
+		{CredentialID: Int(1), Name: String("login"), CreatedBy: String("Alice")},
+		{CredentialID: Int(2), Name: String("api key")},
+	}
+	assert.Equal(t, &expected, res)
+}
+
+func TestClient_GetCredential(t *testing.T) {
+	out := `{"credentials":[{"credentialId":1,"name":"login"}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/credentials/1.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		_, _ = w.Write([]byte(out))
+	})
+
+	res, err := client.GetCredential(1)
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &Credential{CredentialID: Int(1), Name: String("login")}, res)
+}
+
+func TestClient_CreateCredential(t *testing.T) {
+	out := `{"credentials":[{"credentialId":1,"name":"login"}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/credentials/new.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name":"login","value":"hunter2"}`, string(body))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(out))
+	})
+
+	res, err := client.CreateCredential(Credential{Name: String("login"), Value: String("hunter2")})
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &Credential{CredentialID: Int(1), Name: String("login")}, res)
+
+	_, err = client.CreateCredential(Credential{Name: String("login")})
+	assert.EqualError(t, err, "credential name and value are required")
+}
+
+func TestClient_UpdateCredential(t *testing.T) {
+	out := `{"credentials":[{"credentialId":1,"name":"login"}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/credentials/1/update.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"value":"rotated"}`, string(body))
+		_, _ = w.Write([]byte(out))
+	})
+
+	res, err := client.UpdateCredential(1, Credential{Value: String("rotated")})
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &Credential{CredentialID: Int(1), Name: String("login")}, res)
+}
+
+func TestClient_DeleteCredential(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/credentials/1/delete.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := client.DeleteCredential(1)
+	teardown()
+	assert.Nil(t, err)
+}
+
+func TestCredential_String(t *testing.T) {
+	cred := Credential{CredentialID: Int(1), Name: String("login"), Value: String("hunter2")}
+	for _, format := range []string{"%v", "%+v", "%#v", "%s"} {
+		out := fmt.Sprintf(format, cred)
+		assert.NotContains(t, out, "hunter2", format)
+		assert.Contains(t, out, "<redacted>", format)
+	}
+	assert.NotContains(t, fmt.Sprintf("%v", &cred), "hunter2")
+}
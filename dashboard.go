@@ -0,0 +1,387 @@
+package thousandeyes
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Dashboards - list of dashboards
+type Dashboards []Dashboard
+
+// Dashboard - a dashboard
+type Dashboard struct {
+	DashboardID      *string            `json:"dashboardId,omitempty"`
+	Title            *string            `json:"title,omitempty"`
+	Description      *string            `json:"description,omitempty"`
+	IsBuiltIn        *bool              `json:"isBuiltIn,omitempty"`
+	IsPrivate        *bool              `json:"isPrivate,omitempty"`
+	IsGlobalOverride *bool              `json:"isGlobalOverride,omitempty"`
+	CreatedBy        *string            `json:"createdBy,omitempty"`
+	ModifiedBy       *string            `json:"modifiedBy,omitempty"`
+	ModifiedDate     *string            `json:"modifiedDate,omitempty"`
+	Widgets          *[]DashboardWidget `json:"widgets,omitempty"`
+	APILinks         *[]APILink         `json:"apiLinks,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// DashboardWidgetType - the visualisation used by a dashboard widget
+type DashboardWidgetType string
+
+// Dashboard widget types
+const (
+	DashboardWidgetTimeSeriesLine        DashboardWidgetType = "Time Series: Line"
+	DashboardWidgetTimeSeriesStackedArea DashboardWidgetType = "Time Series: Stacked Area"
+	DashboardWidgetNumber                DashboardWidgetType = "Number"
+	DashboardWidgetNumbersCard           DashboardWidgetType = "Numbers Card"
+	DashboardWidgetTable                 DashboardWidgetType = "Table"
+	DashboardWidgetMultiMetricTable      DashboardWidgetType = "Multi Metric Table"
+	DashboardWidgetBarChartStacked       DashboardWidgetType = "Bar Chart: Stacked"
+	DashboardWidgetBarChartGrouped       DashboardWidgetType = "Bar Chart: Grouped"
+	DashboardWidgetPieChart              DashboardWidgetType = "Pie Chart"
+	DashboardWidgetMap                   DashboardWidgetType = "Map"
+	DashboardWidgetBox                   DashboardWidgetType = "Box and Whiskers"
+	DashboardWidgetAlertList             DashboardWidgetType = "Alert List"
+	DashboardWidgetColorGrid             DashboardWidgetType = "Color Grid"
+	DashboardWidgetAgentStatus           DashboardWidgetType = "Agent Status"
+	DashboardWidgetTestTable             DashboardWidgetType = "Test Table"
+)
+
+// DashboardWidget - a widget on a dashboard
+type DashboardWidget struct {
+	ID            *string                  `json:"id,omitempty"`
+	Type          *DashboardWidgetType     `json:"type,omitempty"`
+	Title         *string                  `json:"title,omitempty"`
+	Visual        *string                  `json:"visualMode,omitempty"`
+	DataSource    *string                  `json:"dataSource,omitempty"`
+	MetricGroup   *string                  `json:"metricGroup,omitempty"`
+	Metric        *string                  `json:"metric,omitempty"`
+	Direction     *string                  `json:"direction,omitempty"`
+	Measure       *DashboardWidgetMeasure  `json:"measure,omitempty"`
+	Filters       *DashboardWidgetFilters  `json:"filters,omitempty"`
+	FixedTimespan *DashboardWidgetTimespan `json:"fixedTimespan,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// DashboardWidgetMeasure - how a widget aggregates its metric
+type DashboardWidgetMeasure struct {
+	Type       *string `json:"type,omitempty"`
+	Percentile *int    `json:"percentile,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// DashboardWidgetTimespan - a fixed time range displayed by a widget
+type DashboardWidgetTimespan struct {
+	Value *int    `json:"value,omitempty"`
+	Unit  *string `json:"unit,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// DashboardWidgetFilters - the objects a widget displays data for
+type DashboardWidgetFilters struct {
+	Tests  *[]int64 `json:"tests,omitempty"`
+	Agents *[]int   `json:"agents,omitempty"`
+	Labels *[]int64 `json:"labels,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// AddWidget - add a widget to the dashboard
+func (d *Dashboard) AddWidget(w DashboardWidget) {
+	if d.Widgets == nil {
+		d.Widgets = &[]DashboardWidget{}
+	}
+	*d.Widgets = append(*d.Widgets, w)
+}
+
+// GetDashboards - Get dashboards
+func (c *Client) GetDashboards() (*Dashboards, error) {
+	resp, err := c.get("/dashboards")
+	if err != nil {
+		return nil, err
+	}
+	var target map[string]Dashboards
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	dashboards := target["dashboards"]
+	return &dashboards, nil
+}
+
+// GetDashboard - Get dashboard
+func (c *Client) GetDashboard(id string) (*Dashboard, error) {
+	resp, err := c.get(fmt.Sprintf("/dashboards/%s", id))
+	if err != nil {
+		return nil, err
+	}
+	var target map[string][]Dashboard
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	if len(target["dashboards"]) < 1 {
+		return nil, fmt.Errorf("Could not get dashboard %v", id)
+	}
+	return &target["dashboards"][0], nil
+}
+
+// CreateDashboard - Create dashboard
+func (c *Client) CreateDashboard(d Dashboard) (*Dashboard, error) {
+	resp, err := c.post("/dashboards/new", d, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 201 {
+		return nil, fmt.Errorf("failed to create dashboard, response code %d", resp.StatusCode)
+	}
+	var target map[string][]Dashboard
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	if len(target["dashboards"]) < 1 {
+		return nil, fmt.Errorf("'dashboards' not found in JSON response")
+	}
+	return &target["dashboards"][0], nil
+}
+
+// UpdateDashboard - Update dashboard
+func (c *Client) UpdateDashboard(id string, d Dashboard) (*Dashboard, error) {
+	resp, err := c.post(fmt.Sprintf("/dashboards/%s/update", id), d, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("failed to update dashboard, response code %d", resp.StatusCode)
+	}
+	var target map[string][]Dashboard
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	if len(target["dashboards"]) < 1 {
+		return nil, fmt.Errorf("'dashboards' not found in JSON response")
+	}
+	return &target["dashboards"][0], nil
+}
+
+// DeleteDashboard - Delete dashboard
+func (c *Client) DeleteDashboard(id string) error {
+	resp, err := c.post(fmt.Sprintf("/dashboards/%s/delete", id), nil, nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != 204 {
+		return fmt.Errorf("failed to delete dashboard, response code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// DashboardExport - a dashboard which can be imported into another account
+// group.  TestNames maps the test IDs referenced by widgets to test names,
+// and Labels maps the label IDs referenced by widgets to the names and types
+// of the labels.  They are used to find the matching tests and labels when
+// importing.
+type DashboardExport struct {
+	Dashboard Dashboard            `json:"dashboard"`
+	TestNames map[int64]string     `json:"testNames"`
+	Labels    map[int64]GroupLabel `json:"labels,omitempty"`
+}
+
+// dashboardLabelKey returns the key a label is matched by when importing a
+// dashboard, as label names are only unique within a label type.
+func dashboardLabelKey(l GroupLabel) string {
+	if l.Type == nil || l.Name == nil {
+		return ""
+	}
+	return string(*l.Type) + "/" + *l.Name
+}
+
+// ExportDashboard - Export a dashboard, without server-assigned fields, along
+// with the names of the tests and labels its widgets reference.
+func (c *Client) ExportDashboard(id string) (*DashboardExport, error) {
+	dashboard, err := c.GetDashboard(id)
+	if err != nil {
+		return nil, err
+	}
+	tests, err := c.GetTests()
+	if err != nil {
+		return nil, err
+	}
+	names := map[int64]string{}
+	for _, t := range *tests {
+		if t.TestID != nil && t.TestName != nil {
+			names[*t.TestID] = *t.TestName
+		}
+	}
+	labels := map[int64]GroupLabel{}
+	if dashboardReferencesLabels(dashboard) {
+		all, err := c.GetGroupLabels()
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range *all {
+			if l.GroupID != nil && dashboardLabelKey(l) != "" {
+				labels[*l.GroupID] = GroupLabel{Name: l.Name, Type: l.Type}
+			}
+		}
+	}
+
+	export := DashboardExport{
+		Dashboard: Dashboard{
+			Title:            dashboard.Title,
+			Description:      dashboard.Description,
+			IsPrivate:        dashboard.IsPrivate,
+			IsGlobalOverride: dashboard.IsGlobalOverride,
+		},
+		TestNames: map[int64]string{},
+	}
+	if dashboard.Widgets != nil {
+		for _, w := range *dashboard.Widgets {
+			w.ID = nil
+			if w.Filters != nil && w.Filters.Tests != nil {
+				for _, testID := range *w.Filters.Tests {
+					name, ok := names[testID]
+					if !ok {
+						return nil, fmt.Errorf("widget references unknown test %d", testID)
+					}
+					export.TestNames[testID] = name
+				}
+			}
+			if w.Filters != nil && w.Filters.Labels != nil {
+				for _, labelID := range *w.Filters.Labels {
+					label, ok := labels[labelID]
+					if !ok {
+						return nil, fmt.Errorf("widget references unknown label %d", labelID)
+					}
+					if export.Labels == nil {
+						export.Labels = map[int64]GroupLabel{}
+					}
+					export.Labels[labelID] = label
+				}
+			}
+			export.Dashboard.AddWidget(w)
+		}
+	}
+	return &export, nil
+}
+
+// dashboardReferencesLabels reports whether any widget of d filters by label.
+func dashboardReferencesLabels(d *Dashboard) bool {
+	if d.Widgets == nil {
+		return false
+	}
+	for _, w := range *d.Widgets {
+		if w.Filters != nil && w.Filters.Labels != nil && len(*w.Filters.Labels) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ImportDashboard - Create a dashboard from an export, replacing the test and
+// label IDs referenced by widgets with the IDs of the tests and labels with
+// the same names.
+func (c *Client) ImportDashboard(export DashboardExport) (*Dashboard, error) {
+	tests, err := c.GetTests()
+	if err != nil {
+		return nil, err
+	}
+	testIDs := map[string]int64{}
+	for _, t := range *tests {
+		if t.TestID != nil && t.TestName != nil {
+			testIDs[*t.TestName] = *t.TestID
+		}
+	}
+	labelIDs := map[string]int64{}
+	if len(export.Labels) > 0 {
+		labels, err := c.GetGroupLabels()
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range *labels {
+			if l.GroupID != nil && dashboardLabelKey(l) != "" {
+				labelIDs[dashboardLabelKey(l)] = *l.GroupID
+			}
+		}
+	}
+
+	dashboard := export.Dashboard
+	dashboard.Widgets = nil
+	missingTests := map[string]bool{}
+	missingLabels := map[string]bool{}
+	if export.Dashboard.Widgets != nil {
+		for _, w := range *export.Dashboard.Widgets {
+			if w.Filters != nil && w.Filters.Tests != nil {
+				filters := *w.Filters
+				remapped := []int64{}
+				for _, testID := range *w.Filters.Tests {
+					name, ok := export.TestNames[testID]
+					if !ok {
+						return nil, fmt.Errorf("export has no name for test %d", testID)
+					}
+					newID, ok := testIDs[name]
+					if !ok {
+						missingTests[name] = true
+						continue
+					}
+					remapped = append(remapped, newID)
+				}
+				filters.Tests = &remapped
+				w.Filters = &filters
+			}
+			if w.Filters != nil && w.Filters.Labels != nil {
+				filters := *w.Filters
+				remapped := []int64{}
+				for _, labelID := range *w.Filters.Labels {
+					label, ok := export.Labels[labelID]
+					if !ok {
+						return nil, fmt.Errorf("export has no name for label %d", labelID)
+					}
+					newID, ok := labelIDs[dashboardLabelKey(label)]
+					if !ok {
+						missingLabels[dashboardLabelKey(label)] = true
+						continue
+					}
+					remapped = append(remapped, newID)
+				}
+				filters.Labels = &remapped
+				w.Filters = &filters
+			}
+			dashboard.AddWidget(w)
+		}
+	}
+	if len(missingTests) > 0 {
+		return nil, fmt.Errorf("tests not found: %s", sortedKeys(missingTests))
+	}
+	if len(missingLabels) > 0 {
+		return nil, fmt.Errorf("labels not found: %s", sortedKeys(missingLabels))
+	}
+	return c.CreateDashboard(dashboard)
+}
+
+// sortedKeys returns the keys of m, sorted and comma separated.
+func sortedKeys(m map[string]bool) string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ", ")
+}
+
+// CloneDashboard - Copy a dashboard to the account group of the target client
+func (c *Client) CloneDashboard(id string, target *Client) (*Dashboard, error) {
+	export, err := c.ExportDashboard(id)
+	if err != nil {
+		return nil, err
+	}
+	return target.ImportDashboard(*export)
+}
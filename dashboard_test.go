@@ -0,0 +1,197 @@
+package thousandeyes
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetDashboards(t *testing.T) {
+	out := `{"dashboards":[{"dashboardId":"abc","title":"Overview","isBuiltIn":false},{"dashboardId":"def","title":"Web"}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/dashboards.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		_, _ = w.Write([]byte(out))
+	})
+
+	expected := Dashboards{
+		{DashboardID: String("abc"), Title: String("Overview"), IsBuiltIn: Bool(false)},
+		{DashboardID: String("def"), Title: String("Web")},
+	}
+	res, err := client.GetDashboards()
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &expected, res)
+}
+
+func TestClient_GetDashboard(t *testing.T) {
+	out := `{"dashboards":[{"dashboardId":"abc","title":"Overview","widgets":[{"id":"w1","type":"Time Series: Line","title":"Availability","dataSource":"CLOUD_AND_ENTERPRISE_AGENTS","metricGroup":"WEB_HTTP_SERVER","metric":"WEB_AVAILABILITY","measure":{"type":"MEAN"},"filters":{"tests":[10,11]}}]}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/dashboards/abc.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		_, _ = w.Write([]byte(out))
+	})
+
+	expected := Dashboard{
+		DashboardID: String("abc"),
+		Title:       String("Overview"),
+		Widgets: &[]DashboardWidget{
+			{
+				ID:          String("w1"),
+				Type:        Ptr(DashboardWidgetTimeSeriesLine),
+				Title:       String("Availability"),
+				DataSource:  String("CLOUD_AND_ENTERPRISE_AGENTS"),
+				MetricGroup: String("WEB_HTTP_SERVER"),
+				Metric:      String("WEB_AVAILABILITY"),
+				Measure:     &DashboardWidgetMeasure{Type: String("MEAN")},
+				Filters:     &DashboardWidgetFilters{Tests: &[]int64{10, 11}},
+			},
+		},
+	}
+	res, err := client.GetDashboard("abc")
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &expected, res)
+}
+
+func TestClient_CreateDashboard(t *testing.T) {
+	out := `{"dashboards":[{"dashboardId":"abc","title":"Overview"}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/dashboards/new.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(out))
+	})
+
+	res, err := client.CreateDashboard(Dashboard{Title: String("Overview")})
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &Dashboard{DashboardID: String("abc"), Title: String("Overview")}, res)
+}
+
+func TestClient_UpdateDashboard(t *testing.T) {
+	out := `{"dashboards":[{"dashboardId":"abc","title":"Renamed"}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/dashboards/abc/update.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		_, _ = w.Write([]byte(out))
+	})
+
+	res, err := client.UpdateDashboard("abc", Dashboard{Title: String("Renamed")})
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &Dashboard{DashboardID: String("abc"), Title: String("Renamed")}, res)
+}
+
+func TestClient_DeleteDashboard(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/dashboards/abc/delete.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := client.DeleteDashboard("abc")
+	teardown()
+	assert.Nil(t, err)
+}
+
+func TestClient_CloneDashboard(t *testing.T) {
+	setup()
+	var source = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/dashboards/abc.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"dashboards":[{"dashboardId":"abc","title":"Overview","isBuiltIn":false,"widgets":[{"id":"w1","type":"Number","filters":{"tests":[10,11],"labels":[5]}}]}]}`))
+	})
+	mux.HandleFunc("/tests.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"test":[{"testId":10,"testName":"web"},{"testId":11,"testName":"dns"}]}`))
+	})
+	mux.HandleFunc("/groups.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"groups":[{"groupId":5,"name":"prod","type":"tests"},{"groupId":6,"name":"prod","type":"agents"}]}`))
+	})
+
+	targetMux := http.NewServeMux()
+	targetServer := httptest.NewServer(targetMux)
+	defer targetServer.Close()
+	var target = &Client{APIEndpoint: targetServer.URL, AuthToken: "foo"}
+	targetMux.HandleFunc("/tests.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"test":[{"testId":21,"testName":"dns"},{"testId":20,"testName":"web"}]}`))
+	})
+	targetMux.HandleFunc("/groups.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"groups":[{"groupId":16,"name":"prod","type":"agents"},{"groupId":15,"name":"prod","type":"tests"}]}`))
+	})
+	targetMux.HandleFunc("/dashboards/new.json", func(w http.ResponseWriter, r *http.Request) {
+		var d Dashboard
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&d))
+		assert.Nil(t, d.DashboardID)
+		assert.Nil(t, d.IsBuiltIn)
+		assert.Nil(t, (*d.Widgets)[0].ID)
+		assert.Equal(t, &[]int64{20, 21}, (*d.Widgets)[0].Filters.Tests)
+		assert.Equal(t, &[]int64{15}, (*d.Widgets)[0].Filters.Labels)
+		assert.Equal(t, Ptr(DashboardWidgetNumber), (*d.Widgets)[0].Type)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"dashboards":[{"dashboardId":"xyz","title":"Overview"}]}`))
+	})
+
+	res, err := source.CloneDashboard("abc", target)
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &Dashboard{DashboardID: String("xyz"), Title: String("Overview")}, res)
+}
+
+func TestClient_ImportDashboardMissingTests(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/tests.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"test":[{"testId":20,"testName":"web"}]}`))
+	})
+	mux.HandleFunc("/dashboards/new.json", func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("dashboard must not be created when tests are missing")
+	})
+
+	export := DashboardExport{
+		Dashboard: Dashboard{
+			Title: String("Overview"),
+			Widgets: &[]DashboardWidget{
+				{Filters: &DashboardWidgetFilters{Tests: &[]int64{10, 11, 12}}},
+			},
+		},
+		TestNames: map[int64]string{10: "web", 11: "dns", 12: "bgp"},
+	}
+	_, err := client.ImportDashboard(export)
+	teardown()
+	assert.EqualError(t, err, "tests not found: bgp, dns")
+}
+
+func TestClient_ImportDashboardMissingLabels(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/tests.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"test":[]}`))
+	})
+	mux.HandleFunc("/groups.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"groups":[{"groupId":16,"name":"prod","type":"agents"}]}`))
+	})
+	mux.HandleFunc("/dashboards/new.json", func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("dashboard must not be created when labels are missing")
+	})
+
+	export := DashboardExport{
+		Dashboard: Dashboard{
+			Title: String("Overview"),
+			Widgets: &[]DashboardWidget{
+				{Filters: &DashboardWidgetFilters{Labels: &[]int64{5}}},
+			},
+		},
+		Labels: map[int64]GroupLabel{5: {Name: String("prod"), Type: Ptr(LabelTypeTests)}},
+	}
+	_, err := client.ImportDashboard(export)
+	teardown()
+	assert.EqualError(t, err, "labels not found: tests/prod")
+}
@@ -29,46 +29,26 @@ type DNSSec struct {
 	Agents   *[]Agent `json:"agents,omitempty"`
 	Domain   *string  `json:"domain,omitempty"`
 	Interval *int     `json:"interval,omitempty"`
-}
-
-// MarshalJSON implements the json.Marshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t DNSSec) MarshalJSON() ([]byte, error) {
-	type aliasTest DNSSec
-
-	data, err := json.Marshal((aliasTest)(t))
-	if err != nil {
-		return nil, err
-	}
-
-	return jsonBoolToInt(&t, data)
-}
 
-// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t *DNSSec) UnmarshalJSON(data []byte) error {
-	type aliasTest DNSSec
-	test := (*aliasTest)(t)
-
-	data, err := jsonIntToBool(t, data)
-	if err != nil {
-		return err
-	}
-
-	return json.Unmarshal(data, &test)
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // AddAgent - Add agent to DNSSec test
 func (t *DNSSec) AddAgent(id int) {
 	agent := Agent{AgentID: Int(id)}
+	if t.Agents == nil {
+		t.Agents = &[]Agent{}
+	}
 	*t.Agents = append(*t.Agents, agent)
 }
 
 // AddAlertRule - Adds an alert to agent test
 func (t *DNSSec) AddAlertRule(id int) {
 	alertRule := AlertRule{RuleID: Int(id)}
+	if t.AlertRules == nil {
+		t.AlertRules = &[]AlertRule{}
+	}
 	*t.AlertRules = append(*t.AlertRules, alertRule)
 }
 
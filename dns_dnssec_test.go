@@ -1,6 +1,7 @@
 package thousandeyes
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
 
@@ -68,6 +69,9 @@ func TestClient_GetDNSSec(t *testing.T) {
 				Rel:  String("data"),
 			},
 		},
+		Extra: map[string]json.RawMessage{
+			"dnsTransportProtocol": json.RawMessage(`"UDP"`),
+		},
 	}
 
 	res, err := client.GetDNSSec(122621)
@@ -28,19 +28,19 @@ func TestClient_GetDNSServer(t *testing.T) {
 		Type:                  String("dns-server"),
 		Interval:              Int(300),
 		LiveShare:             Bool(false),
-		Protocol:              String("UDP"),
+		Protocol:              Protocol("UDP").Ptr(),
 		NetworkMeasurements:   Bool(true),
 		MTUMeasurements:       Bool(true),
 		BandwidthMeasurements: Bool(false),
 		NumPathTraces:         Int(3),
-		PathTraceMode:         String("classic"),
+		PathTraceMode:         PathTraceMode("classic").Ptr(),
 		AlertsEnabled:         Bool(true),
 		RecursiveQueries:      Bool(false),
 		BGPMeasurements:       Bool(true),
 		UsePublicBGP:          Bool(true),
 		Domain:                String("webex.com"),
-		ProbeMode:             String("AUTO"),
-		DNSTransportProtocol:  String("UDP"),
+		ProbeMode:             ProbeMode("AUTO").Ptr(),
+		DNSTransportProtocol:  DNSTransportProtocol("UDP").Ptr(),
 		Agents: &[]Agent{
 			{
 				AgentID:     Int(48620),
@@ -70,6 +70,7 @@ func TestClient_GetDNSServer(t *testing.T) {
 				MonitorID:   Int(64),
 				IPAddress:   String("2001:240:100:ff::2497:2"),
 				MonitorName: String("Tokyo-3"),
+				CountryID:   String("JP"),
 				Network:     String("IIJ Internet Initiative Japan Inc. (AS 2497)"),
 				MonitorType: String("Public"),
 			},
@@ -147,7 +148,7 @@ func TestClient_CreateDNSServer(t *testing.T) {
 		Type:                  String("dns-server"),
 		Interval:              Int(300),
 		LiveShare:             Bool(false),
-		Protocol:              String("UDP"),
+		Protocol:              Protocol("UDP").Ptr(),
 		NetworkMeasurements:   Bool(true),
 		MTUMeasurements:       Bool(true),
 		BandwidthMeasurements: Bool(false),
@@ -157,8 +158,8 @@ func TestClient_CreateDNSServer(t *testing.T) {
 		BGPMeasurements:       Bool(true),
 		UsePublicBGP:          Bool(true),
 		Domain:                String("webex.com"),
-		ProbeMode:             String("AUTO"),
-		DNSTransportProtocol:  String("UDP"),
+		ProbeMode:             ProbeMode("AUTO").Ptr(),
+		DNSTransportProtocol:  DNSTransportProtocol("UDP").Ptr(),
 		Agents: &[]Agent{
 			{
 				AgentID:     Int(48620),
@@ -188,6 +189,7 @@ func TestClient_CreateDNSServer(t *testing.T) {
 				MonitorID:   Int(64),
 				IPAddress:   String("2001:240:100:ff::2497:2"),
 				MonitorName: String("Tokyo-3"),
+				CountryID:   String("JP"),
 				Network:     String("IIJ Internet Initiative Japan Inc. (AS 2497)"),
 				MonitorType: String("Public"),
 			},
@@ -26,50 +26,30 @@ type DNSTrace struct {
 	LiveShare          *bool                `json:"liveShare,omitempty" te:"int-bool"`
 
 	// Fields unique to this test
-	Agents               *[]Agent `json:"agents,omitempty"`
-	DNSTransportProtocol *string  `json:"dnsTransportProtocol,omitempty"`
-	Domain               *string  `json:"domain,omitempty"`
-	Interval             *int     `json:"interval,omitempty"`
-}
-
-// MarshalJSON implements the json.Marshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t DNSTrace) MarshalJSON() ([]byte, error) {
-	type aliasTest DNSTrace
-
-	data, err := json.Marshal((aliasTest)(t))
-	if err != nil {
-		return nil, err
-	}
-
-	return jsonBoolToInt(&t, data)
-}
+	Agents               *[]Agent              `json:"agents,omitempty"`
+	DNSTransportProtocol *DNSTransportProtocol `json:"dnsTransportProtocol,omitempty"`
+	Domain               *string               `json:"domain,omitempty"`
+	Interval             *int                  `json:"interval,omitempty"`
 
-// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t *DNSTrace) UnmarshalJSON(data []byte) error {
-	type aliasTest DNSTrace
-	test := (*aliasTest)(t)
-
-	data, err := jsonIntToBool(t, data)
-	if err != nil {
-		return err
-	}
-
-	return json.Unmarshal(data, &test)
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // AddAgent - Add agent to DNS Trace test
 func (t *DNSTrace) AddAgent(id int) {
 	agent := Agent{AgentID: Int(id)}
+	if t.Agents == nil {
+		t.Agents = &[]Agent{}
+	}
 	*t.Agents = append(*t.Agents, agent)
 }
 
 // AddAlertRule - Adds an alert to agent test
 func (t *DNSTrace) AddAlertRule(id int) {
 	alertRule := AlertRule{RuleID: Int(id)}
+	if t.AlertRules == nil {
+		t.AlertRules = &[]AlertRule{}
+	}
 	*t.AlertRules = append(*t.AlertRules, alertRule)
 }
 
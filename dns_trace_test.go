@@ -1,6 +1,7 @@
 package thousandeyes
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
 
@@ -29,7 +30,7 @@ func TestClient_GetDNSTrace(t *testing.T) {
 		Interval:             Int(300),
 		LiveShare:            Bool(false),
 		Domain:               String("webex.com"),
-		DNSTransportProtocol: String("UDP"),
+		DNSTransportProtocol: DNSTransportProtocol("UDP").Ptr(),
 		Agents: &[]Agent{
 			{
 				AgentID:     Int(48620),
@@ -70,6 +71,9 @@ func TestClient_GetDNSTrace(t *testing.T) {
 				Rel:  String("data"),
 			},
 		},
+		Extra: map[string]json.RawMessage{
+			"probeMode": json.RawMessage(`"AUTO"`),
+		},
 	}
 
 	res, err := client.GetDNSTrace(122621)
@@ -116,7 +120,7 @@ func TestClient_CreateDNSTrace(t *testing.T) {
 		AlertsEnabled:        Bool(true),
 		LiveShare:            Bool(false),
 		Domain:               String("webex.com"),
-		DNSTransportProtocol: String("UDP"),
+		DNSTransportProtocol: DNSTransportProtocol("UDP").Ptr(),
 		Agents: &[]Agent{
 			{
 				AgentID:     Int(48620),
@@ -158,6 +162,9 @@ func TestClient_CreateDNSTrace(t *testing.T) {
 				Rel:  String("data"),
 			},
 		},
+		Extra: map[string]json.RawMessage{
+			"probeMode": json.RawMessage(`"AUTO"`),
+		},
 	}
 	create := DNSTrace{
 		TestName: String("test1"),
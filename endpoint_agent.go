@@ -0,0 +1,106 @@
+package thousandeyes
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// EndpointAgents - list of endpoint agents
+type EndpointAgents []EndpointAgent
+
+// EndpointAgent - an endpoint experience agent installed on a user's machine
+type EndpointAgent struct {
+	AgentID         *string                   `json:"agentId,omitempty"`
+	AgentName       *string                   `json:"agentName,omitempty"`
+	AgentType       *string                   `json:"agentType,omitempty"`
+	ComputerName    *string                   `json:"computerName,omitempty"`
+	OSVersion       *string                   `json:"osVersion,omitempty"`
+	Platform        *string                   `json:"platform,omitempty"`
+	KernelVersion   *string                   `json:"kernelVersion,omitempty"`
+	Manufacturer    *string                   `json:"manufacturer,omitempty"`
+	Model           *string                   `json:"model,omitempty"`
+	Version         *string                   `json:"version,omitempty"`
+	Status          *string                   `json:"status,omitempty"`
+	LastSeen        *string                   `json:"lastSeen,omitempty"`
+	Created         *string                   `json:"created,omitempty"`
+	PublicIP        *string                   `json:"publicIP,omitempty"`
+	Location        *EndpointAgentLocation    `json:"location,omitempty"`
+	VPNProfiles     *[]EndpointVPNProfile     `json:"vpnProfiles,omitempty"`
+	NetworkProfiles *[]EndpointNetworkProfile `json:"networkInterfaceProfiles,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// EndpointAgentLocation - geographic location reported by an endpoint agent
+type EndpointAgentLocation struct {
+	Latitude     *float64 `json:"latitude,omitempty"`
+	Longitude    *float64 `json:"longitude,omitempty"`
+	LocationName *string  `json:"locationName,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// EndpointVPNProfile - VPN connection detected on an endpoint agent
+type EndpointVPNProfile struct {
+	InterfaceName         *string   `json:"interfaceName,omitempty"`
+	VPNType               *string   `json:"vpnType,omitempty"`
+	VPNGatewayAddress     *string   `json:"vpnGatewayAddress,omitempty"`
+	VPNClientAddresses    *[]string `json:"vpnClientAddresses,omitempty"`
+	VPNClientNetworkRange *[]string `json:"vpnClientNetworkRange,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// EndpointNetworkProfile - network interface of an endpoint agent
+type EndpointNetworkProfile struct {
+	InterfaceName   *string   `json:"interfaceName,omitempty"`
+	InterfaceType   *string   `json:"interfaceType,omitempty"`
+	HardwareType    *string   `json:"hardwareType,omitempty"`
+	IPAddresses     *[]string `json:"ipAddresses,omitempty"`
+	IPv6Addresses   *[]string `json:"ipv6Addresses,omitempty"`
+	DNSServers      *[]string `json:"dnsServers,omitempty"`
+	Gateway         *string   `json:"gateway,omitempty"`
+	ProxyConfigured *bool     `json:"proxyConfigured,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// OnVPN - reports whether the endpoint agent has an active VPN connection
+func (a EndpointAgent) OnVPN() bool {
+	return a.VPNProfiles != nil && len(*a.VPNProfiles) > 0
+}
+
+// GetEndpointAgents - Get endpoint agents
+func (c *Client) GetEndpointAgents() (*EndpointAgents, error) {
+	resp, err := c.get("/endpoint-agents")
+	if err != nil {
+		return &EndpointAgents{}, err
+	}
+	var target map[string]EndpointAgents
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	agents := target["endpointAgents"]
+	return &agents, nil
+}
+
+// GetEndpointAgent - Get endpoint agent
+func (c *Client) GetEndpointAgent(id string) (*EndpointAgent, error) {
+	resp, err := c.get(fmt.Sprintf("/endpoint-agents/%s", id))
+	if err != nil {
+		return nil, err
+	}
+	var target map[string][]EndpointAgent
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	if len(target["endpointAgents"]) < 1 {
+		return nil, fmt.Errorf("Could not get endpoint agent %v", id)
+	}
+	agent := target["endpointAgents"][0]
+	return &agent, nil
+}
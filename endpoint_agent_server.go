@@ -0,0 +1,116 @@
+package thousandeyes
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// EndpointAgentServer - an endpoint agent to server test
+type EndpointAgentServer struct {
+	// Common endpoint test fields
+	AgentSelectorConfig *EndpointAgentSelector `json:"agentSelectorConfig,omitempty"`
+	AlertsEnabled       *bool                  `json:"alertsEnabled,omitempty" te:"int-bool"`
+	AlertRules          *[]AlertRule           `json:"alertRules,omitempty"`
+	APILinks            *[]APILink             `json:"apiLinks,omitempty"`
+	Enabled             *bool                  `json:"enabled,omitempty" te:"int-bool"`
+	Interval            *int                   `json:"interval,omitempty"`
+	TestID              *int64                 `json:"testId,omitempty"`
+	TestName            *string                `json:"testName,omitempty"`
+	Type                *string                `json:"type,omitempty"`
+
+	// Fields unique to this test
+	Port         *int       `json:"port,omitempty"`
+	ProbeMode    *ProbeMode `json:"probeMode,omitempty"`
+	Protocol     *Protocol  `json:"protocol,omitempty"`
+	Server       *string    `json:"server,omitempty"`
+	TCPProbeMode *string    `json:"tcpProbeMode,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// GetEndpointAgentServer - get an endpoint agent to server test
+func (c *Client) GetEndpointAgentServer(id int) (*EndpointAgentServer, error) {
+	resp, err := c.get(fmt.Sprintf("/endpoint-tests/%d", id))
+	if err != nil {
+		return &EndpointAgentServer{}, err
+	}
+	var target map[string][]EndpointAgentServer
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	if len(target["endpointTests"]) < 1 {
+		return nil, fmt.Errorf("'endpointTests' not found in JSON response")
+	}
+	return &target["endpointTests"][0], nil
+}
+
+// CreateEndpointAgentServer - create an endpoint agent to server test
+func (c Client) CreateEndpointAgentServer(t EndpointAgentServer) (*EndpointAgentServer, error) {
+	resp, err := c.post("/endpoint-tests/agent-to-server/new", t, nil)
+	if err != nil {
+		return &t, err
+	}
+	if resp.StatusCode != 201 {
+		return &t, fmt.Errorf("failed to create endpoint test, response code %d", resp.StatusCode)
+	}
+	var target map[string][]EndpointAgentServer
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	if len(target["endpointTests"]) < 1 {
+		return nil, fmt.Errorf("'endpointTests' not found in JSON response")
+	}
+	return &target["endpointTests"][0], nil
+}
+
+// DeleteEndpointAgentServer - delete an endpoint agent to server test
+func (c *Client) DeleteEndpointAgentServer(id int) error {
+	resp, err := c.post(fmt.Sprintf("/endpoint-tests/agent-to-server/%d/delete", id), nil, nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != 204 {
+		return fmt.Errorf("failed to delete endpoint test, response code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// UpdateEndpointAgentServer - update an endpoint agent to server test
+func (c *Client) UpdateEndpointAgentServer(id int, t EndpointAgentServer) (*EndpointAgentServer, error) {
+	resp, err := c.post(fmt.Sprintf("/endpoint-tests/agent-to-server/%d/update", id), t, nil)
+	if err != nil {
+		return &t, err
+	}
+	if resp.StatusCode != 200 {
+		return &t, fmt.Errorf("failed to update endpoint test, response code %d", resp.StatusCode)
+	}
+	var target map[string][]EndpointAgentServer
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	if len(target["endpointTests"]) < 1 {
+		return nil, fmt.Errorf("'endpointTests' not found in JSON response")
+	}
+	return &target["endpointTests"][0], nil
+}
+
+// RunEndpointInstantAgentServer - run an endpoint agent to server test once as an endpoint instant test.
+// Instant tests are paced by the separate instant test rate limit.
+func (c *Client) RunEndpointInstantAgentServer(t EndpointAgentServer) (*EndpointAgentServer, error) {
+	resp, err := c.post("/endpoint-instant/agent-to-server", t, nil)
+	if err != nil {
+		return &t, err
+	}
+	if resp.StatusCode != 200 && resp.StatusCode != 201 {
+		return &t, fmt.Errorf("failed to run endpoint instant test, response code %d", resp.StatusCode)
+	}
+	var target map[string][]EndpointAgentServer
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	if len(target["endpointTests"]) < 1 {
+		return nil, fmt.Errorf("'endpointTests' not found in JSON response")
+	}
+	return &target["endpointTests"][0], nil
+}
@@ -0,0 +1,109 @@
+package thousandeyes
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetEndpointAgentServer(t *testing.T) {
+	out := `{"endpointTests":[{"testId":1,"testName":"test1","type":"agent-to-server","server":"example.com"}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/endpoint-tests/1.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		_, _ = w.Write([]byte(out))
+	})
+	expected := EndpointAgentServer{
+		TestID:   Int64(1),
+		TestName: String("test1"),
+		Type:     String("agent-to-server"),
+		Server:   String("example.com"),
+	}
+	res, err := client.GetEndpointAgentServer(1)
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &expected, res)
+}
+
+func TestClient_CreateEndpointAgentServer(t *testing.T) {
+	out := `{"endpointTests":[{"testId":1,"testName":"test1","type":"agent-to-server","server":"example.com"}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/endpoint-tests/agent-to-server/new.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(out))
+	})
+	expected := EndpointAgentServer{TestID: Int64(1), TestName: String("test1"), Type: String("agent-to-server"), Server: String("example.com")}
+	create := EndpointAgentServer{TestName: String("test1"), Server: String("example.com")}
+	res, err := client.CreateEndpointAgentServer(create)
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &expected, res)
+}
+
+func TestClient_UpdateEndpointAgentServer(t *testing.T) {
+	out := `{"endpointTests":[{"testId":1,"testName":"test1","type":"agent-to-server","interval":600}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/endpoint-tests/agent-to-server/1/update.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		_, _ = w.Write([]byte(out))
+	})
+	expected := EndpointAgentServer{TestID: Int64(1), TestName: String("test1"), Type: String("agent-to-server"), Interval: Int(600)}
+	res, err := client.UpdateEndpointAgentServer(1, EndpointAgentServer{Interval: Int(600)})
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &expected, res)
+}
+
+func TestClient_DeleteEndpointAgentServer(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/endpoint-tests/agent-to-server/1/delete.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	err := client.DeleteEndpointAgentServer(1)
+	teardown()
+	assert.Nil(t, err)
+}
+
+func TestClient_RunEndpointInstantAgentServer(t *testing.T) {
+	out := `{"endpointTests":[{"testId":2,"testName":"instant","type":"agent-to-server"}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/endpoint-instant/agent-to-server.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		_, _ = w.Write([]byte(out))
+	})
+	expected := EndpointAgentServer{TestID: Int64(2), TestName: String("instant"), Type: String("agent-to-server")}
+	res, err := client.RunEndpointInstantAgentServer(EndpointAgentServer{TestName: String("instant")})
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &expected, res)
+}
+
+func TestClient_CreateEndpointAgentServerStatusCode(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/endpoint-tests/agent-to-server/new.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"endpointTests":[]}`))
+	})
+	_, err := client.CreateEndpointAgentServer(EndpointAgentServer{})
+	teardown()
+	assert.EqualError(t, err, "failed to create endpoint test, response code 200")
+}
+
+func TestClient_GetEndpointAgentServerEmpty(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/endpoint-tests/1.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"endpointTests":[]}`))
+	})
+	_, err := client.GetEndpointAgentServer(1)
+	teardown()
+	assert.EqualError(t, err, "'endpointTests' not found in JSON response")
+}
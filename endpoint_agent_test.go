@@ -0,0 +1,85 @@
+package thousandeyes
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetEndpointAgents(t *testing.T) {
+	out := `{"endpointAgents":[{"agentId":"a1b2","agentName":"laptop-1","computerName":"laptop-1","osVersion":"Microsoft Windows 10 Pro","platform":"windows","lastSeen":"2022-06-01 12:00:00","status":"enabled","vpnProfiles":[{"interfaceName":"utun2","vpnType":"cisco-anyconnect","vpnGatewayAddress":"10.0.0.1"}]},{"agentId":"c3d4","agentName":"laptop-2","platform":"mac"}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/endpoint-agents.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		_, _ = w.Write([]byte(out))
+	})
+
+	expected := EndpointAgents{
+		{
+			AgentID:      String("a1b2"),
+			AgentName:    String("laptop-1"),
+			ComputerName: String("laptop-1"),
+			OSVersion:    String("Microsoft Windows 10 Pro"),
+			Platform:     String("windows"),
+			LastSeen:     String("2022-06-01 12:00:00"),
+			Status:       String("enabled"),
+			VPNProfiles: &[]EndpointVPNProfile{
+				{
+					InterfaceName:     String("utun2"),
+					VPNType:           String("cisco-anyconnect"),
+					VPNGatewayAddress: String("10.0.0.1"),
+				},
+			},
+		},
+		{
+			AgentID:   String("c3d4"),
+			AgentName: String("laptop-2"),
+			Platform:  String("mac"),
+		},
+	}
+	res, err := client.GetEndpointAgents()
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &expected, res)
+	assert.True(t, (*res)[0].OnVPN())
+	assert.False(t, (*res)[1].OnVPN())
+}
+
+func TestClient_GetEndpointAgent(t *testing.T) {
+	out := `{"endpointAgents":[{"agentId":"a1b2","agentName":"laptop-1"}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/endpoint-agents/a1b2.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		_, _ = w.Write([]byte(out))
+	})
+	expected := EndpointAgent{AgentID: String("a1b2"), AgentName: String("laptop-1")}
+	res, err := client.GetEndpointAgent("a1b2")
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &expected, res)
+}
+
+func TestClient_GetEndpointAgentNotFound(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/endpoint-agents/a1b2.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"endpointAgents":[]}`))
+	})
+	_, err := client.GetEndpointAgent("a1b2")
+	teardown()
+	assert.EqualError(t, err, "Could not get endpoint agent a1b2")
+}
+
+func TestClient_GetEndpointAgentsError(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/endpoint-agents.json", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	_, err := client.GetEndpointAgents()
+	teardown()
+	assert.Error(t, err)
+}
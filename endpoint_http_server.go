@@ -0,0 +1,121 @@
+package thousandeyes
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// EndpointHTTPServer - an endpoint http server test
+type EndpointHTTPServer struct {
+	// Common endpoint test fields
+	AgentSelectorConfig *EndpointAgentSelector `json:"agentSelectorConfig,omitempty"`
+	AlertsEnabled       *bool                  `json:"alertsEnabled,omitempty" te:"int-bool"`
+	AlertRules          *[]AlertRule           `json:"alertRules,omitempty"`
+	APILinks            *[]APILink             `json:"apiLinks,omitempty"`
+	Enabled             *bool                  `json:"enabled,omitempty" te:"int-bool"`
+	Interval            *int                   `json:"interval,omitempty"`
+	TestID              *int64                 `json:"testId,omitempty"`
+	TestName            *string                `json:"testName,omitempty"`
+	Type                *string                `json:"type,omitempty"`
+
+	// Fields unique to this test
+	AuthType          *AuthType  `json:"authType,omitempty"`
+	HTTPTimeLimit     *int       `json:"httpTimeLimit,omitempty"`
+	Password          *string    `json:"password,omitempty"`
+	ProbeMode         *ProbeMode `json:"probeMode,omitempty"`
+	Protocol          *Protocol  `json:"protocol,omitempty"`
+	SSLVersionID      *int       `json:"sslVersionId,omitempty"`
+	TCPProbeMode      *string    `json:"tcpProbeMode,omitempty"`
+	URL               *string    `json:"url,omitempty"`
+	Username          *string    `json:"username,omitempty"`
+	VerifyCertificate *bool      `json:"verifyCertificate,omitempty" te:"int-bool"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// GetEndpointHTTPServer - get an endpoint http server test
+func (c *Client) GetEndpointHTTPServer(id int) (*EndpointHTTPServer, error) {
+	resp, err := c.get(fmt.Sprintf("/endpoint-tests/%d", id))
+	if err != nil {
+		return &EndpointHTTPServer{}, err
+	}
+	var target map[string][]EndpointHTTPServer
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	if len(target["endpointTests"]) < 1 {
+		return nil, fmt.Errorf("'endpointTests' not found in JSON response")
+	}
+	return &target["endpointTests"][0], nil
+}
+
+// CreateEndpointHTTPServer - create an endpoint http server test
+func (c Client) CreateEndpointHTTPServer(t EndpointHTTPServer) (*EndpointHTTPServer, error) {
+	resp, err := c.post("/endpoint-tests/http-server/new", t, nil)
+	if err != nil {
+		return &t, err
+	}
+	if resp.StatusCode != 201 {
+		return &t, fmt.Errorf("failed to create endpoint test, response code %d", resp.StatusCode)
+	}
+	var target map[string][]EndpointHTTPServer
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	if len(target["endpointTests"]) < 1 {
+		return nil, fmt.Errorf("'endpointTests' not found in JSON response")
+	}
+	return &target["endpointTests"][0], nil
+}
+
+// DeleteEndpointHTTPServer - delete an endpoint http server test
+func (c *Client) DeleteEndpointHTTPServer(id int) error {
+	resp, err := c.post(fmt.Sprintf("/endpoint-tests/http-server/%d/delete", id), nil, nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != 204 {
+		return fmt.Errorf("failed to delete endpoint test, response code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// UpdateEndpointHTTPServer - update an endpoint http server test
+func (c *Client) UpdateEndpointHTTPServer(id int, t EndpointHTTPServer) (*EndpointHTTPServer, error) {
+	resp, err := c.post(fmt.Sprintf("/endpoint-tests/http-server/%d/update", id), t, nil)
+	if err != nil {
+		return &t, err
+	}
+	if resp.StatusCode != 200 {
+		return &t, fmt.Errorf("failed to update endpoint test, response code %d", resp.StatusCode)
+	}
+	var target map[string][]EndpointHTTPServer
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	if len(target["endpointTests"]) < 1 {
+		return nil, fmt.Errorf("'endpointTests' not found in JSON response")
+	}
+	return &target["endpointTests"][0], nil
+}
+
+// RunEndpointInstantHTTPServer - run an endpoint http server test once as an endpoint instant test.
+// Instant tests are paced by the separate instant test rate limit.
+func (c *Client) RunEndpointInstantHTTPServer(t EndpointHTTPServer) (*EndpointHTTPServer, error) {
+	resp, err := c.post("/endpoint-instant/http-server", t, nil)
+	if err != nil {
+		return &t, err
+	}
+	if resp.StatusCode != 200 && resp.StatusCode != 201 {
+		return &t, fmt.Errorf("failed to run endpoint instant test, response code %d", resp.StatusCode)
+	}
+	var target map[string][]EndpointHTTPServer
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	if len(target["endpointTests"]) < 1 {
+		return nil, fmt.Errorf("'endpointTests' not found in JSON response")
+	}
+	return &target["endpointTests"][0], nil
+}
@@ -0,0 +1,110 @@
+package thousandeyes
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetEndpointHTTPServer(t *testing.T) {
+	out := `{"endpointTests":[{"testId":1,"testName":"test1","type":"http-server","url":"https://example.com","verifyCertificate":1}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/endpoint-tests/1.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		_, _ = w.Write([]byte(out))
+	})
+	expected := EndpointHTTPServer{
+		TestID:            Int64(1),
+		TestName:          String("test1"),
+		Type:              String("http-server"),
+		URL:               String("https://example.com"),
+		VerifyCertificate: Bool(true),
+	}
+	res, err := client.GetEndpointHTTPServer(1)
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &expected, res)
+}
+
+func TestClient_CreateEndpointHTTPServer(t *testing.T) {
+	out := `{"endpointTests":[{"testId":1,"testName":"test1","type":"http-server","url":"https://example.com"}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/endpoint-tests/http-server/new.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(out))
+	})
+	expected := EndpointHTTPServer{TestID: Int64(1), TestName: String("test1"), Type: String("http-server"), URL: String("https://example.com")}
+	create := EndpointHTTPServer{TestName: String("test1"), URL: String("https://example.com")}
+	res, err := client.CreateEndpointHTTPServer(create)
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &expected, res)
+}
+
+func TestClient_UpdateEndpointHTTPServer(t *testing.T) {
+	out := `{"endpointTests":[{"testId":1,"testName":"test1","type":"http-server","interval":600}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/endpoint-tests/http-server/1/update.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		_, _ = w.Write([]byte(out))
+	})
+	expected := EndpointHTTPServer{TestID: Int64(1), TestName: String("test1"), Type: String("http-server"), Interval: Int(600)}
+	res, err := client.UpdateEndpointHTTPServer(1, EndpointHTTPServer{Interval: Int(600)})
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &expected, res)
+}
+
+func TestClient_DeleteEndpointHTTPServer(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/endpoint-tests/http-server/1/delete.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	err := client.DeleteEndpointHTTPServer(1)
+	teardown()
+	assert.Nil(t, err)
+}
+
+func TestClient_RunEndpointInstantHTTPServer(t *testing.T) {
+	out := `{"endpointTests":[{"testId":2,"testName":"instant","type":"http-server"}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/endpoint-instant/http-server.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		_, _ = w.Write([]byte(out))
+	})
+	expected := EndpointHTTPServer{TestID: Int64(2), TestName: String("instant"), Type: String("http-server")}
+	res, err := client.RunEndpointInstantHTTPServer(EndpointHTTPServer{TestName: String("instant")})
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &expected, res)
+}
+
+func TestClient_CreateEndpointHTTPServerStatusCode(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/endpoint-tests/http-server/new.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"endpointTests":[]}`))
+	})
+	_, err := client.CreateEndpointHTTPServer(EndpointHTTPServer{})
+	teardown()
+	assert.EqualError(t, err, "failed to create endpoint test, response code 200")
+}
+
+func TestClient_RunEndpointInstantHTTPServerEmpty(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/endpoint-instant/http-server.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"endpointTests":[]}`))
+	})
+	_, err := client.RunEndpointInstantHTTPServer(EndpointHTTPServer{})
+	teardown()
+	assert.EqualError(t, err, "'endpointTests' not found in JSON response")
+}
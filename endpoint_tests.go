@@ -0,0 +1,63 @@
+package thousandeyes
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// EndpointAgentSelector - selects the endpoint agents a scheduled endpoint test runs on
+type EndpointAgentSelector struct {
+	AgentSelectorType   *string   `json:"agentSelectorType,omitempty"`
+	EndpointAgents      *[]string `json:"endpointAgents,omitempty"`
+	EndpointAgentLabels *[]int    `json:"endpointAgentLabels,omitempty"`
+	MaxMachines         *int      `json:"maxMachines,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// GenericEndpointTest - GenericEndpointTest struct to represent all scheduled endpoint test types
+type GenericEndpointTest struct {
+	AgentSelectorConfig *EndpointAgentSelector `json:"agentSelectorConfig,omitempty"`
+	AlertsEnabled       *bool                  `json:"alertsEnabled,omitempty" te:"int-bool"`
+	APILinks            *[]APILink             `json:"apiLinks,omitempty"`
+	Enabled             *bool                  `json:"enabled,omitempty" te:"int-bool"`
+	Interval            *int                   `json:"interval,omitempty"`
+	TestID              *int64                 `json:"testId,omitempty"`
+	TestName            *string                `json:"testName,omitempty"`
+	Type                *string                `json:"type,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// GetEndpointTests - get all scheduled endpoint tests
+func (c *Client) GetEndpointTests() (*[]GenericEndpointTest, error) {
+	resp, err := c.get("/endpoint-tests")
+	if err != nil {
+		return nil, err
+	}
+	var target map[string][]GenericEndpointTest
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	tests := target["endpointTests"]
+	return &tests, nil
+}
+
+// GetEndpointTest - get scheduled endpoint test
+func (c *Client) GetEndpointTest(id int) (*GenericEndpointTest, error) {
+	resp, err := c.get(fmt.Sprintf("/endpoint-tests/%d", id))
+	if err != nil {
+		return nil, err
+	}
+	var target map[string][]GenericEndpointTest
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	if len(target["endpointTests"]) < 1 {
+		return nil, fmt.Errorf("Could not get endpoint test %v", id)
+	}
+	test := target["endpointTests"][0]
+	return &test, nil
+}
@@ -0,0 +1,63 @@
+package thousandeyes
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetEndpointTests(t *testing.T) {
+	out := `{"endpointTests":[{"testId":1,"testName":"test1","type":"http-server","interval":300,"enabled":1,"alertsEnabled":0,"agentSelectorConfig":{"agentSelectorType":"SPECIFIC_AGENTS","endpointAgents":["a1b2"],"maxMachines":25}}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/endpoint-tests.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		_, _ = w.Write([]byte(out))
+	})
+	expected := []GenericEndpointTest{
+		{
+			TestID:        Int64(1),
+			TestName:      String("test1"),
+			Type:          String("http-server"),
+			Interval:      Int(300),
+			Enabled:       Bool(true),
+			AlertsEnabled: Bool(false),
+			AgentSelectorConfig: &EndpointAgentSelector{
+				AgentSelectorType: String("SPECIFIC_AGENTS"),
+				EndpointAgents:    &[]string{"a1b2"},
+				MaxMachines:       Int(25),
+			},
+		},
+	}
+	res, err := client.GetEndpointTests()
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &expected, res)
+}
+
+func TestClient_GetEndpointTest(t *testing.T) {
+	out := `{"endpointTests":[{"testId":1,"testName":"test1","type":"agent-to-server"}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/endpoint-tests/1.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		_, _ = w.Write([]byte(out))
+	})
+	expected := GenericEndpointTest{TestID: Int64(1), TestName: String("test1"), Type: String("agent-to-server")}
+	res, err := client.GetEndpointTest(1)
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &expected, res)
+}
+
+func TestClient_GetEndpointTestsJsonError(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/endpoint-tests.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"endpointTests": [test]}`))
+	})
+	_, err := client.GetEndpointTests()
+	teardown()
+	assert.EqualError(t, err, "Could not decode JSON response: invalid character 'e' in literal true (expecting 'r')")
+}
@@ -0,0 +1,329 @@
+package thousandeyes
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Protocol - the protocol of a test's network measurements, or of SIP
+// credentials.  Not every protocol is valid for every test; Validate on the
+// test checks the protocols it supports.
+type Protocol string
+
+// Protocols
+const (
+	ProtocolTCP  Protocol = "TCP"
+	ProtocolUDP  Protocol = "UDP"
+	ProtocolICMP Protocol = "ICMP"
+	ProtocolTLS  Protocol = "TLS"
+)
+
+// ProbeMode - the TCP probe mode of network measurements
+type ProbeMode string
+
+// Probe modes
+const (
+	ProbeModeAuto ProbeMode = "AUTO"
+	ProbeModeSACK ProbeMode = "SACK"
+	ProbeModeSYN  ProbeMode = "SYN"
+)
+
+// PathTraceMode - how path traces are run
+type PathTraceMode string
+
+// Path trace modes
+const (
+	PathTraceModeClassic   PathTraceMode = "classic"
+	PathTraceModeInSession PathTraceMode = "inSession"
+)
+
+// Direction - the direction of agent to agent test traffic
+type Direction string
+
+// Directions
+const (
+	DirectionToTarget      Direction = "TO_TARGET"
+	DirectionFromTarget    Direction = "FROM_TARGET"
+	DirectionBidirectional Direction = "BIDIRECTIONAL"
+)
+
+// DNSTransportProtocol - the transport of DNS queries
+type DNSTransportProtocol string
+
+// DNS transport protocols
+const (
+	DNSTransportProtocolUDP DNSTransportProtocol = "UDP"
+	DNSTransportProtocolTCP DNSTransportProtocol = "TCP"
+)
+
+// AuthType - the HTTP authentication scheme of a web test
+type AuthType string
+
+// Authentication types
+const (
+	AuthTypeNone     AuthType = "NONE"
+	AuthTypeBasic    AuthType = "BASIC"
+	AuthTypeNTLM     AuthType = "NTLM"
+	AuthTypeKerberos AuthType = "KERBEROS"
+)
+
+// Codec - the codec simulated by voice tests.  Each codec has a CodecID.
+type Codec string
+
+// Codecs
+const (
+	CodecG711 Codec = "G.711 @ 64 Kbps"
+	CodecG722 Codec = "G.722 @ 64 Kbps"
+	CodecG729 Codec = "G.729a @ 8 Kbps"
+)
+
+// DSCP - the DSCP marking of test traffic.  Each marking has a DSCPID,
+// which is its DSCP value.
+type DSCP string
+
+// DSCP markings
+const (
+	DSCPBestEffort DSCP = "Best Effort (DSCP 0)"
+	DSCPCS1        DSCP = "CS1 (DSCP 8)"
+	DSCPAF11       DSCP = "AF11 (DSCP 10)"
+	DSCPAF12       DSCP = "AF12 (DSCP 12)"
+	DSCPAF13       DSCP = "AF13 (DSCP 14)"
+	DSCPCS2        DSCP = "CS2 (DSCP 16)"
+	DSCPAF21       DSCP = "AF21 (DSCP 18)"
+	DSCPAF22       DSCP = "AF22 (DSCP 20)"
+	DSCPAF23       DSCP = "AF23 (DSCP 22)"
+	DSCPCS3        DSCP = "CS3 (DSCP 24)"
+	DSCPAF31       DSCP = "AF31 (DSCP 26)"
+	DSCPAF32       DSCP = "AF32 (DSCP 28)"
+	DSCPAF33       DSCP = "AF33 (DSCP 30)"
+	DSCPCS4        DSCP = "CS4 (DSCP 32)"
+	DSCPAF41       DSCP = "AF41 (DSCP 34)"
+	DSCPAF42       DSCP = "AF42 (DSCP 36)"
+	DSCPAF43       DSCP = "AF43 (DSCP 38)"
+	DSCPCS5        DSCP = "CS5 (DSCP 40)"
+	DSCPVoiceAdmit DSCP = "VOICE-ADMIT (DSCP 44)"
+	DSCPEF         DSCP = "EF (DSCP 46)"
+	DSCPCS6        DSCP = "CS6 (DSCP 48)"
+	DSCPCS7        DSCP = "CS7 (DSCP 56)"
+)
+
+var codecIDs = map[Codec]int{
+	CodecG711: 0,
+	CodecG722: 1,
+	CodecG729: 2,
+}
+
+var dscpIDs = map[DSCP]int{
+	DSCPBestEffort: 0,
+	DSCPCS1:        8,
+	DSCPAF11:       10,
+	DSCPAF12:       12,
+	DSCPAF13:       14,
+	DSCPCS2:        16,
+	DSCPAF21:       18,
+	DSCPAF22:       20,
+	DSCPAF23:       22,
+	DSCPCS3:        24,
+	DSCPAF31:       26,
+	DSCPAF32:       28,
+	DSCPAF33:       30,
+	DSCPCS4:        32,
+	DSCPAF41:       34,
+	DSCPAF42:       36,
+	DSCPAF43:       38,
+	DSCPCS5:        40,
+	DSCPVoiceAdmit: 44,
+	DSCPEF:         46,
+	DSCPCS6:        48,
+	DSCPCS7:        56,
+}
+
+// Validate returns an error if p is not a known protocol.
+func (p Protocol) Validate() error {
+	switch p {
+	case ProtocolTCP, ProtocolUDP, ProtocolICMP, ProtocolTLS:
+		return nil
+	}
+	return fmt.Errorf("invalid protocol %q", string(p))
+}
+
+// Validate returns an error if m is not a known probe mode.
+func (m ProbeMode) Validate() error {
+	switch m {
+	case ProbeModeAuto, ProbeModeSACK, ProbeModeSYN:
+		return nil
+	}
+	return fmt.Errorf("invalid probe mode %q", string(m))
+}
+
+// Validate returns an error if m is not a known path trace mode.
+func (m PathTraceMode) Validate() error {
+	switch m {
+	case PathTraceModeClassic, PathTraceModeInSession:
+		return nil
+	}
+	return fmt.Errorf("invalid path trace mode %q", string(m))
+}
+
+// Validate returns an error if d is not a known direction.
+func (d Direction) Validate() error {
+	switch d {
+	case DirectionToTarget, DirectionFromTarget, DirectionBidirectional:
+		return nil
+	}
+	return fmt.Errorf("invalid direction %q", string(d))
+}
+
+// Validate returns an error if p is not a known DNS transport protocol.
+func (p DNSTransportProtocol) Validate() error {
+	switch p {
+	case DNSTransportProtocolUDP, DNSTransportProtocolTCP:
+		return nil
+	}
+	return fmt.Errorf("invalid DNS transport protocol %q", string(p))
+}
+
+// Validate returns an error if a is not a known authentication type.
+func (a AuthType) Validate() error {
+	switch a {
+	case AuthTypeNone, AuthTypeBasic, AuthTypeNTLM, AuthTypeKerberos:
+		return nil
+	}
+	return fmt.Errorf("invalid auth type %q", string(a))
+}
+
+// Validate returns an error if c is not a known codec.
+func (c Codec) Validate() error {
+	if _, ok := codecIDs[c]; !ok {
+		return fmt.Errorf("invalid codec %q", string(c))
+	}
+	return nil
+}
+
+// Validate returns an error if d is not a known DSCP marking.
+func (d DSCP) Validate() error {
+	if _, ok := dscpIDs[d]; !ok {
+		return fmt.Errorf("invalid DSCP %q", string(d))
+	}
+	return nil
+}
+
+// Ptr returns a pointer to a copy of p, for setting optional fields.
+func (p Protocol) Ptr() *Protocol {
+	return &p
+}
+
+// Ptr returns a pointer to a copy of m, for setting optional fields.
+func (m ProbeMode) Ptr() *ProbeMode {
+	return &m
+}
+
+// Ptr returns a pointer to a copy of m, for setting optional fields.
+func (m PathTraceMode) Ptr() *PathTraceMode {
+	return &m
+}
+
+// Ptr returns a pointer to a copy of d, for setting optional fields.
+func (d Direction) Ptr() *Direction {
+	return &d
+}
+
+// Ptr returns a pointer to a copy of p, for setting optional fields.
+func (p DNSTransportProtocol) Ptr() *DNSTransportProtocol {
+	return &p
+}
+
+// Ptr returns a pointer to a copy of a, for setting optional fields.
+func (a AuthType) Ptr() *AuthType {
+	return &a
+}
+
+// Ptr returns a pointer to a copy of c, for setting optional fields.
+func (c Codec) Ptr() *Codec {
+	return &c
+}
+
+// Ptr returns a pointer to a copy of d, for setting optional fields.
+func (d DSCP) Ptr() *DSCP {
+	return &d
+}
+
+// ID returns the CodecID of the codec.
+func (c Codec) ID() (int, bool) {
+	id, ok := codecIDs[c]
+	return id, ok
+}
+
+// CodecFromID returns the codec with the given CodecID.
+func CodecFromID(id int) (Codec, bool) {
+	for c, codecID := range codecIDs {
+		if codecID == id {
+			return c, true
+		}
+	}
+	return "", false
+}
+
+// ID returns the DSCPID of the marking.
+func (d DSCP) ID() (int, bool) {
+	id, ok := dscpIDs[d]
+	return id, ok
+}
+
+// DSCPFromID returns the marking with the given DSCPID.
+func DSCPFromID(id int) (DSCP, bool) {
+	for d, dscpID := range dscpIDs {
+		if dscpID == id {
+			return d, true
+		}
+	}
+	return "", false
+}
+
+// fillCodec sets whichever of a codec and its ID is unset from the other.
+func fillCodec(codec **Codec, id **int) {
+	if *codec != nil && *id == nil {
+		if codecID, ok := (*codec).ID(); ok {
+			*id = Int(codecID)
+		}
+	} else if *codec == nil && *id != nil {
+		if c, ok := CodecFromID(**id); ok {
+			*codec = &c
+		}
+	}
+}
+
+// fillDSCP sets whichever of a DSCP marking and its ID is unset from the other.
+func fillDSCP(dscp **DSCP, id **int) {
+	if *dscp != nil && *id == nil {
+		if dscpID, ok := (*dscp).ID(); ok {
+			*id = Int(dscpID)
+		}
+	} else if *dscp == nil && *id != nil {
+		if d, ok := DSCPFromID(**id); ok {
+			*dscp = &d
+		}
+	}
+}
+
+// enum is implemented by the string enum types of this package.
+type enum interface {
+	Validate() error
+}
+
+// validateEnums returns an error for the first enum value in v, which may
+// be a struct, pointer, slice or map, that is not a known value.  The
+// error names the path of the field holding it.
+func validateEnums(v interface{}) error {
+	return walkValues(reflect.ValueOf(v), "", func(v reflect.Value, path string) error {
+		if v.Kind() != reflect.String {
+			return nil
+		}
+		if e, ok := v.Interface().(enum); ok {
+			if err := e.Validate(); err != nil {
+				return fmt.Errorf("%s: %v", path, err)
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,98 @@
+package thousandeyes
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnums_Validate(t *testing.T) {
+	assert.Nil(t, ProtocolICMP.Validate())
+	assert.Nil(t, PathTraceModeInSession.Validate())
+	assert.Nil(t, DSCPEF.Validate())
+	assert.EqualError(t, Protocol("tcp").Validate(), `invalid protocol "tcp"`)
+	assert.EqualError(t, DNSTransportProtocol("TLS").Validate(), `invalid DNS transport protocol "TLS"`)
+	assert.EqualError(t, Codec("Opus").Validate(), `invalid codec "Opus"`)
+}
+
+func TestCodecAndDSCPIDs(t *testing.T) {
+	id, ok := CodecG722.ID()
+	assert.True(t, ok)
+	assert.Equal(t, 1, id)
+	codec, ok := CodecFromID(2)
+	assert.True(t, ok)
+	assert.Equal(t, CodecG729, codec)
+	_, ok = CodecFromID(9)
+	assert.False(t, ok)
+
+	id, ok = DSCPAF41.ID()
+	assert.True(t, ok)
+	assert.Equal(t, 34, id)
+	dscp, ok := DSCPFromID(46)
+	assert.True(t, ok)
+	assert.Equal(t, DSCPEF, dscp)
+	_, ok = DSCP("EF").ID()
+	assert.False(t, ok)
+}
+
+func TestRTPStream_MarshalJSONFillsIDs(t *testing.T) {
+	data, err := json.Marshal(RTPStream{Codec: CodecG722.Ptr(), DSCPID: Int(46)})
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"codec":"G.722 @ 64 Kbps","codecId":1,"dscp":"EF (DSCP 46)","dscpId":46}`, string(data))
+
+	// Unknown values are sent as they are.
+	data, err = json.Marshal(RTPStream{CodecID: Int(9)})
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"codecId":9}`, string(data))
+}
+
+func TestVoiceTests_UnmarshalJSONFillsIDs(t *testing.T) {
+	var stream RTPStream
+	assert.Nil(t, json.Unmarshal([]byte(`{"codecId":1,"dscp":"EF (DSCP 46)"}`), &stream))
+	assert.Equal(t, CodecG722.Ptr(), stream.Codec)
+	assert.Equal(t, Int(46), stream.DSCPID)
+
+	var call VoiceCall
+	assert.Nil(t, json.Unmarshal([]byte(`{"codec":"G.722 @ 64 Kbps","dscpId":46}`), &call))
+	assert.Equal(t, Int(1), call.CodecID)
+	assert.Equal(t, DSCP("EF (DSCP 46)").Ptr(), call.DSCP)
+
+	var agentAgent AgentAgent
+	assert.Nil(t, json.Unmarshal([]byte(`{"dscpId":46}`), &agentAgent))
+	assert.Equal(t, DSCP("EF (DSCP 46)").Ptr(), agentAgent.DSCP)
+}
+
+func TestClient_StrictEnums(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/tests/1.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"test":[{"testId":1,"type":"http-server","protocol":"SCTP"}]}`))
+	})
+	var posted bool
+	mux.HandleFunc("/tests/http-server/new.json", func(w http.ResponseWriter, r *http.Request) {
+		posted = true
+		_, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"test":[{"testId":2}]}`))
+	})
+
+	client := &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	test, err := client.GetHTTPServer(1)
+	assert.Nil(t, err)
+	assert.Equal(t, Protocol("SCTP"), *test.Protocol)
+
+	client.StrictEnums = true
+	_, err = client.GetHTTPServer(1)
+	assert.EqualError(t, err, `Could not decode JSON response: [test][0].Protocol: invalid protocol "SCTP"`)
+
+	_, err = client.CreateHTTPServer(HTTPServer{TestName: String("web"), AuthType: AuthType("DIGEST").Ptr()})
+	assert.EqualError(t, err, `AuthType: invalid auth type "DIGEST"`)
+	assert.False(t, posted)
+
+	_, err = client.CreateHTTPServer(HTTPServer{TestName: String("web"), AuthType: AuthTypeBasic.Ptr()})
+	assert.Nil(t, err)
+	assert.True(t, posted)
+}
@@ -0,0 +1,148 @@
+package thousandeyes
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// UnknownFieldsError - fields of an API response which the SDK does not
+// model, returned by clients with StrictFields set
+type UnknownFieldsError struct {
+	// Fields are the paths of the unknown fields, such as
+	// "[test][0].newField"
+	Fields []string
+}
+
+// Error implements the error interface.
+func (e *UnknownFieldsError) Error() string {
+	return "unknown fields: " + strings.Join(e.Fields, ", ")
+}
+
+// UnknownFields returns the paths of the fields kept in the Extra maps of
+// v, which may be a struct, pointer, slice or map.  An empty result means
+// the SDK modelled every field v was decoded from.
+func UnknownFields(v interface{}) []string {
+	var fields []string
+	_ = walkValues(reflect.ValueOf(v), "", func(v reflect.Value, path string) error {
+		if v.Kind() != reflect.Struct {
+			return nil
+		}
+		extra := v.FieldByName("Extra")
+		if !extra.IsValid() || extra.Type() != reflect.TypeOf(map[string]json.RawMessage(nil)) {
+			return nil
+		}
+		// A GenericTest models the fields common to all tests; the others
+		// are unknown only if the struct of the test's type lacks them.
+		var known map[string]bool
+		if t, ok := v.Interface().(GenericTest); ok {
+			known = testFields(t.GetType())
+		}
+		var keys []string
+		for _, k := range extra.MapKeys() {
+			if !known[strings.ToLower(k.String())] {
+				keys = append(keys, k.String())
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if path == "" {
+				fields = append(fields, k)
+			} else {
+				fields = append(fields, path+"."+k)
+			}
+		}
+		return nil
+	})
+	return fields
+}
+
+// testFields returns the lower case JSON keys of the test struct of the
+// given API type, or of every test struct if the type is unknown.  Keys
+// which the struct drops on decode, listed in the te:"ignore=..." tag of
+// its Extra field, are included.
+func testFields(testType string) map[string]bool {
+	known := false
+	for _, name := range testTypes {
+		known = known || name == testType
+	}
+	fields := map[string]bool{}
+	for t, name := range testTypes {
+		if known && name != testType {
+			continue
+		}
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.Name == "Extra" {
+				if ignore := strings.TrimPrefix(f.Tag.Get("te"), "ignore="); ignore != f.Tag.Get("te") {
+					for _, k := range strings.Split(ignore, ",") {
+						fields[strings.ToLower(k)] = true
+					}
+				}
+				continue
+			}
+			if name := strings.Split(f.Tag.Get("json"), ",")[0]; name != "" && name != "-" {
+				fields[strings.ToLower(name)] = true
+			}
+		}
+	}
+	return fields
+}
+
+// unknownFields returns the members of the JSON object data whose keys,
+// in lower case, are not in known, or nil if there are none.
+func unknownFields(data []byte, known map[string]bool) (map[string]json.RawMessage, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	var extra map[string]json.RawMessage
+	for k, v := range raw {
+		if known[strings.ToLower(k)] {
+			continue
+		}
+		if extra == nil {
+			extra = map[string]json.RawMessage{}
+		}
+		extra[k] = v
+	}
+	return extra, nil
+}
+
+// appendExtraFields adds the members of extra to the JSON object data,
+// skipping keys which, in lower case, are in known.  The struct's own
+// fields encode those.
+func appendExtraFields(data []byte, extra map[string]json.RawMessage, known map[string]bool) ([]byte, error) {
+	if len(extra) == 0 {
+		return data, nil
+	}
+	keys := make([]string, 0, len(extra))
+	for k := range extra {
+		if !known[strings.ToLower(k)] {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	buf.Write(data[:len(data)-1])
+	empty := bytes.Equal(bytes.TrimSpace(data), []byte("{}"))
+	for _, k := range keys {
+		if !json.Valid(extra[k]) {
+			return nil, fmt.Errorf("extra field %q is not valid JSON", k)
+		}
+		if !empty {
+			buf.WriteByte(',')
+		}
+		empty = false
+		key, _ := json.Marshal(k)
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(extra[k])
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,115 @@
+package thousandeyes
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_UpdateHTTPServerKeepsUnknownFields(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/tests/1.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"test":[{"testId":1,"testName":"web","enabled":1,"ipv6Policy":"FORCE_IPV6","newSetting":{"a":[1,2]}}]}`))
+	})
+	mux.HandleFunc("/tests/http-server/1/update.json", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"testId":1,"testName":"web","enabled":1,"interval":300,"ipv6Policy":"FORCE_IPV6","newSetting":{"a":[1,2]}}`, string(body))
+		_, _ = w.Write([]byte(`{"test":[{"testId":1}]}`))
+	})
+
+	client := &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	test, err := client.GetHTTPServer(1)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]json.RawMessage{
+		"ipv6Policy": json.RawMessage(`"FORCE_IPV6"`),
+		"newSetting": json.RawMessage(`{"a":[1,2]}`),
+	}, test.Extra)
+
+	test.Interval = Int(300)
+	_, err = client.UpdateHTTPServer(1, *test)
+	assert.Nil(t, err)
+}
+
+func TestExtra_RoundTrip(t *testing.T) {
+	// Keys are matched case-insensitively, as encoding/json does.
+	var rule AlertRule
+	assert.Nil(t, json.Unmarshal([]byte(`{"RuleID":1,"ruleName":"a","default":1,"severity":"MAJOR"}`), &rule))
+	assert.Equal(t, 1, *rule.RuleID)
+	assert.Equal(t, map[string]json.RawMessage{"severity": json.RawMessage(`"MAJOR"`)}, rule.Extra)
+	data, err := json.Marshal(rule)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"ruleId":1,"ruleName":"a","default":1,"severity":"MAJOR"}`, string(data))
+
+	// Types without int-bool fields keep unknown fields too.
+	var dashboard Dashboard
+	assert.Nil(t, json.Unmarshal([]byte(`{"dashboardId":"d","theme":"dark"}`), &dashboard))
+	assert.Equal(t, map[string]json.RawMessage{"theme": json.RawMessage(`"dark"`)}, dashboard.Extra)
+
+	// Extra fields never override modelled ones, and an empty object stays valid.
+	data, err = json.Marshal(Dashboard{Extra: map[string]json.RawMessage{"dashboardId": json.RawMessage(`"x"`), "theme": json.RawMessage(`"dark"`)}})
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"theme":"dark"}`, string(data))
+
+	_, err = json.Marshal(Dashboard{Extra: map[string]json.RawMessage{"theme": json.RawMessage(`dark`)}})
+	assert.EqualError(t, err, `json: error calling MarshalJSON for type *thousandeyes.Dashboard: extra field "theme" is not valid JSON`)
+}
+
+func TestExtra_IgnoredFields(t *testing.T) {
+	var test RTPStream
+	assert.Nil(t, json.Unmarshal([]byte(`{"testId":1,"server":"192.0.2.1:5060"}`), &test))
+	assert.Nil(t, test.Extra)
+}
+
+func TestExtra_GroupLabel(t *testing.T) {
+	var label GroupLabel
+	assert.Nil(t, json.Unmarshal([]byte(`{"groupId":1,"type":"endpoint_agents","agents":[{"agentId":"a"}],"color":"red"}`), &label))
+	assert.Equal(t, "a", *(*label.EndpointAgents)[0].AgentID)
+	assert.Equal(t, map[string]json.RawMessage{"color": json.RawMessage(`"red"`)}, label.Extra)
+
+	data, err := json.Marshal(label)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"groupId":1,"type":"endpoint_agents","agents":[{"agentId":"a"}],"color":"red"}`, string(data))
+}
+
+func TestUnknownFields(t *testing.T) {
+	tests := map[string][]HTTPServer{"test": {
+		{TestID: Int64(1)},
+		{TestID: Int64(2), Extra: map[string]json.RawMessage{"b": nil, "a": nil}, AlertRules: &[]AlertRule{{Extra: map[string]json.RawMessage{"c": nil}}}},
+	}}
+	assert.Equal(t, []string{"[test][1].a", "[test][1].b", "[test][1].AlertRules[0].c"}, UnknownFields(tests))
+	assert.Nil(t, UnknownFields(HTTPServer{}))
+	assert.Equal(t, []string{"a"}, UnknownFields(&HTTPServer{Extra: map[string]json.RawMessage{"a": nil}}))
+}
+
+func TestClient_StrictFields(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/tests/1.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"test":[{"testId":1,"newSetting":true}]}`))
+	})
+
+	client := &Client{APIEndpoint: server.URL, AuthToken: "foo", StrictFields: true}
+	_, err := client.GetHTTPServer(1)
+	assert.EqualError(t, err, "Could not decode JSON response: unknown fields: [test][0].newSetting")
+}
+
+func TestClient_StrictFieldsGenericTests(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/tests.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"test":[
+			{"testId":1,"testName":"web","type":"http-server","enabled":1,"interval":300,"url":"https://example.com","protocol":"TCP","followRedirects":1,"sslVersionId":0},
+			{"testId":2,"testName":"dns","type":"dns-server","enabled":1,"interval":60,"domain":"example.com A","dnsServers":[{"serverId":1,"serverName":"ns1.example.com"}]},
+			{"testId":3,"testName":"call","type":"voice","enabled":1,"interval":60,"codecId":0,"dscpId":46,"server":"10.0.0.1:49152"},
+			{"testId":4,"testName":"dns","type":"dns-server","newSetting":true}
+		]}`))
+	})
+
+	client := &Client{APIEndpoint: server.URL, AuthToken: "foo", StrictFields: true}
+	_, err := client.GetTests()
+	assert.EqualError(t, err, "could not decode JSON response: unknown fields: [test][3].newSetting")
+}
@@ -26,64 +26,44 @@ type FTPServer struct {
 	LiveShare          *bool                `json:"liveShare,omitempty" te:"int-bool"`
 
 	// Fields unique to this test
-	Agents              *[]Agent `json:"agents,omitempty"`
-	BGPMeasurements     *bool    `json:"bgpMeasurements,omitempty" te:"int-bool"`
-	DownloadLimit       *int     `json:"downloadLimit,omitempty"`
-	FTPTargetTime       *int     `json:"ftpTargetTime,omitempty"`
-	FTPTimeLimit        *int     `json:"ftpTimeLimit,omitempty"`
-	Interval            *int     `json:"interval,omitempty"`
-	MTUMeasurements     *bool    `json:"mtuMeasurements,omitempty" te:"int-bool"`
-	NetworkMeasurements *bool    `json:"networkMeasurements,omitempty" te:"int-bool"`
-	NumPathTraces       *int     `json:"numPathTraces,omitempty"`
-	Password            *string  `json:"password,omitempty"`
-	PathTraceMode       *string  `json:"pathTraceMode,omitempty"`
-	ProbeMode           *string  `json:"probeMode,omitempty"`
-	Protocol            *string  `json:"protocol,omitempty"`
-	RequestType         *string  `json:"requestType,omitempty"`
-	URL                 *string  `json:"url,omitempty"`
-	UseActiveFTP        *int     `json:"useActiveFtp,omitempty"`
-	UseExplicitFTPS     *int     `json:"useExplicitFtps,omitempty"`
-	Username            *string  `json:"username,omitempty"`
-}
-
-// MarshalJSON implements the json.Marshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t FTPServer) MarshalJSON() ([]byte, error) {
-	type aliasTest FTPServer
-
-	data, err := json.Marshal((aliasTest)(t))
-	if err != nil {
-		return nil, err
-	}
-
-	return jsonBoolToInt(&t, data)
-}
+	Agents              *[]Agent       `json:"agents,omitempty"`
+	BGPMeasurements     *bool          `json:"bgpMeasurements,omitempty" te:"int-bool"`
+	DownloadLimit       *int           `json:"downloadLimit,omitempty"`
+	FTPTargetTime       *int           `json:"ftpTargetTime,omitempty"`
+	FTPTimeLimit        *int           `json:"ftpTimeLimit,omitempty"`
+	Interval            *int           `json:"interval,omitempty"`
+	MTUMeasurements     *bool          `json:"mtuMeasurements,omitempty" te:"int-bool"`
+	NetworkMeasurements *bool          `json:"networkMeasurements,omitempty" te:"int-bool"`
+	NumPathTraces       *int           `json:"numPathTraces,omitempty"`
+	Password            *string        `json:"password,omitempty"`
+	PathTraceMode       *PathTraceMode `json:"pathTraceMode,omitempty"`
+	ProbeMode           *ProbeMode     `json:"probeMode,omitempty"`
+	Protocol            *Protocol      `json:"protocol,omitempty"`
+	RequestType         *string        `json:"requestType,omitempty"`
+	URL                 *string        `json:"url,omitempty"`
+	UseActiveFTP        *int           `json:"useActiveFtp,omitempty"`
+	UseExplicitFTPS     *int           `json:"useExplicitFtps,omitempty"`
+	Username            *string        `json:"username,omitempty"`
 
-// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t *FTPServer) UnmarshalJSON(data []byte) error {
-	type aliasTest FTPServer
-	test := (*aliasTest)(t)
-
-	data, err := jsonIntToBool(t, data)
-	if err != nil {
-		return err
-	}
-
-	return json.Unmarshal(data, &test)
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // AddAgent - Add ftp server test
 func (t *FTPServer) AddAgent(id int) {
 	agent := Agent{AgentID: Int(id)}
+	if t.Agents == nil {
+		t.Agents = &[]Agent{}
+	}
 	*t.Agents = append(*t.Agents, agent)
 }
 
 // AddAlertRule - Adds an alert to agent test
 func (t *FTPServer) AddAlertRule(id int) {
 	alertRule := AlertRule{RuleID: Int(id)}
+	if t.AlertRules == nil {
+		t.AlertRules = &[]AlertRule{}
+	}
 	*t.AlertRules = append(*t.AlertRules, alertRule)
 }
 
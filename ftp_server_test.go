@@ -29,7 +29,7 @@ func TestClient_GetFTPServer(t *testing.T) {
 		LiveShare:     Bool(false),
 		Interval:      Int(300),
 		URL:           String("webex.com"),
-		ProbeMode:     String("AUTO"),
+		ProbeMode:     ProbeMode("AUTO").Ptr(),
 		Agents: &[]Agent{
 			{
 				AgentID:     Int(48620),
@@ -114,8 +114,8 @@ func TestClient_CreateFTPServer(t *testing.T) {
 		Interval:      Int(300),
 		LiveShare:     Bool(false),
 		URL:           String("webex.com"),
-		Protocol:      String("TCP"),
-		ProbeMode:     String("AUTO"),
+		Protocol:      Protocol("TCP").Ptr(),
+		ProbeMode:     ProbeMode("AUTO").Ptr(),
 		Agents: &[]Agent{
 			{
 				AgentID:     Int(48620),
@@ -161,8 +161,8 @@ func TestClient_CreateFTPServer(t *testing.T) {
 	create := FTPServer{
 		TestName:  String("test123"),
 		URL:       String("webex.com"),
-		Protocol:  String("TCP"),
-		ProbeMode: String("AUTO"),
+		Protocol:  Protocol("TCP").Ptr(),
+		ProbeMode: ProbeMode("AUTO").Ptr(),
 	}
 	res, err := client.CreateFTPServer(create)
 	teardown()
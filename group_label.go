@@ -8,43 +8,117 @@ import (
 // GroupLabels - list of labels
 type GroupLabels []GroupLabel
 
+// LabelType - the kind of object a label groups
+type LabelType string
+
+// Label types
+const (
+	LabelTypeTests          LabelType = "tests"
+	LabelTypeAgents         LabelType = "agents"
+	LabelTypeEndpointAgents LabelType = "endpoint_agents"
+	LabelTypeEndpointTests  LabelType = "endpoint_tests"
+	LabelTypeDashboards     LabelType = "dashboards"
+)
+
+// Validate returns an error if t is not a known label type.
+func (t LabelType) Validate() error {
+	switch t {
+	case LabelTypeTests, LabelTypeAgents, LabelTypeEndpointAgents, LabelTypeEndpointTests, LabelTypeDashboards:
+		return nil
+	}
+	return fmt.Errorf("invalid label type %q", string(t))
+}
+
+// isEndpoint reports whether labels of type t group endpoint agents or
+// endpoint tests.
+func (t LabelType) isEndpoint() bool {
+	return t == LabelTypeEndpointAgents || t == LabelTypeEndpointTests
+}
+
 // GroupLabel - label
 type GroupLabel struct {
 	Name    *string        `json:"name,omitempty"`
 	GroupID *int64         `json:"groupId,omitempty"`
 	Builtin *bool          `json:"builtin,omitempty" te:"int-bool"`
-	Type    *string        `json:"type,omitempty"`
+	Type    *LabelType     `json:"type,omitempty"`
 	Agents  *[]Agent       `json:"agents,omitempty"`
 	Tests   *[]GenericTest `json:"tests,omitempty"`
+
+	// Endpoint agent and endpoint test labels use the same "agents" and
+	// "tests" keys as other labels, but with different member objects.
+	EndpointAgents *[]EndpointAgent       `json:"-"`
+	EndpointTests  *[]GenericEndpointTest `json:"-"`
+	Dashboards     *[]Dashboard           `json:"dashboards,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
-// MarshalJSON implements the json.Marshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t GroupLabel) MarshalJSON() ([]byte, error) {
-	type alias GroupLabel
+// afterMarshalJSON encodes endpoint agent and endpoint test members.
+func (t GroupLabel) afterMarshalJSON(data []byte) ([]byte, error) {
+	if t.EndpointAgents == nil && t.EndpointTests == nil {
+		return data, nil
+	}
+	return setLabelMembers(data, t.EndpointAgents, t.EndpointTests)
+}
 
-	data, err := json.Marshal((alias)(t))
-	if err != nil {
+// beforeUnmarshalJSON decodes endpoint agent and endpoint test members,
+// which share the "agents" and "tests" keys with the members of other
+// labels, and returns the remaining JSON.  The kind of members is decided
+// by the label type; members of labels without a type are decoded as agents
+// and tests.
+func (t *GroupLabel) beforeUnmarshalJSON(data []byte) ([]byte, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
 		return nil, err
 	}
-
-	return jsonBoolToInt(&t, data)
+	var labelType LabelType
+	if v, ok := raw["type"]; ok {
+		if err := json.Unmarshal(v, &labelType); err != nil {
+			return nil, err
+		}
+	}
+	if v, ok := raw["agents"]; ok && labelType == LabelTypeEndpointAgents {
+		var agents []EndpointAgent
+		if err := json.Unmarshal(v, &agents); err != nil {
+			return nil, err
+		}
+		t.EndpointAgents = &agents
+		delete(raw, "agents")
+	}
+	if v, ok := raw["tests"]; ok && labelType == LabelTypeEndpointTests {
+		var tests []GenericEndpointTest
+		if err := json.Unmarshal(v, &tests); err != nil {
+			return nil, err
+		}
+		t.EndpointTests = &tests
+		delete(raw, "tests")
+	}
+	return json.Marshal(raw)
 }
 
-// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t *GroupLabel) UnmarshalJSON(data []byte) error {
-	type alias GroupLabel
-	test := (*alias)(t)
-
-	data, err := jsonIntToBool(t, data)
-	if err != nil {
-		return err
+// setLabelMembers encodes endpoint agent and endpoint test members under the
+// "agents" and "tests" keys used by the API.
+func setLabelMembers(data []byte, agents *[]EndpointAgent, tests *[]GenericEndpointTest) ([]byte, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
 	}
-
-	return json.Unmarshal(data, &test)
+	if agents != nil {
+		v, err := json.Marshal(agents)
+		if err != nil {
+			return nil, err
+		}
+		raw["agents"] = v
+	}
+	if tests != nil {
+		v, err := json.Marshal(tests)
+		if err != nil {
+			return nil, err
+		}
+		raw["tests"] = v
+	}
+	return json.Marshal(raw)
 }
 
 // GetGroupLabels - Get labels
@@ -62,8 +136,11 @@ func (c *Client) GetGroupLabels() (*GroupLabels, error) {
 }
 
 // GetGroupLabelsByType - Get label by type
-func (c *Client) GetGroupLabelsByType(t string) (*GroupLabels, error) {
-	resp, err := c.get("/groups/" + t)
+func (c *Client) GetGroupLabelsByType(t LabelType) (*GroupLabels, error) {
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+	resp, err := c.get("/groups/" + string(t))
 	if err != nil {
 		return &GroupLabels{}, err
 	}
@@ -91,12 +168,15 @@ func (c *Client) GetGroupLabel(id int) (*GroupLabel, error) {
 // CreateGroupLabel - Create label
 func (c Client) CreateGroupLabel(a GroupLabel) (*GroupLabel, error) {
 	if a.Type == nil {
-		a.Type = String("")
+		return nil, fmt.Errorf("label type is required to create a label")
+	}
+	if err := a.Type.Validate(); err != nil {
+		return nil, err
 	}
 	path := fmt.Sprintf("/groups/%s/new", *a.Type)
 	// Now we must set Type to blank.  Because even though it's required to know the submit path,
 	// TE will return an error if we also submit it a part of the object.
-	a.Type = String("")
+	a.Type = nil
 	resp, err := c.post(path, a, nil)
 	if err != nil {
 		return nil, err
@@ -143,3 +223,197 @@ func (c Client) UpdateGroupLabel(id int, a GroupLabel) (*GroupLabels, error) {
 	return &labels, nil
 
 }
+
+// labelUpdateAttempts is the number of times a label membership change is
+// retried when the label is modified concurrently.
+const labelUpdateAttempts = 3
+
+// labelMembers returns the test and agent members of a label as a set.
+func labelMembers(l *GroupLabel) map[string]bool {
+	members := map[string]bool{}
+	if l.Tests != nil {
+		for _, t := range *l.Tests {
+			if t.TestID != nil {
+				members[fmt.Sprintf("test %d", *t.TestID)] = true
+			}
+		}
+	}
+	if l.Agents != nil {
+		for _, a := range *l.Agents {
+			if a.AgentID != nil {
+				members[fmt.Sprintf("agent %d", *a.AgentID)] = true
+			}
+		}
+	}
+	return members
+}
+
+// labelChangeApplied reports whether the members of a label read back after
+// an update include every wanted member and none of the members removed
+// from before.  Members added by other writers are ignored.
+func labelChangeApplied(before, want, got map[string]bool) bool {
+	for m := range want {
+		if !got[m] {
+			return false
+		}
+	}
+	for m := range before {
+		if !want[m] && got[m] {
+			return false
+		}
+	}
+	return true
+}
+
+// modifyGroupLabel performs a read-modify-write of a label's test or agent
+// members.  The API has no conditional updates, so the label is read back
+// after writing, and the change is retried if another writer dropped any of
+// the wanted members or restored a removed one.  Endpoint agent and endpoint
+// test labels are not supported.
+func (c *Client) modifyGroupLabel(id int, modify func(l *GroupLabel) bool) (*GroupLabel, error) {
+	for attempt := 0; attempt < labelUpdateAttempts; attempt++ {
+		label, err := c.GetGroupLabel(id)
+		if err != nil {
+			return nil, err
+		}
+		if label.EndpointAgents != nil || label.EndpointTests != nil ||
+			(label.Type != nil && label.Type.isEndpoint()) {
+			return nil, fmt.Errorf("label %d is an endpoint label, which cannot be modified by member ID", id)
+		}
+		before := labelMembers(label)
+		update := GroupLabel{Name: label.Name, Tests: label.Tests, Agents: label.Agents}
+		if !modify(&update) {
+			return label, nil
+		}
+		want := labelMembers(&update)
+
+		if _, err := c.UpdateGroupLabel(id, update); err != nil {
+			return nil, err
+		}
+		result, err := c.GetGroupLabel(id)
+		if err != nil {
+			return nil, err
+		}
+		if labelChangeApplied(before, want, labelMembers(result)) {
+			return result, nil
+		}
+	}
+	return nil, fmt.Errorf("label %d was modified concurrently, giving up after %d attempts", id, labelUpdateAttempts)
+}
+
+// AddTestsToLabel - add tests to a label, leaving its other members in place
+func (c *Client) AddTestsToLabel(id int, testIDs []int) (*GroupLabel, error) {
+	return c.modifyGroupLabel(id, func(l *GroupLabel) bool {
+		tests := []GenericTest{}
+		present := map[int64]bool{}
+		if l.Tests != nil {
+			for _, t := range *l.Tests {
+				if t.TestID != nil {
+					tests = append(tests, GenericTest{TestID: t.TestID})
+					present[*t.TestID] = true
+				}
+			}
+		}
+		changed := false
+		for _, id := range testIDs {
+			if !present[int64(id)] {
+				tests = append(tests, GenericTest{TestID: Int64(int64(id))})
+				present[int64(id)] = true
+				changed = true
+			}
+		}
+		l.Tests = &tests
+		return changed
+	})
+}
+
+// RemoveTestsFromLabel - remove tests from a label, leaving its other members in place
+func (c *Client) RemoveTestsFromLabel(id int, testIDs []int) (*GroupLabel, error) {
+	return c.modifyGroupLabel(id, func(l *GroupLabel) bool {
+		remove := map[int64]bool{}
+		for _, id := range testIDs {
+			remove[int64(id)] = true
+		}
+		tests := []GenericTest{}
+		changed := false
+		if l.Tests != nil {
+			for _, t := range *l.Tests {
+				if t.TestID == nil {
+					continue
+				}
+				if remove[*t.TestID] {
+					changed = true
+					continue
+				}
+				tests = append(tests, GenericTest{TestID: t.TestID})
+			}
+		}
+		l.Tests = &tests
+		return changed
+	})
+}
+
+// AddAgentsToLabel - add agents to a label, leaving its other members in place
+func (c *Client) AddAgentsToLabel(id int, agentIDs []int) (*GroupLabel, error) {
+	return c.modifyGroupLabel(id, func(l *GroupLabel) bool {
+		agents := []Agent{}
+		present := map[int]bool{}
+		if l.Agents != nil {
+			for _, a := range *l.Agents {
+				if a.AgentID != nil {
+					agents = append(agents, Agent{AgentID: a.AgentID})
+					present[*a.AgentID] = true
+				}
+			}
+		}
+		changed := false
+		for _, id := range agentIDs {
+			if !present[id] {
+				agents = append(agents, Agent{AgentID: Int(id)})
+				present[id] = true
+				changed = true
+			}
+		}
+		l.Agents = &agents
+		return changed
+	})
+}
+
+// RemoveAgentsFromLabel - remove agents from a label, leaving its other members in place
+func (c *Client) RemoveAgentsFromLabel(id int, agentIDs []int) (*GroupLabel, error) {
+	return c.modifyGroupLabel(id, func(l *GroupLabel) bool {
+		remove := map[int]bool{}
+		for _, id := range agentIDs {
+			remove[id] = true
+		}
+		agents := []Agent{}
+		changed := false
+		if l.Agents != nil {
+			for _, a := range *l.Agents {
+				if a.AgentID == nil {
+					continue
+				}
+				if remove[*a.AgentID] {
+					changed = true
+					continue
+				}
+				agents = append(agents, Agent{AgentID: a.AgentID})
+			}
+		}
+		l.Agents = &agents
+		return changed
+	})
+}
+
+// GetLabelsForTest - Get the labels a test belongs to
+func (c *Client) GetLabelsForTest(testID int) (*GroupLabels, error) {
+	test, err := c.GetTest(testID)
+	if err != nil {
+		return nil, err
+	}
+	labels := GroupLabels{}
+	if test.Groups != nil {
+		labels = append(labels, *test.Groups...)
+	}
+	return &labels, nil
+}
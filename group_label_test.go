@@ -1,6 +1,9 @@
 package thousandeyes
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"testing"
 
@@ -18,7 +21,7 @@ func TestClient_GetGroupLabels(t *testing.T) {
 
 	// Define expected values from the API (based on the JSON we print out above)
 	expected := GroupLabels{
-		GroupLabel{GroupID: Int64(1), Type: String("tests"), Name: String("exampleName")},
+		GroupLabel{GroupID: Int64(1), Type: Ptr(LabelTypeTests), Name: String("exampleName")},
 	}
 
 	res, err := client.GetGroupLabels()
@@ -39,7 +42,7 @@ func TestClient_GetGroupLabelsByType(t *testing.T) {
 
 	// Define expected values from the API (based on the JSON we print out above)
 	expected := GroupLabels{
-		GroupLabel{GroupID: Int64(1), Builtin: Bool(false), Type: String("tests"), Name: String("test-agent")},
+		GroupLabel{GroupID: Int64(1), Builtin: Bool(false), Type: Ptr(LabelTypeTests), Name: String("test-agent")},
 	}
 
 	res, err := client.GetGroupLabelsByType("tests")
@@ -65,7 +68,7 @@ func TestClient_GetGroupLabel(t *testing.T) {
 
 	// Define expected values from the API (based on the JSON we print out above)
 	expected := GroupLabel{
-		GroupID: Int64(222), Builtin: Bool(false), Type: String("tests"), Name: String("test-agent"),
+		GroupID: Int64(222), Builtin: Bool(false), Type: Ptr(LabelTypeTests), Name: String("test-agent"),
 	}
 
 	res, err := client.GetGroupLabel(222)
@@ -153,12 +156,12 @@ func TestClient_UpdateGroupLabel(t *testing.T) {
 
 	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
 	id := 222
-	u := GroupLabel{Type: String("tests")}
+	u := GroupLabel{Type: Ptr(LabelTypeTests)}
 	res, err := client.UpdateGroupLabel(id, u)
 	if err != nil {
 		t.Fatal(err)
 	}
-	expected := GroupLabels{GroupLabel{GroupID: Int64(222), Type: String("tests"), Name: String("test-agent")}}
+	expected := GroupLabels{GroupLabel{GroupID: Int64(222), Type: Ptr(LabelTypeTests), Name: String("test-agent")}}
 	assert.Equal(t, &expected, res)
 }
 
@@ -167,12 +170,15 @@ func TestClient_CreateGroupLabel(t *testing.T) {
 	out := `{"groups" : [ {"groupId":1, "name": "test"}]}`
 	mux.HandleFunc("/groups/tests/new.json", func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "POST", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name": "test", "groupId": 1}`, string(body))
 		w.WriteHeader(http.StatusCreated)
 		_, _ = w.Write([]byte(out))
 	})
 
-	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
-	u := GroupLabel{Name: String("test"), Type: String("tests"), GroupID: Int64(1)}
+	// The type is not sent, so it does not fail the strict enum check.
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo", StrictEnums: true}
+	u := GroupLabel{Name: String("test"), Type: Ptr(LabelTypeTests), GroupID: Int64(1)}
 	res, err := client.CreateGroupLabel(u)
 	if err != nil {
 		t.Fatal(err)
@@ -245,7 +251,7 @@ func TestClient_CreateGroupLabelJsonError(t *testing.T) {
 		_, _ = w.Write([]byte(out))
 
 	})
-	_, err := client.CreateGroupLabel(GroupLabel{Type: String("tests")})
+	_, err := client.CreateGroupLabel(GroupLabel{Type: Ptr(LabelTypeTests)})
 	assert.Error(t, err)
 	assert.EqualError(t, err, "Could not decode JSON response: invalid character 'e' in literal true (expecting 'r')")
 }
@@ -284,7 +290,7 @@ func TestClient_CreateGroupLabelStatusCode(t *testing.T) {
 		w.WriteHeader(http.StatusBadRequest)
 		w.Write([]byte(`{}`))
 	})
-	_, err := client.CreateGroupLabel(GroupLabel{Type: String("tests")})
+	_, err := client.CreateGroupLabel(GroupLabel{Type: Ptr(LabelTypeTests)})
 	teardown()
 	assert.ErrorContains(t, err, "Response did not contain formatted error: %!s(<nil>). HTTP response code: 400")
 }
@@ -314,3 +320,191 @@ func TestClient_DeleteGroupLabelStatusCode(t *testing.T) {
 	teardown()
 	assert.ErrorContains(t, err, "Response did not contain formatted error: %!s(<nil>). HTTP response code: 400")
 }
+
+func TestClient_AddTestsToLabel(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	members := `[{"testId": 1}]`
+	var updates []string
+	mux.HandleFunc("/groups/5.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"groups": [{"groupId": 5, "name": "label", "type": "tests", "tests": %s}]}`, members)))
+	})
+	mux.HandleFunc("/groups/5/update.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		var body map[string]json.RawMessage
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		updates = append(updates, string(body["tests"]))
+		members = string(body["tests"])
+		_, _ = w.Write([]byte(`{"groups": []}`))
+	})
+
+	res, err := client.AddTestsToLabel(5, []int{1, 2})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{`[{"testId":1},{"testId":2}]`}, updates)
+	assert.Equal(t, &[]GenericTest{{TestID: Int64(1)}, {TestID: Int64(2)}}, res.Tests)
+
+	// Adding an existing member does not write to the API.
+	_, err = client.AddTestsToLabel(5, []int{2})
+	assert.Nil(t, err)
+	assert.Len(t, updates, 1)
+
+	res, err = client.RemoveTestsFromLabel(5, []int{1})
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, `[{"testId":2}]`, updates[1])
+	assert.Equal(t, &[]GenericTest{{TestID: Int64(2)}}, res.Tests)
+}
+
+func TestClient_AddAgentsToLabel(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	members := `[]`
+	mux.HandleFunc("/groups/6.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"groups": [{"groupId": 6, "name": "label", "type": "agents", "agents": %s}]}`, members)))
+	})
+	mux.HandleFunc("/groups/6/update.json", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]json.RawMessage
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		assert.Nil(t, body["tests"])
+		members = string(body["agents"])
+		_, _ = w.Write([]byte(`{"groups": []}`))
+	})
+
+	res, err := client.AddAgentsToLabel(6, []int{10, 11})
+	assert.Nil(t, err)
+	assert.Equal(t, &[]Agent{{AgentID: Int(10)}, {AgentID: Int(11)}}, res.Agents)
+
+	res, err = client.RemoveAgentsFromLabel(6, []int{10})
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &[]Agent{{AgentID: Int(11)}}, res.Agents)
+}
+
+func TestClient_AddTestsToLabelConflict(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	updates := 0
+	mux.HandleFunc("/groups/5.json", func(w http.ResponseWriter, r *http.Request) {
+		// Another writer overwrites the label after every update.
+		_, _ = w.Write([]byte(`{"groups": [{"groupId": 5, "type": "tests", "tests": [{"testId": 1}]}]}`))
+	})
+	mux.HandleFunc("/groups/5/update.json", func(w http.ResponseWriter, r *http.Request) {
+		updates++
+		_, _ = w.Write([]byte(`{"groups": []}`))
+	})
+
+	_, err := client.AddTestsToLabel(5, []int{100})
+	teardown()
+	assert.EqualError(t, err, "label 5 was modified concurrently, giving up after 3 attempts")
+	assert.Equal(t, 3, updates)
+}
+
+func TestClient_AddTestsToLabelConcurrentAdd(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	members := `[{"testId": 1}]`
+	mux.HandleFunc("/groups/5.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"groups": [{"groupId": 5, "type": "tests", "tests": %s}]}`, members)))
+	})
+	mux.HandleFunc("/groups/5/update.json", func(w http.ResponseWriter, r *http.Request) {
+		// Another writer adds test 3 right after the update.
+		members = `[{"testId": 1}, {"testId": 2}, {"testId": 3}]`
+		_, _ = w.Write([]byte(`{"groups": []}`))
+	})
+
+	res, err := client.AddTestsToLabel(5, []int{2})
+	teardown()
+	assert.Nil(t, err)
+	assert.Len(t, *res.Tests, 3)
+}
+
+func TestClient_AddAgentsToEndpointLabel(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/groups/7.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"groups": [{"groupId": 7, "type": "endpoint_agents", "agents": [{"agentId": "abc"}]}]}`))
+	})
+	mux.HandleFunc("/groups/7/update.json", func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("endpoint labels must not be updated")
+	})
+
+	_, err := client.AddAgentsToLabel(7, []int{10})
+	teardown()
+	assert.EqualError(t, err, "label 7 is an endpoint label, which cannot be modified by member ID")
+}
+
+func TestClient_GetLabelsForTest(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/tests/1.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		_, _ = w.Write([]byte(`{"test": [{"testId": 1, "groups": [{"groupId": 5, "name": "label", "type": "tests", "builtin": 0}]}]}`))
+	})
+
+	res, err := client.GetLabelsForTest(1)
+	teardown()
+	assert.Nil(t, err)
+	expected := GroupLabels{{GroupID: Int64(5), Name: String("label"), Type: Ptr(LabelTypeTests), Builtin: Bool(false)}}
+	assert.Equal(t, &expected, res)
+}
+
+func TestClient_GetGroupLabelsByTypeInvalid(t *testing.T) {
+	_, err := client.GetGroupLabelsByType("test")
+	assert.EqualError(t, err, `invalid label type "test"`)
+}
+
+func TestClient_CreateGroupLabelInvalidType(t *testing.T) {
+	_, err := client.CreateGroupLabel(GroupLabel{Name: String("test")})
+	assert.EqualError(t, err, "label type is required to create a label")
+
+	_, err = client.CreateGroupLabel(GroupLabel{Name: String("test"), Type: Ptr(LabelType(""))})
+	assert.EqualError(t, err, `invalid label type ""`)
+}
+
+func TestClient_GetGroupLabelsByTypeEndpointAgents(t *testing.T) {
+	out := `{"groups": [{"groupId": 1, "type": "endpoint_agents", "name": "laptops", "builtin": 0, "agents": [{"agentId": "a1b2", "agentName": "laptop-1"}]}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/groups/endpoint_agents.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		_, _ = w.Write([]byte(out))
+	})
+
+	expected := GroupLabels{
+		{
+			GroupID:        Int64(1),
+			Type:           Ptr(LabelTypeEndpointAgents),
+			Name:           String("laptops"),
+			Builtin:        Bool(false),
+			EndpointAgents: &[]EndpointAgent{{AgentID: String("a1b2"), AgentName: String("laptop-1")}},
+		},
+	}
+	res, err := client.GetGroupLabelsByType(LabelTypeEndpointAgents)
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &expected, res)
+}
+
+func TestGroupLabel_JSONMembers(t *testing.T) {
+	var label GroupLabel
+	err := json.Unmarshal([]byte(`{"type": "endpoint_tests", "tests": [{"testId": 1, "enabled": 1}]}`), &label)
+	assert.Nil(t, err)
+	assert.Nil(t, label.Tests)
+	assert.Equal(t, &[]GenericEndpointTest{{TestID: Int64(1), Enabled: Bool(true)}}, label.EndpointTests)
+
+	data, err := json.Marshal(label)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"type": "endpoint_tests", "tests": [{"testId": 1, "enabled": 1}]}`, string(data))
+
+	// Members of labels without a type are decoded as agents and tests.
+	label = GroupLabel{}
+	err = json.Unmarshal([]byte(`{"agents": [{"agentId": 3}], "tests": [{"testId": 4}]}`), &label)
+	assert.Nil(t, err)
+	assert.Equal(t, &[]Agent{{AgentID: Int(3)}}, label.Agents)
+	assert.Equal(t, &[]GenericTest{{TestID: Int64(4)}}, label.Tests)
+
+	err = json.Unmarshal([]byte(`{"type": "dashboards", "dashboards": [{"dashboardId": "abc", "title": "Overview"}]}`), &label)
+	assert.Nil(t, err)
+	assert.Equal(t, &[]Dashboard{{DashboardID: String("abc"), Title: String("Overview")}}, label.Dashboards)
+}
@@ -16,6 +16,9 @@ type CustomHeaders struct {
 	Root    *map[string]string            `json:"root,omitempty"`
 	All     *map[string]string            `json:"all,omitempty"`
 	Domains *map[string]map[string]string `json:"domains,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // HTTPServer - a http server test
@@ -40,7 +43,7 @@ type HTTPServer struct {
 
 	// Fields unique to this test
 	Agents                *[]Agent       `json:"agents,omitempty"`
-	AuthType              *string        `json:"authType,omitempty"`
+	AuthType              *AuthType      `json:"authType,omitempty"`
 	BandwidthMeasurements *bool          `json:"bandwidthMeasurements,omitempty" te:"int-bool"`
 	BGPMeasurements       *bool          `json:"bgpMeasurements,omitempty" te:"int-bool"`
 	BGPMonitors           *[]Monitor     `json:"bgpMonitors,omitempty"`
@@ -60,10 +63,10 @@ type HTTPServer struct {
 	NetworkMeasurements   *bool          `json:"networkMeasurements,omitempty" te:"int-bool"`
 	NumPathTraces         *int           `json:"numPathTraces,omitempty"`
 	Password              *string        `json:"password,omitempty"`
-	PathTraceMode         *string        `json:"pathTraceMode,omitempty"`
+	PathTraceMode         *PathTraceMode `json:"pathTraceMode,omitempty"`
 	PostBody              *string        `json:"postBody,omitempty"`
-	ProbeMode             *string        `json:"probeMode,omitempty"`
-	Protocol              *string        `json:"protocol,omitempty"`
+	ProbeMode             *ProbeMode     `json:"probeMode,omitempty"`
+	Protocol              *Protocol      `json:"protocol,omitempty"`
 	SSLVersion            *string        `json:"sslVersion,omitempty"`
 	SSLVersionID          *int           `json:"sslVersionId,omitempty"`
 	URL                   *string        `json:"url,omitempty"`
@@ -71,40 +74,17 @@ type HTTPServer struct {
 	UserAgent             *string        `json:"userAgent,omitempty"`
 	Username              *string        `json:"username,omitempty"`
 	VerifyCertificate     *bool          `json:"verifyCertificate,omitempty" te:"int-bool"`
-}
-
-// MarshalJSON implements the json.Marshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t HTTPServer) MarshalJSON() ([]byte, error) {
-	type aliasTest HTTPServer
-
-	data, err := json.Marshal((aliasTest)(t))
-	if err != nil {
-		return nil, err
-	}
-
-	return jsonBoolToInt(&t, data)
-}
-
-// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t *HTTPServer) UnmarshalJSON(data []byte) error {
-	type aliasTest HTTPServer
-	test := (*aliasTest)(t)
 
-	data, err := jsonIntToBool(t, data)
-	if err != nil {
-		return err
-	}
-
-	return json.Unmarshal(data, &test)
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // AddAgent - add an agent
 func (t *HTTPServer) AddAgent(id int) {
 	agent := Agent{AgentID: Int(id)}
+	if t.Agents == nil {
+		t.Agents = &[]Agent{}
+	}
 	*t.Agents = append(*t.Agents, agent)
 }
 
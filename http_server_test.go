@@ -1,6 +1,7 @@
 package thousandeyes
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
 
@@ -27,7 +28,7 @@ func TestClient_GetHTTPServer(t *testing.T) {
 		Type:                  String("http-server"),
 		Interval:              Int(300),
 		URL:                   String("https://test.com"),
-		Protocol:              String("TCP"),
+		Protocol:              Protocol("TCP").Ptr(),
 		NetworkMeasurements:   Bool(true),
 		MTUMeasurements:       Bool(true),
 		BandwidthMeasurements: Bool(false),
@@ -42,9 +43,9 @@ func TestClient_GetHTTPServer(t *testing.T) {
 		SSLVersionID:          Int(0),
 		VerifyCertificate:     Bool(true),
 		UseNTLM:               Bool(false),
-		AuthType:              String("NONE"),
+		AuthType:              AuthType("NONE").Ptr(),
 		ContentRegex:          String(""),
-		ProbeMode:             String("AUTO"),
+		ProbeMode:             ProbeMode("AUTO").Ptr(),
 		Agents: &[]Agent{
 			{
 				AgentID:     Int(48620),
@@ -96,6 +97,10 @@ func TestClient_GetHTTPServer(t *testing.T) {
 				Rel:  String("data"),
 			},
 		},
+		Extra: map[string]json.RawMessage{
+			"ipv6Policy":   json.RawMessage(`"USE_AGENT_POLICY"`),
+			"usePublicBgp": json.RawMessage(`1`),
+		},
 	}
 
 	res, err := client.GetHTTPServer(122621)
@@ -138,7 +143,7 @@ func TestClient_CreateHTTPServer(t *testing.T) {
 		Type:                  String("http-server"),
 		Interval:              Int(300),
 		URL:                   String("https://test.com"),
-		Protocol:              String("TCP"),
+		Protocol:              Protocol("TCP").Ptr(),
 		NetworkMeasurements:   Bool(true),
 		MTUMeasurements:       Bool(true),
 		BandwidthMeasurements: Bool(false),
@@ -153,9 +158,9 @@ func TestClient_CreateHTTPServer(t *testing.T) {
 		SSLVersionID:          Int(0),
 		VerifyCertificate:     Bool(true),
 		UseNTLM:               Bool(false),
-		AuthType:              String("NONE"),
+		AuthType:              AuthType("NONE").Ptr(),
 		ContentRegex:          String(""),
-		ProbeMode:             String("AUTO"),
+		ProbeMode:             ProbeMode("AUTO").Ptr(),
 		Agents: &[]Agent{
 			{
 				AgentID:     Int(48620),
@@ -207,6 +212,10 @@ func TestClient_CreateHTTPServer(t *testing.T) {
 				Rel:  String("data"),
 			},
 		},
+		Extra: map[string]json.RawMessage{
+			"ipv6Policy":   json.RawMessage(`"USE_AGENT_POLICY"`),
+			"usePublicBgp": json.RawMessage(`1`),
+		},
 	}
 	create := HTTPServer{
 		TestName: String("test1"),
@@ -0,0 +1,137 @@
+package thousandeyes
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntBool_UnmarshalJSON(t *testing.T) {
+	for input, expected := range map[string]*bool{
+		`1`:       Bool(true),
+		`0`:       Bool(false),
+		`1.0`:     Bool(true),
+		`2`:       Bool(true),
+		`"1"`:     Bool(true),
+		`"0"`:     Bool(false),
+		`""`:      Bool(false),
+		`true`:    Bool(true),
+		`false`:   Bool(false),
+		`"TRUE"`:  Bool(true),
+		`"false"`: Bool(false),
+		`null`:    nil,
+	} {
+		var test GenericTest
+		err := json.Unmarshal([]byte(`{"enabled":`+input+`}`), &test)
+		assert.Nil(t, err, input)
+		assert.Equal(t, expected, test.Enabled, input)
+	}
+
+	var test GenericTest
+	err := json.Unmarshal([]byte(`{"enabled":"yes"}`), &test)
+	assert.EqualError(t, err, `cannot decode "yes" as a boolean`)
+	err = json.Unmarshal([]byte(`{"enabled":[1]}`), &test)
+	assert.EqualError(t, err, `cannot decode [1] as a boolean`)
+}
+
+func TestIntBool_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(GenericTest{Enabled: Bool(true), SavedEvent: Bool(false), TestName: String("a")})
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"enabled":1,"savedEvent":0,"testName":"a"}`, string(data))
+
+	// Fields without omitempty are encoded as null when unset.
+	data, err = json.Marshal(Permission{})
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), `"isManagementPermission":null`)
+}
+
+func TestIntBool_UnmarshalKeepsUnsetFields(t *testing.T) {
+	test := GenericTest{Enabled: Bool(true), AlertsEnabled: Bool(true)}
+	assert.Nil(t, json.Unmarshal([]byte(`{"alertsEnabled":0}`), &test))
+	assert.Equal(t, Bool(true), test.Enabled)
+	assert.Equal(t, Bool(false), test.AlertsEnabled)
+}
+
+// largeTestsResponse returns a GetTests response with n tests, each with a
+// few agents and alert rules.
+func largeTestsResponse(n int) []byte {
+	tests := make([]string, n)
+	for i := range tests {
+		tests[i] = fmt.Sprintf(`{"testId":%d,"testName":"test %d","type":"http-server","enabled":1,"alertsEnabled":0,"savedEvent":0,"liveShare":0,"createdBy":"a","createdDate":"2022-06-01 10:00:00","description":"","apiLinks":[{"rel":"self","href":"https://api.thousandeyes.com/v6/tests/%d"}],"agents":[{"agentId":1,"agentName":"London","enabled":1,"keepBrowserCache":0},{"agentId":2,"agentName":"Paris","enabled":1,"keepBrowserCache":0}],"alertRules":[{"ruleId":1,"ruleName":"Default","default":1},{"ruleId":2,"ruleName":"Latency","default":0}]}`, i, i, i)
+	}
+	return []byte(`{"test":[` + strings.Join(tests, ",") + `]}`)
+}
+
+func BenchmarkClient_GetTests(b *testing.B) {
+	setup()
+	defer teardown()
+	out := largeTestsResponse(1000)
+	mux.HandleFunc("/tests.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(out)
+	})
+	client := &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := client.GetTests(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// reflectionTest decodes like GenericTest did before its marshalers were
+// generated, as a baseline for BenchmarkUnmarshalTests.
+type reflectionTest GenericTest
+
+func (t *reflectionTest) UnmarshalJSON(data []byte) error {
+	type alias reflectionTest
+	booleanFields := map[string]bool{}
+	rt := reflect.TypeOf(*t)
+	for i := 0; i < rt.NumField(); i++ {
+		if rt.Field(i).Tag.Get("te") == "int-bool" {
+			booleanFields[strings.Split(rt.Field(i).Tag.Get("json"), ",")[0]] = true
+		}
+	}
+	var jsonMap map[string]interface{}
+	if err := json.Unmarshal(data, &jsonMap); err != nil {
+		return err
+	}
+	for key, value := range jsonMap {
+		if booleanFields[key] {
+			jsonMap[key] = value.(float64) == 1
+		}
+	}
+	data, err := json.Marshal(jsonMap)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, (*alias)(t))
+}
+
+func BenchmarkUnmarshalTests(b *testing.B) {
+	data := largeTestsResponse(1000)
+	b.Run("generated", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var target map[string][]GenericTest
+			if err := json.Unmarshal(data, &target); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("reflection", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var target map[string][]reflectionTest
+			if err := json.Unmarshal(data, &target); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -1,17 +1,65 @@
 package thousandeyes
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// IntegrationType - the kind of alert destination an integration delivers to
+type IntegrationType string
+
+// Integration types
+const (
+	IntegrationTypeWebhook    IntegrationType = "WEBHOOK"
+	IntegrationTypePagerDuty  IntegrationType = "PAGER_DUTY"
+	IntegrationTypeSlack      IntegrationType = "SLACK"
+	IntegrationTypeServiceNow IntegrationType = "SERVICE_NOW"
+)
+
+// IntegrationCategory - the group an integration is listed under by the API
+type IntegrationCategory string
+
+// Integration categories
+const (
+	IntegrationCategoryThirdParty IntegrationCategory = "thirdParty"
+	IntegrationCategoryWebhook    IntegrationCategory = "webhook"
+)
+
+// Validate returns an error if t is not a known integration type.
+func (t IntegrationType) Validate() error {
+	switch t {
+	case IntegrationTypeWebhook, IntegrationTypePagerDuty, IntegrationTypeSlack, IntegrationTypeServiceNow:
+		return nil
+	}
+	return fmt.Errorf("invalid integration type %q", string(t))
+}
+
+// Category returns the category integrations of this type are listed under.
+func (t IntegrationType) Category() IntegrationCategory {
+	if t == IntegrationTypeWebhook {
+		return IntegrationCategoryWebhook
+	}
+	return IntegrationCategoryThirdParty
+}
 
 // Integration - Integration struct
 type Integration struct {
-	AuthMethod      *string `json:"authMethod,omitempty"`
-	AuthUser        *string `json:"authUser,omitempty"`
-	AuthToken       *string `json:"authToken,omitempty"`
-	Channel         *string `json:"channel,omitempty"`
-	IntegrationID   *string `json:"integrationId,omitempty"`
-	IntegrationName *string `json:"integrationName,omitempty"`
-	IntegrationType *string `json:"integrationType,omitempty"`
-	Target          *string `json:"target,omitempty"`
+	AuthMethod      *string          `json:"authMethod,omitempty"`
+	AuthUser        *string          `json:"authUser,omitempty"`
+	AuthToken       *string          `json:"authToken,omitempty"`
+	Channel         *string          `json:"channel,omitempty"`
+	IntegrationID   *string          `json:"integrationId,omitempty"`
+	IntegrationName *string          `json:"integrationName,omitempty"`
+	IntegrationType *IntegrationType `json:"integrationType,omitempty"`
+	Target          *string          `json:"target,omitempty"`
+
+	// Category is set by GetIntegrations from the list the integration
+	// was returned in.  It is not sent to the API.
+	Category IntegrationCategory `json:"-"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetIntegrations - Get third party and webhook integrations
@@ -26,7 +74,122 @@ func (c *Client) GetIntegrations() (*[]Integration, error) {
 		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
 	}
 	var integrations []Integration
-	integrations = append(integrations, target["integrations"]["thirdParty"]...)
-	integrations = append(integrations, target["integrations"]["webhook"]...)
+	for _, category := range []IntegrationCategory{IntegrationCategoryThirdParty, IntegrationCategoryWebhook} {
+		for _, i := range target["integrations"][string(category)] {
+			i.Category = category
+			integrations = append(integrations, i)
+		}
+	}
 	return &integrations, nil
 }
+
+// integrationPath returns the API path for integrations of a category
+func integrationPath(category IntegrationCategory) string {
+	if category == IntegrationCategoryWebhook {
+		return "/integrations/webhook"
+	}
+	return "/integrations/third-party"
+}
+
+// checkIntegrationType checks the type of an integration against the
+// category it is being written to.  Types the SDK does not know are only
+// rejected in strict mode.
+func (c *Client) checkIntegrationType(i Integration, category IntegrationCategory) error {
+	if i.IntegrationType == nil {
+		return fmt.Errorf("integration type is required")
+	}
+	t := *i.IntegrationType
+	if c.StrictEnums {
+		if err := t.Validate(); err != nil {
+			return err
+		}
+	}
+	if t.Category() != category {
+		return fmt.Errorf("integration type %s is not a %s integration", t, category)
+	}
+	return nil
+}
+
+// decodeIntegration decodes a single integration of a category from a response
+func (c *Client) decodeIntegration(resp *http.Response, category IntegrationCategory) (*Integration, error) {
+	var target map[string]map[string][]Integration
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	integrations := target["integrations"][string(category)]
+	if len(integrations) < 1 {
+		return nil, fmt.Errorf("'%s' integration not found in JSON response", category)
+	}
+	integration := integrations[0]
+	integration.Category = category
+	return &integration, nil
+}
+
+func (c *Client) createIntegration(i Integration, category IntegrationCategory) (*Integration, error) {
+	if err := c.checkIntegrationType(i, category); err != nil {
+		return nil, err
+	}
+	resp, err := c.post(integrationPath(category)+"/new", i, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 201 {
+		return nil, fmt.Errorf("failed to create integration, response code %d", resp.StatusCode)
+	}
+	return c.decodeIntegration(resp, category)
+}
+
+func (c *Client) updateIntegration(id string, i Integration, category IntegrationCategory) (*Integration, error) {
+	if err := c.checkIntegrationType(i, category); err != nil {
+		return nil, err
+	}
+	resp, err := c.post(fmt.Sprintf("%s/%s/update", integrationPath(category), id), i, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("failed to update integration, response code %d", resp.StatusCode)
+	}
+	return c.decodeIntegration(resp, category)
+}
+
+func (c *Client) deleteIntegration(id string, category IntegrationCategory) error {
+	resp, err := c.post(fmt.Sprintf("%s/%s/delete", integrationPath(category), id), nil, nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != 204 {
+		return fmt.Errorf("failed to delete integration, response code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// CreateWebhookIntegration - Create webhook integration
+func (c *Client) CreateWebhookIntegration(i Integration) (*Integration, error) {
+	return c.createIntegration(i, IntegrationCategoryWebhook)
+}
+
+// UpdateWebhookIntegration - Update webhook integration
+func (c *Client) UpdateWebhookIntegration(id string, i Integration) (*Integration, error) {
+	return c.updateIntegration(id, i, IntegrationCategoryWebhook)
+}
+
+// DeleteWebhookIntegration - Delete webhook integration
+func (c *Client) DeleteWebhookIntegration(id string) error {
+	return c.deleteIntegration(id, IntegrationCategoryWebhook)
+}
+
+// CreateThirdPartyIntegration - Create third party integration, such as PagerDuty, Slack or ServiceNow
+func (c *Client) CreateThirdPartyIntegration(i Integration) (*Integration, error) {
+	return c.createIntegration(i, IntegrationCategoryThirdParty)
+}
+
+// UpdateThirdPartyIntegration - Update third party integration
+func (c *Client) UpdateThirdPartyIntegration(id string, i Integration) (*Integration, error) {
+	return c.updateIntegration(id, i, IntegrationCategoryThirdParty)
+}
+
+// DeleteThirdPartyIntegration - Delete third party integration
+func (c *Client) DeleteThirdPartyIntegration(id string) error {
+	return c.deleteIntegration(id, IntegrationCategoryThirdParty)
+}
@@ -1,6 +1,7 @@
 package thousandeyes
 
 import (
+	"io"
 	"net/http"
 	"testing"
 
@@ -23,14 +24,16 @@ func TestClient_GetIntegrations(t *testing.T) {
 			AuthMethod:      String("Auth Token"),
 			IntegrationID:   String("pgd-9999"),
 			IntegrationName: String("Test PD Integration"),
-			IntegrationType: String("PAGER_DUTY"),
+			IntegrationType: Ptr(IntegrationTypePagerDuty),
+			Category:        IntegrationCategoryThirdParty,
 		},
 		{
 			AuthMethod:      String("Basic"),
 			IntegrationID:   String("wb-999"),
 			IntegrationName: String("Test Webhook Integration"),
-			IntegrationType: String("WEBHOOK"),
+			IntegrationType: Ptr(IntegrationTypeWebhook),
 			Target:          String("https://thousandeyes.com/"),
+			Category:        IntegrationCategoryWebhook,
 		},
 	}
 
@@ -71,3 +74,86 @@ func TestClient_GetIntegrationsJsonError(t *testing.T) {
 	assert.Error(t, err)
 	assert.EqualError(t, err, "Could not decode JSON response: invalid character 'a' looking for beginning of object key string")
 }
+
+func TestClient_CreateWebhookIntegration(t *testing.T) {
+	out := `{"integrations":{"webhook":[{"integrationId":"wb-1","integrationName":"hook","integrationType":"WEBHOOK","target":"https://example.com/"}]}}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/integrations/webhook/new.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"integrationName":"hook","integrationType":"WEBHOOK","target":"https://example.com/"}`, string(body))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(out))
+	})
+
+	create := Integration{
+		IntegrationName: String("hook"),
+		IntegrationType: Ptr(IntegrationTypeWebhook),
+		Target:          String("https://example.com/"),
+		Category:        IntegrationCategoryWebhook,
+	}
+	res, err := client.CreateWebhookIntegration(create)
+	teardown()
+	assert.Nil(t, err)
+	create.IntegrationID = String("wb-1")
+	assert.Equal(t, &create, res)
+}
+
+func TestClient_UpdateThirdPartyIntegration(t *testing.T) {
+	out := `{"integrations":{"thirdParty":[{"integrationId":"slk-1","integrationName":"alerts","integrationType":"SLACK","channel":"#alerts"}]}}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/integrations/third-party/slk-1/update.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		_, _ = w.Write([]byte(out))
+	})
+
+	update := Integration{IntegrationName: String("alerts"), IntegrationType: Ptr(IntegrationTypeSlack), Channel: String("#alerts")}
+	res, err := client.UpdateThirdPartyIntegration("slk-1", update)
+	teardown()
+	assert.Nil(t, err)
+	expected := Integration{
+		IntegrationID:   String("slk-1"),
+		IntegrationName: String("alerts"),
+		IntegrationType: Ptr(IntegrationTypeSlack),
+		Channel:         String("#alerts"),
+		Category:        IntegrationCategoryThirdParty,
+	}
+	assert.Equal(t, &expected, res)
+}
+
+func TestClient_DeleteIntegrations(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/integrations/webhook/wb-1/delete.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/integrations/third-party/pgd-1/delete.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	assert.Nil(t, client.DeleteWebhookIntegration("wb-1"))
+	assert.EqualError(t, client.DeleteThirdPartyIntegration("pgd-1"), "failed to delete integration, response code 200")
+	teardown()
+}
+
+func TestClient_CreateIntegrationInvalidType(t *testing.T) {
+	_, err := client.CreateWebhookIntegration(Integration{})
+	assert.EqualError(t, err, "integration type is required")
+
+	_, err = client.CreateWebhookIntegration(Integration{IntegrationType: Ptr(IntegrationType("EMAIL"))})
+	assert.EqualError(t, err, "integration type EMAIL is not a webhook integration")
+
+	// Types the SDK does not know are only rejected in strict mode.
+	teams := Integration{IntegrationType: Ptr(IntegrationType("MS_TEAMS"))}
+	assert.Nil(t, client.checkIntegrationType(teams, IntegrationCategoryThirdParty))
+	strict := &Client{StrictEnums: true}
+	_, err = strict.CreateThirdPartyIntegration(teams)
+	assert.EqualError(t, err, `invalid integration type "MS_TEAMS"`)
+
+	_, err = client.CreateThirdPartyIntegration(Integration{IntegrationType: Ptr(IntegrationTypeWebhook)})
+	assert.EqualError(t, err, "integration type WEBHOOK is not a thirdParty integration")
+}
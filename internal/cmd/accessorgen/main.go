@@ -0,0 +1,282 @@
+// Command accessorgen generates nil-safe accessors for the pointer fields
+// of the structs of the thousandeyes package.
+//
+// For a field X of type *T, the generated method GetX returns *X, or the
+// zero value of T if the receiver or the field is nil:
+//
+//   - for strings, numbers, booleans and enums, the dereferenced value
+//   - for *[]T, the slice, which is nil when unset
+//   - for a pointer to a named struct, the pointer itself, so accessors can be
+//     chained: test.GetTargetSIPCredentials().GetPort()
+//
+// Fields of other types are skipped, as are fields whose accessor name is
+// already taken by a field or method.
+//
+// Usage, from the package directory:
+//
+//	go run ./internal/cmd/accessorgen [-o accessors_gen.go]
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// accessor is a generated GetX method
+type accessor struct {
+	Receiver string
+	Type     string
+	Field    string
+	// Result is the result type of the accessor
+	Result string
+	// Zero is returned when the receiver or field is nil, or "" if the
+	// accessor returns the field itself
+	Zero string
+	// Deref is set if the accessor returns the value the field points to
+	Deref bool
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("accessorgen: ")
+	output := flag.String("o", "accessors_gen.go", "output file")
+	flag.Parse()
+
+	accessors, pkg, imports, err := parseAccessors(".", *output)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := generate(pkg, imports, accessors)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parseAccessors type-checks the package in dir and returns the accessors
+// of its exported structs, sorted by type and field, along with the
+// packages their result types import.  Test files are skipped, and
+// accessors in the output file are replaced.
+func parseAccessors(dir, output string) ([]accessor, string, []string, error) {
+	fset := token.NewFileSet()
+	filter := func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}
+	pkgs, err := parser.ParseDir(fset, dir, filter, 0)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, "", nil, fmt.Errorf("expected one package in %s, found %d", dir, len(pkgs))
+	}
+	var name string
+	var files []*ast.File
+	for n, pkg := range pkgs {
+		name = n
+		for _, f := range pkg.Files {
+			files = append(files, f)
+		}
+	}
+	// The package may use the accessors, so the previous output is type
+	// checked too, but errors in it, such as accessors of removed fields,
+	// are ignored.
+	generated := func(pos token.Pos) bool {
+		return filepath.Base(fset.Position(pos).Filename) == filepath.Base(output)
+	}
+	var checkErr error
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error: func(err error) {
+			if e, ok := err.(types.Error); (!ok || !generated(e.Pos)) && checkErr == nil {
+				checkErr = err
+			}
+		},
+	}
+	pkg, _ := conf.Check(name, fset, files, nil)
+	if checkErr != nil {
+		return nil, "", nil, checkErr
+	}
+
+	receivers := receiverNames(files, generated)
+	imports := map[string]bool{}
+	qualifier := func(p *types.Package) string {
+		if p == pkg {
+			return ""
+		}
+		imports[p.Path()] = true
+		return p.Name()
+	}
+	var accessors []accessor
+	scope := pkg.Scope()
+	for _, typeName := range scope.Names() {
+		obj, ok := scope.Lookup(typeName).(*types.TypeName)
+		if !ok || !obj.Exported() || obj.IsAlias() {
+			continue
+		}
+		named, ok := obj.Type().(*types.Named)
+		if !ok {
+			continue
+		}
+		st, ok := named.Underlying().(*types.Struct)
+		if !ok {
+			continue
+		}
+		recv, ok := receivers[typeName]
+		if !ok {
+			recv = string(unicode.ToLower(rune(typeName[0])))
+		}
+		for i := 0; i < st.NumFields(); i++ {
+			f := st.Field(i)
+			if !f.Exported() || f.Embedded() {
+				continue
+			}
+			ptr, ok := f.Type().(*types.Pointer)
+			if !ok {
+				continue
+			}
+			method := "Get" + f.Name()
+			if taken, _, _ := types.LookupFieldOrMethod(types.NewPointer(named), true, pkg, method); taken != nil && !generated(taken.Pos()) {
+				continue
+			}
+			a := accessor{Receiver: recv, Type: typeName, Field: f.Name()}
+			switch elem := ptr.Elem().Underlying().(type) {
+			case *types.Basic:
+				a.Result = types.TypeString(ptr.Elem(), qualifier)
+				a.Zero = basicZero(elem)
+				a.Deref = true
+			case *types.Slice:
+				a.Result = types.TypeString(ptr.Elem(), qualifier)
+				a.Zero = "nil"
+				a.Deref = true
+			case *types.Struct:
+				if _, ok := ptr.Elem().(*types.Named); !ok {
+					continue
+				}
+				a.Result = types.TypeString(ptr, qualifier)
+			default:
+				continue
+			}
+			if a.Zero == "" && a.Deref {
+				continue
+			}
+			accessors = append(accessors, a)
+		}
+	}
+	sort.SliceStable(accessors, func(i, j int) bool {
+		if accessors[i].Type != accessors[j].Type {
+			return accessors[i].Type < accessors[j].Type
+		}
+		return accessors[i].Field < accessors[j].Field
+	})
+	paths := make([]string, 0, len(imports))
+	for path := range imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return accessors, name, paths, nil
+}
+
+// receiverNames returns the receiver name of the first method of each type
+// which has one, so generated methods are consistent with it
+func receiverNames(files []*ast.File, generated func(token.Pos) bool) map[string]string {
+	names := map[string]string{}
+	sort.Slice(files, func(i, j int) bool { return files[i].Name.Pos() < files[j].Name.Pos() })
+	for _, file := range files {
+		if generated(file.Pos()) {
+			continue
+		}
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || len(fn.Recv.List) != 1 || len(fn.Recv.List[0].Names) != 1 {
+				continue
+			}
+			expr := fn.Recv.List[0].Type
+			if star, ok := expr.(*ast.StarExpr); ok {
+				expr = star.X
+			}
+			ident, ok := expr.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			if _, ok := names[ident.Name]; !ok && fn.Recv.List[0].Names[0].Name != "_" {
+				names[ident.Name] = fn.Recv.List[0].Names[0].Name
+			}
+		}
+	}
+	return names
+}
+
+func basicZero(b *types.Basic) string {
+	switch {
+	case b.Info()&types.IsString != 0:
+		return `""`
+	case b.Info()&types.IsNumeric != 0:
+		return "0"
+	case b.Info()&types.IsBoolean != 0:
+		return "false"
+	}
+	return ""
+}
+
+var fileTemplate = template.Must(template.New("file").Parse(`// Code generated by accessorgen; DO NOT EDIT.
+
+package {{.Package}}
+{{if .Imports}}
+import (
+{{- range .Imports}}
+	"{{.}}"
+{{- end}}
+)
+{{end}}{{range .Accessors}}
+{{- if .Deref}}
+// Get{{.Field}} returns the {{.Field}} field if it's non-nil, zero value otherwise.
+func ({{.Receiver}} *{{.Type}}) Get{{.Field}}() {{.Result}} {
+	if {{.Receiver}} == nil || {{.Receiver}}.{{.Field}} == nil {
+		return {{.Zero}}
+	}
+	return *{{.Receiver}}.{{.Field}}
+}
+{{else}}
+// Get{{.Field}} returns the {{.Field}} field, or nil if the receiver is nil.
+func ({{.Receiver}} *{{.Type}}) Get{{.Field}}() {{.Result}} {
+	if {{.Receiver}} == nil {
+		return nil
+	}
+	return {{.Receiver}}.{{.Field}}
+}
+{{end}}{{end}}`))
+
+// generate returns the formatted source of the accessors
+func generate(pkg string, imports []string, accessors []accessor) ([]byte, error) {
+	var buf bytes.Buffer
+	err := fileTemplate.Execute(&buf, struct {
+		Package   string
+		Imports   []string
+		Accessors []accessor
+	}{pkg, imports, accessors})
+	if err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %v\n%s", err, buf.Bytes())
+	}
+	return src, nil
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGeneratedUpToDate fails when the pointer fields of the package have
+// changed without running go generate.
+func TestGeneratedUpToDate(t *testing.T) {
+	dir := filepath.Join("..", "..", "..")
+	accessors, pkg, imports, err := parseAccessors(dir, "accessors_gen.go")
+	assert.Nil(t, err)
+	src, err := generate(pkg, imports, accessors)
+	assert.Nil(t, err)
+
+	current, err := os.ReadFile(filepath.Join(dir, "accessors_gen.go"))
+	assert.Nil(t, err)
+	assert.Equal(t, string(current), string(src), "accessors_gen.go is out of date; run go generate")
+}
+
+func TestParseAccessors(t *testing.T) {
+	accessors, _, imports, err := parseAccessors(filepath.Join("..", "..", ".."), "accessors_gen.go")
+	assert.Nil(t, err)
+	byName := map[string]accessor{}
+	for _, a := range accessors {
+		byName[a.Type+"."+a.Field] = a
+	}
+	assert.Equal(t, accessor{Receiver: "t", Type: "HTTPServer", Field: "URL", Result: "string", Zero: `""`, Deref: true}, byName["HTTPServer.URL"])
+	assert.Equal(t, accessor{Receiver: "t", Type: "HTTPServer", Field: "Protocol", Result: "Protocol", Zero: `""`, Deref: true}, byName["HTTPServer.Protocol"])
+	assert.Equal(t, accessor{Receiver: "t", Type: "HTTPServer", Field: "Agents", Result: "[]Agent", Zero: "nil", Deref: true}, byName["HTTPServer.Agents"])
+	assert.Equal(t, accessor{Receiver: "t", Type: "SIPServer", Field: "TargetSIPCredentials", Result: "*SIPAuthData"}, byName["SIPServer.TargetSIPCredentials"])
+	assert.Contains(t, imports, "time")
+
+	// Anonymous structs and maps get no accessors.
+	assert.NotContains(t, byName, "AuditEventsPage.Pages")
+	assert.NotContains(t, byName, "HTTPServer.Extra")
+}
@@ -0,0 +1,222 @@
+// Command buildergen generates the methods which the test builders of the
+// thousandeyes package have in common.
+//
+// A builder is a struct named XBuilder whose only field, test, is the test
+// being built.  For each builder, buildergen generates a setter for each of
+// the common fields of the test (Description, Interval, Enabled,
+// AlertsEnabled, Agents, AlertRules and Groups), along with With and Build.
+// Constructors and setters of fields particular to a test type are written
+// by hand; methods declared outside the output file are not generated.
+//
+// Usage, from the package directory:
+//
+//	go run ./internal/cmd/buildergen [-o builders_gen.go]
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// setter is a common builder method, generated when the test has Field
+type setter struct {
+	Name  string
+	Field string
+	// Type is the type of the field, without the package qualifier
+	Type   string
+	Doc    string
+	Params string
+	Body   string
+}
+
+// setters are the common builder methods, in the order they are generated
+var setters = []setter{
+	{"Description", "Description", "*string", "set the description of the test", "description string", "b.test.Description = String(description)"},
+	{"Interval", "Interval", "*int", "set how often the test runs, in seconds", "seconds int", "b.test.Interval = Int(seconds)"},
+	{"Enabled", "Enabled", "*bool", "set whether the test runs", "enabled bool", "b.test.Enabled = Bool(enabled)"},
+	{"AlertsEnabled", "AlertsEnabled", "*bool", "set whether the test raises alerts", "enabled bool", "b.test.AlertsEnabled = Bool(enabled)"},
+	{"Agents", "Agents", "*[]Agent", "add the agents with the given IDs to the test", "ids ...int", "addAgents(&b.test.Agents, ids)"},
+	{"AlertRules", "AlertRules", "*[]AlertRule", "add the alert rules with the given IDs to the test", "ids ...int", "addAlertRules(&b.test.AlertRules, ids)"},
+	{"Labels", "Groups", "*[]GroupLabel", "add the test to the labels with the given IDs", "ids ...int64", "addGroups(&b.test.Groups, ids)"},
+}
+
+// builder is a builder type and the common methods generated for it
+type builder struct {
+	Name    string
+	Test    string
+	Setters []setter
+	With    bool
+	Build   bool
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("buildergen: ")
+	output := flag.String("o", "builders_gen.go", "output file")
+	flag.Parse()
+
+	builders, pkg, err := parseBuilders(".", *output)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := generate(pkg, builders)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parseBuilders type-checks the package in dir and returns its builders,
+// sorted by name.  Test files are skipped, and methods in the output file
+// are replaced.
+func parseBuilders(dir, output string) ([]builder, string, error) {
+	fset := token.NewFileSet()
+	filter := func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}
+	pkgs, err := parser.ParseDir(fset, dir, filter, 0)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(pkgs) != 1 {
+		return nil, "", fmt.Errorf("expected one package in %s, found %d", dir, len(pkgs))
+	}
+	var name string
+	var files []*ast.File
+	for n, pkg := range pkgs {
+		name = n
+		for _, f := range pkg.Files {
+			files = append(files, f)
+		}
+	}
+	// Errors in the previous output, such as methods of removed builders,
+	// are ignored.
+	generated := func(pos token.Pos) bool {
+		return filepath.Base(fset.Position(pos).Filename) == filepath.Base(output)
+	}
+	var checkErr error
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error: func(err error) {
+			if e, ok := err.(types.Error); (!ok || !generated(e.Pos)) && checkErr == nil {
+				checkErr = err
+			}
+		},
+	}
+	pkg, _ := conf.Check(name, fset, files, nil)
+	if checkErr != nil {
+		return nil, "", checkErr
+	}
+
+	qualifier := func(p *types.Package) string {
+		if p == pkg {
+			return ""
+		}
+		return p.Name()
+	}
+	// declared reports whether the builder has a method which is not
+	// generated
+	declared := func(named *types.Named, method string) bool {
+		obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(named), true, pkg, method)
+		return obj != nil && !generated(obj.Pos())
+	}
+	var builders []builder
+	scope := pkg.Scope()
+	for _, typeName := range scope.Names() {
+		obj, ok := scope.Lookup(typeName).(*types.TypeName)
+		if !ok || !obj.Exported() || obj.IsAlias() || !strings.HasSuffix(typeName, "Builder") {
+			continue
+		}
+		named, ok := obj.Type().(*types.Named)
+		if !ok {
+			continue
+		}
+		st, ok := named.Underlying().(*types.Struct)
+		if !ok || st.NumFields() != 1 || st.Field(0).Name() != "test" {
+			continue
+		}
+		test, ok := st.Field(0).Type().(*types.Named)
+		if !ok {
+			return nil, "", fmt.Errorf("%s: test is not a named type", typeName)
+		}
+		testStruct, ok := test.Underlying().(*types.Struct)
+		if !ok {
+			return nil, "", fmt.Errorf("%s: test is not a struct", typeName)
+		}
+		b := builder{
+			Name:  typeName,
+			Test:  types.TypeString(test, qualifier),
+			With:  !declared(named, "With"),
+			Build: !declared(named, "Build"),
+		}
+		for _, s := range setters {
+			if declared(named, s.Name) {
+				continue
+			}
+			for i := 0; i < testStruct.NumFields(); i++ {
+				f := testStruct.Field(i)
+				if f.Name() == s.Field && types.TypeString(f.Type(), qualifier) == s.Type {
+					b.Setters = append(b.Setters, s)
+					break
+				}
+			}
+		}
+		builders = append(builders, b)
+	}
+	return builders, name, nil
+}
+
+var fileTemplate = template.Must(template.New("file").Parse(`// Code generated by buildergen; DO NOT EDIT.
+
+package {{.Package}}
+{{range $b := .Builders}}{{range .Setters}}
+// {{.Name}} - {{.Doc}}
+func (b *{{$b.Name}}) {{.Name}}({{.Params}}) *{{$b.Name}} {
+	{{.Body}}
+	return b
+}
+{{end}}{{if .With}}
+// With - call f to set fields of the test which have no builder method
+func (b *{{.Name}}) With(f func(*{{.Test}})) *{{.Name}} {
+	f(&b.test)
+	return b
+}
+{{end}}{{if .Build}}
+// Build - validate the test and return a copy of it.  The builder can be
+// used again, to build similar tests.
+func (b *{{.Name}}) Build() ({{.Test}}, error) {
+	return buildTest(b.test)
+}
+{{end}}{{end}}`))
+
+// generate returns the formatted source of the builder methods
+func generate(pkg string, builders []builder) ([]byte, error) {
+	var buf bytes.Buffer
+	err := fileTemplate.Execute(&buf, struct {
+		Package  string
+		Builders []builder
+	}{pkg, builders})
+	if err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %v\n%s", err, buf.Bytes())
+	}
+	return src, nil
+}
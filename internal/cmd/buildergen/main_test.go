@@ -0,0 +1,47 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGeneratedUpToDate fails when the builders of the package have changed
+// without running go generate.
+func TestGeneratedUpToDate(t *testing.T) {
+	dir := filepath.Join("..", "..", "..")
+	builders, pkg, err := parseBuilders(dir, "builders_gen.go")
+	assert.Nil(t, err)
+	src, err := generate(pkg, builders)
+	assert.Nil(t, err)
+
+	current, err := os.ReadFile(filepath.Join(dir, "builders_gen.go"))
+	assert.Nil(t, err)
+	assert.Equal(t, string(current), string(src), "builders_gen.go is out of date; run go generate")
+}
+
+func TestParseBuilders(t *testing.T) {
+	builders, _, err := parseBuilders(filepath.Join("..", "..", ".."), "builders_gen.go")
+	assert.Nil(t, err)
+	byName := map[string]builder{}
+	for _, b := range builders {
+		byName[b.Name] = b
+	}
+	names := func(b builder) []string {
+		var names []string
+		for _, s := range b.Setters {
+			names = append(names, s.Name)
+		}
+		return names
+	}
+	http := byName["HTTPServerBuilder"]
+	assert.Equal(t, "HTTPServer", http.Test)
+	assert.Equal(t, []string{"Description", "Interval", "Enabled", "AlertsEnabled", "Agents", "AlertRules", "Labels"}, names(http))
+	assert.True(t, http.With)
+	assert.True(t, http.Build)
+
+	// BGP tests have no interval or agents.
+	assert.Equal(t, []string{"Description", "Enabled", "AlertsEnabled", "AlertRules", "Labels"}, names(byName["BGPBuilder"]))
+}
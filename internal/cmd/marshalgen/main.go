@@ -0,0 +1,381 @@
+// Command marshalgen generates the JSON marshalers of the thousandeyes
+// package.
+//
+// The ThousandEyes API encodes many booleans as the integers 0 and 1.
+// Struct fields holding them are declared as *bool and tagged
+// te:"int-bool".  For every struct with such fields, marshalgen writes a
+// MarshalJSON and UnmarshalJSON method which shadow those fields with the
+// package's intBool type, so the struct is encoded in a single pass.
+//
+// Structs may also declare a field
+//
+//	Extra map[string]json.RawMessage `json:"-"`
+//
+// in which the generated UnmarshalJSON keeps the members of the JSON
+// object that no field is decoded from.  MarshalJSON encodes them again,
+// so fields the SDK does not model survive a read-modify-write.  Keys
+// listed in a te:"ignore=key1,key2" tag on Extra are dropped instead.
+// Keys are matched case-insensitively, like encoding/json matches them.
+//
+// A struct may declare optional hooks which the generated methods call:
+//
+//	func (t *T) beforeMarshalJSON()                              // on a copy of the value
+//	func (t T) afterMarshalJSON(data []byte) ([]byte, error)     // on the encoded JSON
+//	func (t *T) beforeUnmarshalJSON(data []byte) ([]byte, error) // on the JSON to decode
+//	func (t *T) afterUnmarshalJSON()                             // on the decoded value
+//
+// Usage, from the package directory:
+//
+//	go run ./internal/cmd/marshalgen [-o marshal_gen.go]
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"log"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// field is a struct field tagged te:"int-bool"
+type field struct {
+	Name string
+	Tag  string
+}
+
+// structType is a struct with int-bool fields or an Extra field
+type structType struct {
+	Name     string
+	Receiver string
+	Fields   []field
+	// Keys are the lower case JSON keys of all the fields of the struct,
+	// and the keys it ignores, set if it has an Extra field
+	Keys    []string
+	Before  bool
+	After   bool
+	Decode  bool
+	Decoded bool
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("marshalgen: ")
+	output := flag.String("o", "marshal_gen.go", "output file")
+	flag.Parse()
+
+	types, pkg, err := parseTypes(".", *output)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := generate(pkg, types)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parseTypes returns the structs of the package in dir which have int-bool
+// fields, sorted by name.  Test files and the output file are skipped.
+func parseTypes(dir, output string) ([]*structType, string, error) {
+	fset := token.NewFileSet()
+	filter := func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != output
+	}
+	pkgs, err := parser.ParseDir(fset, dir, filter, 0)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(pkgs) != 1 {
+		return nil, "", fmt.Errorf("expected one package in %s, found %d", dir, len(pkgs))
+	}
+
+	var pkgName string
+	byName := map[string]*structType{}
+	receivers := map[string]string{}
+	hooks := map[string]map[string]bool{}
+	for name, pkg := range pkgs {
+		pkgName = name
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				switch d := decl.(type) {
+				case *ast.GenDecl:
+					if err := collectStructs(fset, d, byName); err != nil {
+						return nil, "", err
+					}
+				case *ast.FuncDecl:
+					if d.Recv == nil || len(d.Recv.List) != 1 {
+						continue
+					}
+					recv := d.Recv.List[0]
+					typeName := receiverType(recv.Type)
+					if _, ok := receivers[typeName]; !ok && len(recv.Names) == 1 {
+						receivers[typeName] = recv.Names[0].Name
+					}
+					if hooks[typeName] == nil {
+						hooks[typeName] = map[string]bool{}
+					}
+					hooks[typeName][d.Name.Name] = true
+				}
+			}
+		}
+	}
+
+	var types []*structType
+	for name, t := range byName {
+		t.Receiver = "t"
+		if r, ok := receivers[name]; ok && r != "_" {
+			t.Receiver = r
+		}
+		t.Before = hooks[name]["beforeMarshalJSON"]
+		t.After = hooks[name]["afterMarshalJSON"]
+		t.Decode = hooks[name]["beforeUnmarshalJSON"]
+		t.Decoded = hooks[name]["afterUnmarshalJSON"]
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i].Name < types[j].Name })
+	return types, pkgName, nil
+}
+
+// collectStructs adds the structs declared by d which have int-bool fields
+// or an Extra field
+func collectStructs(fset *token.FileSet, d *ast.GenDecl, byName map[string]*structType) error {
+	for _, spec := range d.Specs {
+		ts, ok := spec.(*ast.TypeSpec)
+		if !ok {
+			continue
+		}
+		st, ok := ts.Type.(*ast.StructType)
+		if !ok {
+			continue
+		}
+		var fields []field
+		var keys []string
+		hasExtra, embedded := false, false
+		for _, f := range st.Fields.List {
+			var tag reflect.StructTag
+			if f.Tag != nil {
+				tagValue, err := strconv.Unquote(f.Tag.Value)
+				if err != nil {
+					return err
+				}
+				tag = reflect.StructTag(tagValue)
+			}
+			if len(f.Names) == 0 {
+				embedded = true
+			}
+			for _, name := range f.Names {
+				if name.Name == "Extra" {
+					if !isRawMessageMap(f.Type) || tag.Get("json") != "-" {
+						return fmt.Errorf("%s: Extra field of %s must be a map[string]json.RawMessage tagged json:\"-\"", fset.Position(f.Pos()), ts.Name.Name)
+					}
+					hasExtra = true
+					if ignore := strings.TrimPrefix(tag.Get("te"), "ignore="); ignore != tag.Get("te") {
+						for _, key := range strings.Split(ignore, ",") {
+							keys = append(keys, strings.ToLower(key))
+						}
+					}
+					continue
+				}
+				if key := jsonKey(name.Name, tag); key != "" && name.IsExported() {
+					keys = append(keys, strings.ToLower(key))
+				}
+			}
+			if tag.Get("te") != "int-bool" {
+				continue
+			}
+			if !isBoolPointer(f.Type) || len(f.Names) != 1 {
+				return fmt.Errorf("%s: int-bool field of %s must be a single named *bool", fset.Position(f.Pos()), ts.Name.Name)
+			}
+			fields = append(fields, field{Name: f.Names[0].Name, Tag: tag.Get("json")})
+		}
+		if hasExtra && embedded {
+			return fmt.Errorf("%s: %s has an Extra field and embedded fields, which are not supported", fset.Position(ts.Pos()), ts.Name.Name)
+		}
+		if len(fields) > 0 || hasExtra {
+			t := &structType{Name: ts.Name.Name, Fields: fields}
+			if hasExtra {
+				sort.Strings(keys)
+				t.Keys = keys
+			}
+			byName[ts.Name.Name] = t
+		}
+	}
+	return nil
+}
+
+func isBoolPointer(expr ast.Expr) bool {
+	star, ok := expr.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := star.X.(*ast.Ident)
+	return ok && ident.Name == "bool"
+}
+
+func isRawMessageMap(expr ast.Expr) bool {
+	m, ok := expr.(*ast.MapType)
+	if !ok {
+		return false
+	}
+	key, ok := m.Key.(*ast.Ident)
+	if !ok || key.Name != "string" {
+		return false
+	}
+	value, ok := m.Value.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	pkg, ok := value.X.(*ast.Ident)
+	return ok && pkg.Name == "json" && value.Sel.Name == "RawMessage"
+}
+
+// jsonKey returns the key encoding/json uses for a field, or "" if the
+// field is not encoded
+func jsonKey(name string, tag reflect.StructTag) string {
+	key := strings.Split(tag.Get("json"), ",")[0]
+	switch key {
+	case "-":
+		return ""
+	case "":
+		return name
+	}
+	return key
+}
+
+func receiverType(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+var fileTemplate = template.Must(template.New("file").Parse(`// Code generated by marshalgen; DO NOT EDIT.
+
+package {{.Package}}
+
+import "encoding/json"
+{{range .Types}}{{$r := .Receiver}}{{$t := .}}
+{{- if .Keys}}
+// jsonFields{{.Name}} are the lower case JSON keys decoded into the
+// fields of {{.Name}}, or ignored
+var jsonFields{{.Name}} = map[string]bool{
+{{- range .Keys}}
+	"{{.}}": true,
+{{- end}}
+}
+{{end}}
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans{{if .Keys}}, and encodes the Extra fields{{end}}.
+func ({{$r}} {{.Name}}) MarshalJSON() ([]byte, error) {
+	type alias {{.Name}}
+{{if .Before}}
+	{{$r}}.beforeMarshalJSON()
+{{end}}
+	{{if or .After .Keys}}data, err :={{else}}return{{end}} json.Marshal(
+{{- if .Fields}}struct {
+		alias
+{{- range .Fields}}
+		{{.Name}} *intBool ` + "`json:\"{{.Tag}}\"`" + `
+{{- end}}
+	}{
+		alias: alias({{$r}}),
+{{- range .Fields}}
+		{{.Name}}: (*intBool)({{$r}}.{{.Name}}),
+{{- end}}
+	}
+{{- else}}alias({{$r}}){{end}})
+{{- if or .After .Keys}}
+	if err != nil {
+		return nil, err
+	}
+{{- end}}
+{{- if .Keys}}
+	{{if .After}}if data, err = appendExtraFields(data, {{$r}}.Extra, jsonFields{{.Name}}); err != nil {
+		return nil, err
+	}
+	return {{$r}}.afterMarshalJSON(data){{else}}return appendExtraFields(data, {{$r}}.Extra, jsonFields{{.Name}}){{end}}
+{{- else if .After}}
+	return {{$r}}.afterMarshalJSON(data)
+{{- end}}
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans{{if .Keys}}, and keeps unknown fields in Extra{{end}}.
+func ({{$r}} *{{.Name}}) UnmarshalJSON(data []byte) error {
+	type alias {{.Name}}
+{{if .Decode}}
+	data, err := {{$r}}.beforeUnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+{{end}}
+{{- if .Fields}}
+	aux := struct {
+		*alias
+{{- range .Fields}}
+		{{.Name}} *intBool ` + "`json:\"{{.Tag}}\"`" + `
+{{- end}}
+	}{
+		alias: (*alias)({{$r}}),
+{{- range .Fields}}
+		{{.Name}}: (*intBool)({{$r}}.{{.Name}}),
+{{- end}}
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+{{- range .Fields}}
+	{{$r}}.{{.Name}} = (*bool)(aux.{{.Name}})
+{{- end}}
+{{- else}}
+	if err := json.Unmarshal(data, (*alias)({{$r}})); err != nil {
+		return err
+	}
+{{- end}}
+{{- if .Keys}}
+	extra, err := unknownFields(data, jsonFields{{.Name}})
+	if err != nil {
+		return err
+	}
+	{{$r}}.Extra = extra
+{{- end}}
+{{- if .Decoded}}
+	{{$r}}.afterUnmarshalJSON()
+{{- end}}
+	return nil
+}
+{{end}}`))
+
+// generate returns the formatted source of the marshalers of types
+func generate(pkg string, types []*structType) ([]byte, error) {
+	var buf bytes.Buffer
+	err := fileTemplate.Execute(&buf, struct {
+		Package string
+		Types   []*structType
+	}{pkg, types})
+	if err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %v\n%s", err, buf.Bytes())
+	}
+	return src, nil
+}
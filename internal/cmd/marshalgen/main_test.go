@@ -0,0 +1,46 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGeneratedUpToDate fails when the int-bool fields of the package have
+// changed without running go generate.
+func TestGeneratedUpToDate(t *testing.T) {
+	dir := filepath.Join("..", "..", "..")
+	types, pkg, err := parseTypes(dir, "marshal_gen.go")
+	assert.Nil(t, err)
+	src, err := generate(pkg, types)
+	assert.Nil(t, err)
+
+	current, err := os.ReadFile(filepath.Join(dir, "marshal_gen.go"))
+	assert.Nil(t, err)
+	assert.Equal(t, string(current), string(src), "marshal_gen.go is out of date; run go generate")
+}
+
+func TestParseTypesHooks(t *testing.T) {
+	types, _, err := parseTypes(filepath.Join("..", "..", ".."), "marshal_gen.go")
+	assert.Nil(t, err)
+	byName := map[string]*structType{}
+	for _, st := range types {
+		byName[st.Name] = st
+	}
+	assert.True(t, byName["RTPStream"].Before)
+	assert.True(t, byName["GroupLabel"].After)
+	assert.True(t, byName["GroupLabel"].Decode)
+	assert.True(t, byName["RTPStream"].Decoded)
+	assert.False(t, byName["HTTPServer"].Before)
+	assert.Equal(t, []field{{Name: "IsManagementPermission", Tag: "isManagementPermission"}}, byName["Permission"].Fields)
+	assert.Equal(t, []string{"ismanagementpermission", "label", "permissionid"}, byName["Permission"].Keys)
+
+	// Structs with an Extra field know all their keys, in lower case,
+	// including ignored ones.
+	assert.Nil(t, byName["Dashboard"].Fields)
+	assert.Contains(t, byName["Dashboard"].Keys, "dashboardid")
+	assert.NotContains(t, byName["Dashboard"].Keys, "extra")
+	assert.Contains(t, byName["RTPStream"].Keys, "server")
+}
@@ -0,0 +1,3738 @@
+// Code generated by marshalgen; DO NOT EDIT.
+
+package thousandeyes
+
+import "encoding/json"
+
+// jsonFieldsAPILink are the lower case JSON keys decoded into the
+// fields of APILink, or ignored
+var jsonFieldsAPILink = map[string]bool{
+	"href": true,
+	"rel":  true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (a APILink) MarshalJSON() ([]byte, error) {
+	type alias APILink
+
+	data, err := json.Marshal(alias(a))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, a.Extra, jsonFieldsAPILink)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (a *APILink) UnmarshalJSON(data []byte) error {
+	type alias APILink
+
+	if err := json.Unmarshal(data, (*alias)(a)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsAPILink)
+	if err != nil {
+		return err
+	}
+	a.Extra = extra
+	return nil
+}
+
+// jsonFieldsAccountGroup are the lower case JSON keys decoded into the
+// fields of AccountGroup, or ignored
+var jsonFieldsAccountGroup = map[string]bool{
+	"accountgroupname": true,
+	"agents":           true,
+	"aid":              true,
+	"current":          true,
+	"default":          true,
+	"organizationname": true,
+	"users":            true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (t AccountGroup) MarshalJSON() ([]byte, error) {
+	type alias AccountGroup
+
+	data, err := json.Marshal(struct {
+		alias
+		Current *intBool `json:"current,omitempty"`
+		Default *intBool `json:"default,omitempty"`
+	}{
+		alias:   alias(t),
+		Current: (*intBool)(t.Current),
+		Default: (*intBool)(t.Default),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, t.Extra, jsonFieldsAccountGroup)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (t *AccountGroup) UnmarshalJSON(data []byte) error {
+	type alias AccountGroup
+
+	aux := struct {
+		*alias
+		Current *intBool `json:"current,omitempty"`
+		Default *intBool `json:"default,omitempty"`
+	}{
+		alias:   (*alias)(t),
+		Current: (*intBool)(t.Current),
+		Default: (*intBool)(t.Default),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.Current = (*bool)(aux.Current)
+	t.Default = (*bool)(aux.Default)
+	extra, err := unknownFields(data, jsonFieldsAccountGroup)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
+
+// jsonFieldsAccountGroupRole are the lower case JSON keys decoded into the
+// fields of AccountGroupRole, or ignored
+var jsonFieldsAccountGroupRole = map[string]bool{
+	"builtin":                  true,
+	"hasmanagementpermissions": true,
+	"permissions":              true,
+	"roleid":                   true,
+	"rolename":                 true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (t AccountGroupRole) MarshalJSON() ([]byte, error) {
+	type alias AccountGroupRole
+
+	data, err := json.Marshal(struct {
+		alias
+		HasManagementPermissions *intBool `json:"hasManagementPermissions,omitempty"`
+		Builtin                  *intBool `json:"builtin,omitempty"`
+	}{
+		alias:                    alias(t),
+		HasManagementPermissions: (*intBool)(t.HasManagementPermissions),
+		Builtin:                  (*intBool)(t.Builtin),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, t.Extra, jsonFieldsAccountGroupRole)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (t *AccountGroupRole) UnmarshalJSON(data []byte) error {
+	type alias AccountGroupRole
+
+	aux := struct {
+		*alias
+		HasManagementPermissions *intBool `json:"hasManagementPermissions,omitempty"`
+		Builtin                  *intBool `json:"builtin,omitempty"`
+	}{
+		alias:                    (*alias)(t),
+		HasManagementPermissions: (*intBool)(t.HasManagementPermissions),
+		Builtin:                  (*intBool)(t.Builtin),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.HasManagementPermissions = (*bool)(aux.HasManagementPermissions)
+	t.Builtin = (*bool)(aux.Builtin)
+	extra, err := unknownFields(data, jsonFieldsAccountGroupRole)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
+
+// jsonFieldsAccountGroupUser are the lower case JSON keys decoded into the
+// fields of AccountGroupUser, or ignored
+var jsonFieldsAccountGroupUser = map[string]bool{
+	"email": true,
+	"name":  true,
+	"roles": true,
+	"uid":   true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (a AccountGroupUser) MarshalJSON() ([]byte, error) {
+	type alias AccountGroupUser
+
+	data, err := json.Marshal(alias(a))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, a.Extra, jsonFieldsAccountGroupUser)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (a *AccountGroupUser) UnmarshalJSON(data []byte) error {
+	type alias AccountGroupUser
+
+	if err := json.Unmarshal(data, (*alias)(a)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsAccountGroupUser)
+	if err != nil {
+		return err
+	}
+	a.Extra = extra
+	return nil
+}
+
+// jsonFieldsAgent are the lower case JSON keys decoded into the
+// fields of Agent, or ignored
+var jsonFieldsAgent = map[string]bool{
+	"agentid":              true,
+	"agentname":            true,
+	"agentstate":           true,
+	"agenttype":            true,
+	"clustermembers":       true,
+	"countryid":            true,
+	"createddate":          true,
+	"enabled":              true,
+	"errordetails":         true,
+	"groups":               true,
+	"hostname":             true,
+	"ipaddresses":          true,
+	"ipv6policy":           true,
+	"keepbrowsercache":     true,
+	"lastseen":             true,
+	"location":             true,
+	"network":              true,
+	"prefix":               true,
+	"targetfortests":       true,
+	"utilization":          true,
+	"verifysslcertificate": true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (t Agent) MarshalJSON() ([]byte, error) {
+	type alias Agent
+
+	data, err := json.Marshal(struct {
+		alias
+		Enabled               *intBool `json:"enabled,omitempty"`
+		VerifySslCertificates *intBool `json:"verifySslCertificate,omitempty"`
+		KeepBrowserCache      *intBool `json:"keepBrowserCache,omitempty"`
+	}{
+		alias:                 alias(t),
+		Enabled:               (*intBool)(t.Enabled),
+		VerifySslCertificates: (*intBool)(t.VerifySslCertificates),
+		KeepBrowserCache:      (*intBool)(t.KeepBrowserCache),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, t.Extra, jsonFieldsAgent)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (t *Agent) UnmarshalJSON(data []byte) error {
+	type alias Agent
+
+	aux := struct {
+		*alias
+		Enabled               *intBool `json:"enabled,omitempty"`
+		VerifySslCertificates *intBool `json:"verifySslCertificate,omitempty"`
+		KeepBrowserCache      *intBool `json:"keepBrowserCache,omitempty"`
+	}{
+		alias:                 (*alias)(t),
+		Enabled:               (*intBool)(t.Enabled),
+		VerifySslCertificates: (*intBool)(t.VerifySslCertificates),
+		KeepBrowserCache:      (*intBool)(t.KeepBrowserCache),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.Enabled = (*bool)(aux.Enabled)
+	t.VerifySslCertificates = (*bool)(aux.VerifySslCertificates)
+	t.KeepBrowserCache = (*bool)(aux.KeepBrowserCache)
+	extra, err := unknownFields(data, jsonFieldsAgent)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
+
+// jsonFieldsAgentAgent are the lower case JSON keys decoded into the
+// fields of AgentAgent, or ignored
+var jsonFieldsAgentAgent = map[string]bool{
+	"agents":                 true,
+	"alertrules":             true,
+	"alertsenabled":          true,
+	"apilinks":               true,
+	"bgpmeasurements":        true,
+	"bgpmonitors":            true,
+	"createdby":              true,
+	"createddate":            true,
+	"description":            true,
+	"direction":              true,
+	"dscp":                   true,
+	"dscpid":                 true,
+	"enabled":                true,
+	"groups":                 true,
+	"interval":               true,
+	"liveshare":              true,
+	"modifiedby":             true,
+	"modifieddate":           true,
+	"mss":                    true,
+	"mtumeasurements":        true,
+	"networkmeasurements":    true,
+	"numpathtraces":          true,
+	"pathtracemode":          true,
+	"port":                   true,
+	"protocol":               true,
+	"savedevent":             true,
+	"sharedwithaccounts":     true,
+	"targetagentid":          true,
+	"testid":                 true,
+	"testname":               true,
+	"throughputduration":     true,
+	"throughputmeasurements": true,
+	"throughputrate":         true,
+	"type":                   true,
+	"usepublicbgp":           true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (t AgentAgent) MarshalJSON() ([]byte, error) {
+	type alias AgentAgent
+
+	t.beforeMarshalJSON()
+
+	data, err := json.Marshal(struct {
+		alias
+		AlertsEnabled          *intBool `json:"alertsEnabled,omitempty"`
+		Enabled                *intBool `json:"enabled,omitempty"`
+		SavedEvent             *intBool `json:"savedEvent,omitempty"`
+		LiveShare              *intBool `json:"liveShare,omitempty"`
+		BGPMeasurements        *intBool `json:"bgpMeasurements,omitempty"`
+		NetworkMeasurements    *intBool `json:"networkMeasurements,omitempty"`
+		MTUMeasurements        *intBool `json:"mtuMeasurements,omitempty"`
+		ThroughputMeasurements *intBool `json:"throughputMeasurements,omitempty"`
+		UsePublicBGP           *intBool `json:"usePublicBgp,omitempty"`
+	}{
+		alias:                  alias(t),
+		AlertsEnabled:          (*intBool)(t.AlertsEnabled),
+		Enabled:                (*intBool)(t.Enabled),
+		SavedEvent:             (*intBool)(t.SavedEvent),
+		LiveShare:              (*intBool)(t.LiveShare),
+		BGPMeasurements:        (*intBool)(t.BGPMeasurements),
+		NetworkMeasurements:    (*intBool)(t.NetworkMeasurements),
+		MTUMeasurements:        (*intBool)(t.MTUMeasurements),
+		ThroughputMeasurements: (*intBool)(t.ThroughputMeasurements),
+		UsePublicBGP:           (*intBool)(t.UsePublicBGP),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, t.Extra, jsonFieldsAgentAgent)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (t *AgentAgent) UnmarshalJSON(data []byte) error {
+	type alias AgentAgent
+
+	aux := struct {
+		*alias
+		AlertsEnabled          *intBool `json:"alertsEnabled,omitempty"`
+		Enabled                *intBool `json:"enabled,omitempty"`
+		SavedEvent             *intBool `json:"savedEvent,omitempty"`
+		LiveShare              *intBool `json:"liveShare,omitempty"`
+		BGPMeasurements        *intBool `json:"bgpMeasurements,omitempty"`
+		NetworkMeasurements    *intBool `json:"networkMeasurements,omitempty"`
+		MTUMeasurements        *intBool `json:"mtuMeasurements,omitempty"`
+		ThroughputMeasurements *intBool `json:"throughputMeasurements,omitempty"`
+		UsePublicBGP           *intBool `json:"usePublicBgp,omitempty"`
+	}{
+		alias:                  (*alias)(t),
+		AlertsEnabled:          (*intBool)(t.AlertsEnabled),
+		Enabled:                (*intBool)(t.Enabled),
+		SavedEvent:             (*intBool)(t.SavedEvent),
+		LiveShare:              (*intBool)(t.LiveShare),
+		BGPMeasurements:        (*intBool)(t.BGPMeasurements),
+		NetworkMeasurements:    (*intBool)(t.NetworkMeasurements),
+		MTUMeasurements:        (*intBool)(t.MTUMeasurements),
+		ThroughputMeasurements: (*intBool)(t.ThroughputMeasurements),
+		UsePublicBGP:           (*intBool)(t.UsePublicBGP),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.AlertsEnabled = (*bool)(aux.AlertsEnabled)
+	t.Enabled = (*bool)(aux.Enabled)
+	t.SavedEvent = (*bool)(aux.SavedEvent)
+	t.LiveShare = (*bool)(aux.LiveShare)
+	t.BGPMeasurements = (*bool)(aux.BGPMeasurements)
+	t.NetworkMeasurements = (*bool)(aux.NetworkMeasurements)
+	t.MTUMeasurements = (*bool)(aux.MTUMeasurements)
+	t.ThroughputMeasurements = (*bool)(aux.ThroughputMeasurements)
+	t.UsePublicBGP = (*bool)(aux.UsePublicBGP)
+	extra, err := unknownFields(data, jsonFieldsAgentAgent)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	t.afterUnmarshalJSON()
+	return nil
+}
+
+// jsonFieldsAgentErrorDetails are the lower case JSON keys decoded into the
+// fields of AgentErrorDetails, or ignored
+var jsonFieldsAgentErrorDetails = map[string]bool{
+	"code":        true,
+	"description": true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (a AgentErrorDetails) MarshalJSON() ([]byte, error) {
+	type alias AgentErrorDetails
+
+	data, err := json.Marshal(alias(a))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, a.Extra, jsonFieldsAgentErrorDetails)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (a *AgentErrorDetails) UnmarshalJSON(data []byte) error {
+	type alias AgentErrorDetails
+
+	if err := json.Unmarshal(data, (*alias)(a)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsAgentErrorDetails)
+	if err != nil {
+		return err
+	}
+	a.Extra = extra
+	return nil
+}
+
+// jsonFieldsAgentServer are the lower case JSON keys decoded into the
+// fields of AgentServer, or ignored
+var jsonFieldsAgentServer = map[string]bool{
+	"agents":                true,
+	"alertrules":            true,
+	"alertsenabled":         true,
+	"apilinks":              true,
+	"bandwidthmeasurements": true,
+	"bgpmeasurements":       true,
+	"bgpmonitors":           true,
+	"createdby":             true,
+	"createddate":           true,
+	"description":           true,
+	"enabled":               true,
+	"groups":                true,
+	"interval":              true,
+	"liveshare":             true,
+	"modifiedby":            true,
+	"modifieddate":          true,
+	"mtumeasurements":       true,
+	"networkmeasurements":   true,
+	"numpathtraces":         true,
+	"pathtracemode":         true,
+	"port":                  true,
+	"probemode":             true,
+	"protocol":              true,
+	"savedevent":            true,
+	"server":                true,
+	"sharedwithaccounts":    true,
+	"testid":                true,
+	"testname":              true,
+	"type":                  true,
+	"usepublicbgp":          true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (t AgentServer) MarshalJSON() ([]byte, error) {
+	type alias AgentServer
+
+	data, err := json.Marshal(struct {
+		alias
+		AlertsEnabled         *intBool `json:"alertsEnabled,omitempty"`
+		Enabled               *intBool `json:"enabled,omitempty"`
+		SavedEvent            *intBool `json:"savedEvent,omitempty"`
+		LiveShare             *intBool `json:"liveShare,omitempty"`
+		BandwidthMeasurements *intBool `json:"bandwidthMeasurements,omitempty"`
+		BGPMeasurements       *intBool `json:"bgpMeasurements,omitempty"`
+		MTUMeasurements       *intBool `json:"mtuMeasurements,omitempty"`
+		NetworkMeasurements   *intBool `json:"networkMeasurements,omitempty"`
+		UsePublicBGP          *intBool `json:"usePublicBgp,omitempty"`
+	}{
+		alias:                 alias(t),
+		AlertsEnabled:         (*intBool)(t.AlertsEnabled),
+		Enabled:               (*intBool)(t.Enabled),
+		SavedEvent:            (*intBool)(t.SavedEvent),
+		LiveShare:             (*intBool)(t.LiveShare),
+		BandwidthMeasurements: (*intBool)(t.BandwidthMeasurements),
+		BGPMeasurements:       (*intBool)(t.BGPMeasurements),
+		MTUMeasurements:       (*intBool)(t.MTUMeasurements),
+		NetworkMeasurements:   (*intBool)(t.NetworkMeasurements),
+		UsePublicBGP:          (*intBool)(t.UsePublicBGP),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, t.Extra, jsonFieldsAgentServer)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (t *AgentServer) UnmarshalJSON(data []byte) error {
+	type alias AgentServer
+
+	aux := struct {
+		*alias
+		AlertsEnabled         *intBool `json:"alertsEnabled,omitempty"`
+		Enabled               *intBool `json:"enabled,omitempty"`
+		SavedEvent            *intBool `json:"savedEvent,omitempty"`
+		LiveShare             *intBool `json:"liveShare,omitempty"`
+		BandwidthMeasurements *intBool `json:"bandwidthMeasurements,omitempty"`
+		BGPMeasurements       *intBool `json:"bgpMeasurements,omitempty"`
+		MTUMeasurements       *intBool `json:"mtuMeasurements,omitempty"`
+		NetworkMeasurements   *intBool `json:"networkMeasurements,omitempty"`
+		UsePublicBGP          *intBool `json:"usePublicBgp,omitempty"`
+	}{
+		alias:                 (*alias)(t),
+		AlertsEnabled:         (*intBool)(t.AlertsEnabled),
+		Enabled:               (*intBool)(t.Enabled),
+		SavedEvent:            (*intBool)(t.SavedEvent),
+		LiveShare:             (*intBool)(t.LiveShare),
+		BandwidthMeasurements: (*intBool)(t.BandwidthMeasurements),
+		BGPMeasurements:       (*intBool)(t.BGPMeasurements),
+		MTUMeasurements:       (*intBool)(t.MTUMeasurements),
+		NetworkMeasurements:   (*intBool)(t.NetworkMeasurements),
+		UsePublicBGP:          (*intBool)(t.UsePublicBGP),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.AlertsEnabled = (*bool)(aux.AlertsEnabled)
+	t.Enabled = (*bool)(aux.Enabled)
+	t.SavedEvent = (*bool)(aux.SavedEvent)
+	t.LiveShare = (*bool)(aux.LiveShare)
+	t.BandwidthMeasurements = (*bool)(aux.BandwidthMeasurements)
+	t.BGPMeasurements = (*bool)(aux.BGPMeasurements)
+	t.MTUMeasurements = (*bool)(aux.MTUMeasurements)
+	t.NetworkMeasurements = (*bool)(aux.NetworkMeasurements)
+	t.UsePublicBGP = (*bool)(aux.UsePublicBGP)
+	extra, err := unknownFields(data, jsonFieldsAgentServer)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
+
+// jsonFieldsAlert are the lower case JSON keys decoded into the
+// fields of Alert, or ignored
+var jsonFieldsAlert = map[string]bool{
+	"active":         true,
+	"agents":         true,
+	"alertid":        true,
+	"apilinks":       true,
+	"dateend":        true,
+	"datestart":      true,
+	"monitors":       true,
+	"permalink":      true,
+	"ruleexpression": true,
+	"rulename":       true,
+	"testid":         true,
+	"testname":       true,
+	"type":           true,
+	"violationcount": true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (a Alert) MarshalJSON() ([]byte, error) {
+	type alias Alert
+
+	data, err := json.Marshal(alias(a))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, a.Extra, jsonFieldsAlert)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (a *Alert) UnmarshalJSON(data []byte) error {
+	type alias Alert
+
+	if err := json.Unmarshal(data, (*alias)(a)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsAlert)
+	if err != nil {
+		return err
+	}
+	a.Extra = extra
+	return nil
+}
+
+// jsonFieldsAlertRule are the lower case JSON keys decoded into the
+// fields of AlertRule, or ignored
+var jsonFieldsAlertRule = map[string]bool{
+	"alertruleid":             true,
+	"alerttype":               true,
+	"default":                 true,
+	"direction":               true,
+	"expression":              true,
+	"includecoveredprefixes":  true,
+	"minimumsources":          true,
+	"minimumsourcespct":       true,
+	"notifications":           true,
+	"notifyonclear":           true,
+	"roundsviolatingmode":     true,
+	"roundsviolatingoutof":    true,
+	"roundsviolatingrequired": true,
+	"ruleid":                  true,
+	"rulename":                true,
+	"testids":                 true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (t AlertRule) MarshalJSON() ([]byte, error) {
+	type alias AlertRule
+
+	data, err := json.Marshal(struct {
+		alias
+		Default       *intBool `json:"default,omitempty"`
+		NotifyOnClear *intBool `json:"notifyOnClear,omitempty"`
+	}{
+		alias:         alias(t),
+		Default:       (*intBool)(t.Default),
+		NotifyOnClear: (*intBool)(t.NotifyOnClear),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, t.Extra, jsonFieldsAlertRule)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (t *AlertRule) UnmarshalJSON(data []byte) error {
+	type alias AlertRule
+
+	aux := struct {
+		*alias
+		Default       *intBool `json:"default,omitempty"`
+		NotifyOnClear *intBool `json:"notifyOnClear,omitempty"`
+	}{
+		alias:         (*alias)(t),
+		Default:       (*intBool)(t.Default),
+		NotifyOnClear: (*intBool)(t.NotifyOnClear),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.Default = (*bool)(aux.Default)
+	t.NotifyOnClear = (*bool)(aux.NotifyOnClear)
+	extra, err := unknownFields(data, jsonFieldsAlertRule)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
+
+// jsonFieldsAuditEvent are the lower case JSON keys decoded into the
+// fields of AuditEvent, or ignored
+var jsonFieldsAuditEvent = map[string]bool{
+	"accountgroupname": true,
+	"aid":              true,
+	"date":             true,
+	"event":            true,
+	"ipaddress":        true,
+	"resourcetype":     true,
+	"uid":              true,
+	"user":             true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (e AuditEvent) MarshalJSON() ([]byte, error) {
+	type alias AuditEvent
+
+	data, err := json.Marshal(alias(e))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, e.Extra, jsonFieldsAuditEvent)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (e *AuditEvent) UnmarshalJSON(data []byte) error {
+	type alias AuditEvent
+
+	if err := json.Unmarshal(data, (*alias)(e)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsAuditEvent)
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+	return nil
+}
+
+// jsonFieldsAuditEventsPage are the lower case JSON keys decoded into the
+// fields of AuditEventsPage, or ignored
+var jsonFieldsAuditEventsPage = map[string]bool{
+	"auditevents": true,
+	"from":        true,
+	"pages":       true,
+	"to":          true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (a AuditEventsPage) MarshalJSON() ([]byte, error) {
+	type alias AuditEventsPage
+
+	data, err := json.Marshal(alias(a))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, a.Extra, jsonFieldsAuditEventsPage)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (a *AuditEventsPage) UnmarshalJSON(data []byte) error {
+	type alias AuditEventsPage
+
+	if err := json.Unmarshal(data, (*alias)(a)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsAuditEventsPage)
+	if err != nil {
+		return err
+	}
+	a.Extra = extra
+	return nil
+}
+
+// jsonFieldsBGP are the lower case JSON keys decoded into the
+// fields of BGP, or ignored
+var jsonFieldsBGP = map[string]bool{
+	"alertrules":             true,
+	"alertsenabled":          true,
+	"apilinks":               true,
+	"bgpmonitors":            true,
+	"createdby":              true,
+	"createddate":            true,
+	"description":            true,
+	"enabled":                true,
+	"groups":                 true,
+	"includecoveredprefixes": true,
+	"liveshare":              true,
+	"modifiedby":             true,
+	"modifieddate":           true,
+	"prefix":                 true,
+	"savedevent":             true,
+	"sharedwithaccounts":     true,
+	"testid":                 true,
+	"testname":               true,
+	"type":                   true,
+	"usepublicbgp":           true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (t BGP) MarshalJSON() ([]byte, error) {
+	type alias BGP
+
+	data, err := json.Marshal(struct {
+		alias
+		AlertsEnabled          *intBool `json:"alertsEnabled,omitempty"`
+		Enabled                *intBool `json:"enabled,omitempty"`
+		SavedEvent             *intBool `json:"savedEvent,omitempty"`
+		LiveShare              *intBool `json:"liveShare,omitempty"`
+		IncludeCoveredPrefixes *intBool `json:"includeCoveredPrefixes,omitempty"`
+		UsePublicBGP           *intBool `json:"usePublicBgp,omitempty"`
+	}{
+		alias:                  alias(t),
+		AlertsEnabled:          (*intBool)(t.AlertsEnabled),
+		Enabled:                (*intBool)(t.Enabled),
+		SavedEvent:             (*intBool)(t.SavedEvent),
+		LiveShare:              (*intBool)(t.LiveShare),
+		IncludeCoveredPrefixes: (*intBool)(t.IncludeCoveredPrefixes),
+		UsePublicBGP:           (*intBool)(t.UsePublicBGP),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, t.Extra, jsonFieldsBGP)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (t *BGP) UnmarshalJSON(data []byte) error {
+	type alias BGP
+
+	aux := struct {
+		*alias
+		AlertsEnabled          *intBool `json:"alertsEnabled,omitempty"`
+		Enabled                *intBool `json:"enabled,omitempty"`
+		SavedEvent             *intBool `json:"savedEvent,omitempty"`
+		LiveShare              *intBool `json:"liveShare,omitempty"`
+		IncludeCoveredPrefixes *intBool `json:"includeCoveredPrefixes,omitempty"`
+		UsePublicBGP           *intBool `json:"usePublicBgp,omitempty"`
+	}{
+		alias:                  (*alias)(t),
+		AlertsEnabled:          (*intBool)(t.AlertsEnabled),
+		Enabled:                (*intBool)(t.Enabled),
+		SavedEvent:             (*intBool)(t.SavedEvent),
+		LiveShare:              (*intBool)(t.LiveShare),
+		IncludeCoveredPrefixes: (*intBool)(t.IncludeCoveredPrefixes),
+		UsePublicBGP:           (*intBool)(t.UsePublicBGP),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.AlertsEnabled = (*bool)(aux.AlertsEnabled)
+	t.Enabled = (*bool)(aux.Enabled)
+	t.SavedEvent = (*bool)(aux.SavedEvent)
+	t.LiveShare = (*bool)(aux.LiveShare)
+	t.IncludeCoveredPrefixes = (*bool)(aux.IncludeCoveredPrefixes)
+	t.UsePublicBGP = (*bool)(aux.UsePublicBGP)
+	extra, err := unknownFields(data, jsonFieldsBGP)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
+
+// jsonFieldsBGPMonitor are the lower case JSON keys decoded into the
+// fields of BGPMonitor, or ignored
+var jsonFieldsBGPMonitor = map[string]bool{
+	"countryid":   true,
+	"ipaddress":   true,
+	"monitorid":   true,
+	"monitorname": true,
+	"monitortype": true,
+	"network":     true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (b BGPMonitor) MarshalJSON() ([]byte, error) {
+	type alias BGPMonitor
+
+	data, err := json.Marshal(alias(b))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, b.Extra, jsonFieldsBGPMonitor)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (b *BGPMonitor) UnmarshalJSON(data []byte) error {
+	type alias BGPMonitor
+
+	if err := json.Unmarshal(data, (*alias)(b)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsBGPMonitor)
+	if err != nil {
+		return err
+	}
+	b.Extra = extra
+	return nil
+}
+
+// jsonFieldsClusterMember are the lower case JSON keys decoded into the
+// fields of ClusterMember, or ignored
+var jsonFieldsClusterMember = map[string]bool{
+	"agentstate":        true,
+	"ipaddresses":       true,
+	"lastseen":          true,
+	"memberid":          true,
+	"name":              true,
+	"network":           true,
+	"prefix":            true,
+	"publicipaddresses": true,
+	"targetfortests":    true,
+	"utilization":       true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (c ClusterMember) MarshalJSON() ([]byte, error) {
+	type alias ClusterMember
+
+	data, err := json.Marshal(alias(c))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, c.Extra, jsonFieldsClusterMember)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (c *ClusterMember) UnmarshalJSON(data []byte) error {
+	type alias ClusterMember
+
+	if err := json.Unmarshal(data, (*alias)(c)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsClusterMember)
+	if err != nil {
+		return err
+	}
+	c.Extra = extra
+	return nil
+}
+
+// jsonFieldsCredential are the lower case JSON keys decoded into the
+// fields of Credential, or ignored
+var jsonFieldsCredential = map[string]bool{
+	"createdby":    true,
+	"createddate":  true,
+	"credentialid": true,
+	"modifiedby":   true,
+	"modifieddate": true,
+	"name":         true,
+	"value":        true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (c Credential) MarshalJSON() ([]byte, error) {
+	type alias Credential
+
+	data, err := json.Marshal(alias(c))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, c.Extra, jsonFieldsCredential)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (c *Credential) UnmarshalJSON(data []byte) error {
+	type alias Credential
+
+	if err := json.Unmarshal(data, (*alias)(c)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsCredential)
+	if err != nil {
+		return err
+	}
+	c.Extra = extra
+	return nil
+}
+
+// jsonFieldsCustomHeaders are the lower case JSON keys decoded into the
+// fields of CustomHeaders, or ignored
+var jsonFieldsCustomHeaders = map[string]bool{
+	"all":     true,
+	"domains": true,
+	"root":    true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (t CustomHeaders) MarshalJSON() ([]byte, error) {
+	type alias CustomHeaders
+
+	data, err := json.Marshal(alias(t))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, t.Extra, jsonFieldsCustomHeaders)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (t *CustomHeaders) UnmarshalJSON(data []byte) error {
+	type alias CustomHeaders
+
+	if err := json.Unmarshal(data, (*alias)(t)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsCustomHeaders)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
+
+// jsonFieldsDNSSec are the lower case JSON keys decoded into the
+// fields of DNSSec, or ignored
+var jsonFieldsDNSSec = map[string]bool{
+	"agents":             true,
+	"alertrules":         true,
+	"alertsenabled":      true,
+	"apilinks":           true,
+	"createdby":          true,
+	"createddate":        true,
+	"description":        true,
+	"domain":             true,
+	"enabled":            true,
+	"groups":             true,
+	"interval":           true,
+	"liveshare":          true,
+	"modifiedby":         true,
+	"modifieddate":       true,
+	"savedevent":         true,
+	"sharedwithaccounts": true,
+	"testid":             true,
+	"testname":           true,
+	"type":               true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (t DNSSec) MarshalJSON() ([]byte, error) {
+	type alias DNSSec
+
+	data, err := json.Marshal(struct {
+		alias
+		AlertsEnabled *intBool `json:"alertsEnabled,omitempty"`
+		Enabled       *intBool `json:"enabled,omitempty"`
+		SavedEvent    *intBool `json:"savedEvent,omitempty"`
+		LiveShare     *intBool `json:"liveShare,omitempty"`
+	}{
+		alias:         alias(t),
+		AlertsEnabled: (*intBool)(t.AlertsEnabled),
+		Enabled:       (*intBool)(t.Enabled),
+		SavedEvent:    (*intBool)(t.SavedEvent),
+		LiveShare:     (*intBool)(t.LiveShare),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, t.Extra, jsonFieldsDNSSec)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (t *DNSSec) UnmarshalJSON(data []byte) error {
+	type alias DNSSec
+
+	aux := struct {
+		*alias
+		AlertsEnabled *intBool `json:"alertsEnabled,omitempty"`
+		Enabled       *intBool `json:"enabled,omitempty"`
+		SavedEvent    *intBool `json:"savedEvent,omitempty"`
+		LiveShare     *intBool `json:"liveShare,omitempty"`
+	}{
+		alias:         (*alias)(t),
+		AlertsEnabled: (*intBool)(t.AlertsEnabled),
+		Enabled:       (*intBool)(t.Enabled),
+		SavedEvent:    (*intBool)(t.SavedEvent),
+		LiveShare:     (*intBool)(t.LiveShare),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.AlertsEnabled = (*bool)(aux.AlertsEnabled)
+	t.Enabled = (*bool)(aux.Enabled)
+	t.SavedEvent = (*bool)(aux.SavedEvent)
+	t.LiveShare = (*bool)(aux.LiveShare)
+	extra, err := unknownFields(data, jsonFieldsDNSSec)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
+
+// jsonFieldsDNSServer are the lower case JSON keys decoded into the
+// fields of DNSServer, or ignored
+var jsonFieldsDNSServer = map[string]bool{
+	"agents":                true,
+	"alertrules":            true,
+	"alertsenabled":         true,
+	"apilinks":              true,
+	"bandwidthmeasurements": true,
+	"bgpmeasurements":       true,
+	"bgpmonitors":           true,
+	"createdby":             true,
+	"createddate":           true,
+	"description":           true,
+	"dnsservers":            true,
+	"dnstransportprotocol":  true,
+	"domain":                true,
+	"enabled":               true,
+	"groups":                true,
+	"interval":              true,
+	"liveshare":             true,
+	"modifiedby":            true,
+	"modifieddate":          true,
+	"mtumeasurements":       true,
+	"networkmeasurements":   true,
+	"numpathtraces":         true,
+	"pathtracemode":         true,
+	"probemode":             true,
+	"protocol":              true,
+	"recursivequeries":      true,
+	"savedevent":            true,
+	"sharedwithaccounts":    true,
+	"testid":                true,
+	"testname":              true,
+	"type":                  true,
+	"usepublicbgp":          true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (t DNSServer) MarshalJSON() ([]byte, error) {
+	type alias DNSServer
+
+	data, err := json.Marshal(struct {
+		alias
+		AlertsEnabled         *intBool `json:"alertsEnabled,omitempty"`
+		Enabled               *intBool `json:"enabled,omitempty"`
+		SavedEvent            *intBool `json:"savedEvent,omitempty"`
+		LiveShare             *intBool `json:"liveShare,omitempty"`
+		BandwidthMeasurements *intBool `json:"bandwidthMeasurements,omitempty"`
+		BGPMeasurements       *intBool `json:"bgpMeasurements,omitempty"`
+		MTUMeasurements       *intBool `json:"mtuMeasurements,omitempty"`
+		NetworkMeasurements   *intBool `json:"networkMeasurements,omitempty"`
+		RecursiveQueries      *intBool `json:"recursiveQueries,omitempty"`
+		UsePublicBGP          *intBool `json:"usePublicBgp,omitempty"`
+	}{
+		alias:                 alias(t),
+		AlertsEnabled:         (*intBool)(t.AlertsEnabled),
+		Enabled:               (*intBool)(t.Enabled),
+		SavedEvent:            (*intBool)(t.SavedEvent),
+		LiveShare:             (*intBool)(t.LiveShare),
+		BandwidthMeasurements: (*intBool)(t.BandwidthMeasurements),
+		BGPMeasurements:       (*intBool)(t.BGPMeasurements),
+		MTUMeasurements:       (*intBool)(t.MTUMeasurements),
+		NetworkMeasurements:   (*intBool)(t.NetworkMeasurements),
+		RecursiveQueries:      (*intBool)(t.RecursiveQueries),
+		UsePublicBGP:          (*intBool)(t.UsePublicBGP),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, t.Extra, jsonFieldsDNSServer)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (t *DNSServer) UnmarshalJSON(data []byte) error {
+	type alias DNSServer
+
+	aux := struct {
+		*alias
+		AlertsEnabled         *intBool `json:"alertsEnabled,omitempty"`
+		Enabled               *intBool `json:"enabled,omitempty"`
+		SavedEvent            *intBool `json:"savedEvent,omitempty"`
+		LiveShare             *intBool `json:"liveShare,omitempty"`
+		BandwidthMeasurements *intBool `json:"bandwidthMeasurements,omitempty"`
+		BGPMeasurements       *intBool `json:"bgpMeasurements,omitempty"`
+		MTUMeasurements       *intBool `json:"mtuMeasurements,omitempty"`
+		NetworkMeasurements   *intBool `json:"networkMeasurements,omitempty"`
+		RecursiveQueries      *intBool `json:"recursiveQueries,omitempty"`
+		UsePublicBGP          *intBool `json:"usePublicBgp,omitempty"`
+	}{
+		alias:                 (*alias)(t),
+		AlertsEnabled:         (*intBool)(t.AlertsEnabled),
+		Enabled:               (*intBool)(t.Enabled),
+		SavedEvent:            (*intBool)(t.SavedEvent),
+		LiveShare:             (*intBool)(t.LiveShare),
+		BandwidthMeasurements: (*intBool)(t.BandwidthMeasurements),
+		BGPMeasurements:       (*intBool)(t.BGPMeasurements),
+		MTUMeasurements:       (*intBool)(t.MTUMeasurements),
+		NetworkMeasurements:   (*intBool)(t.NetworkMeasurements),
+		RecursiveQueries:      (*intBool)(t.RecursiveQueries),
+		UsePublicBGP:          (*intBool)(t.UsePublicBGP),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.AlertsEnabled = (*bool)(aux.AlertsEnabled)
+	t.Enabled = (*bool)(aux.Enabled)
+	t.SavedEvent = (*bool)(aux.SavedEvent)
+	t.LiveShare = (*bool)(aux.LiveShare)
+	t.BandwidthMeasurements = (*bool)(aux.BandwidthMeasurements)
+	t.BGPMeasurements = (*bool)(aux.BGPMeasurements)
+	t.MTUMeasurements = (*bool)(aux.MTUMeasurements)
+	t.NetworkMeasurements = (*bool)(aux.NetworkMeasurements)
+	t.RecursiveQueries = (*bool)(aux.RecursiveQueries)
+	t.UsePublicBGP = (*bool)(aux.UsePublicBGP)
+	extra, err := unknownFields(data, jsonFieldsDNSServer)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
+
+// jsonFieldsDNSTrace are the lower case JSON keys decoded into the
+// fields of DNSTrace, or ignored
+var jsonFieldsDNSTrace = map[string]bool{
+	"agents":               true,
+	"alertrules":           true,
+	"alertsenabled":        true,
+	"apilinks":             true,
+	"createdby":            true,
+	"createddate":          true,
+	"description":          true,
+	"dnstransportprotocol": true,
+	"domain":               true,
+	"enabled":              true,
+	"groups":               true,
+	"interval":             true,
+	"liveshare":            true,
+	"modifiedby":           true,
+	"modifieddate":         true,
+	"savedevent":           true,
+	"sharedwithaccounts":   true,
+	"testid":               true,
+	"testname":             true,
+	"type":                 true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (t DNSTrace) MarshalJSON() ([]byte, error) {
+	type alias DNSTrace
+
+	data, err := json.Marshal(struct {
+		alias
+		AlertsEnabled *intBool `json:"alertsEnabled,omitempty"`
+		Enabled       *intBool `json:"enabled,omitempty"`
+		SavedEvent    *intBool `json:"savedEvent,omitempty"`
+		LiveShare     *intBool `json:"liveShare,omitempty"`
+	}{
+		alias:         alias(t),
+		AlertsEnabled: (*intBool)(t.AlertsEnabled),
+		Enabled:       (*intBool)(t.Enabled),
+		SavedEvent:    (*intBool)(t.SavedEvent),
+		LiveShare:     (*intBool)(t.LiveShare),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, t.Extra, jsonFieldsDNSTrace)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (t *DNSTrace) UnmarshalJSON(data []byte) error {
+	type alias DNSTrace
+
+	aux := struct {
+		*alias
+		AlertsEnabled *intBool `json:"alertsEnabled,omitempty"`
+		Enabled       *intBool `json:"enabled,omitempty"`
+		SavedEvent    *intBool `json:"savedEvent,omitempty"`
+		LiveShare     *intBool `json:"liveShare,omitempty"`
+	}{
+		alias:         (*alias)(t),
+		AlertsEnabled: (*intBool)(t.AlertsEnabled),
+		Enabled:       (*intBool)(t.Enabled),
+		SavedEvent:    (*intBool)(t.SavedEvent),
+		LiveShare:     (*intBool)(t.LiveShare),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.AlertsEnabled = (*bool)(aux.AlertsEnabled)
+	t.Enabled = (*bool)(aux.Enabled)
+	t.SavedEvent = (*bool)(aux.SavedEvent)
+	t.LiveShare = (*bool)(aux.LiveShare)
+	extra, err := unknownFields(data, jsonFieldsDNSTrace)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
+
+// jsonFieldsDashboard are the lower case JSON keys decoded into the
+// fields of Dashboard, or ignored
+var jsonFieldsDashboard = map[string]bool{
+	"apilinks":         true,
+	"createdby":        true,
+	"dashboardid":      true,
+	"description":      true,
+	"isbuiltin":        true,
+	"isglobaloverride": true,
+	"isprivate":        true,
+	"modifiedby":       true,
+	"modifieddate":     true,
+	"title":            true,
+	"widgets":          true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (d Dashboard) MarshalJSON() ([]byte, error) {
+	type alias Dashboard
+
+	data, err := json.Marshal(alias(d))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, d.Extra, jsonFieldsDashboard)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (d *Dashboard) UnmarshalJSON(data []byte) error {
+	type alias Dashboard
+
+	if err := json.Unmarshal(data, (*alias)(d)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsDashboard)
+	if err != nil {
+		return err
+	}
+	d.Extra = extra
+	return nil
+}
+
+// jsonFieldsDashboardWidget are the lower case JSON keys decoded into the
+// fields of DashboardWidget, or ignored
+var jsonFieldsDashboardWidget = map[string]bool{
+	"datasource":    true,
+	"direction":     true,
+	"filters":       true,
+	"fixedtimespan": true,
+	"id":            true,
+	"measure":       true,
+	"metric":        true,
+	"metricgroup":   true,
+	"title":         true,
+	"type":          true,
+	"visualmode":    true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (d DashboardWidget) MarshalJSON() ([]byte, error) {
+	type alias DashboardWidget
+
+	data, err := json.Marshal(alias(d))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, d.Extra, jsonFieldsDashboardWidget)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (d *DashboardWidget) UnmarshalJSON(data []byte) error {
+	type alias DashboardWidget
+
+	if err := json.Unmarshal(data, (*alias)(d)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsDashboardWidget)
+	if err != nil {
+		return err
+	}
+	d.Extra = extra
+	return nil
+}
+
+// jsonFieldsDashboardWidgetFilters are the lower case JSON keys decoded into the
+// fields of DashboardWidgetFilters, or ignored
+var jsonFieldsDashboardWidgetFilters = map[string]bool{
+	"agents": true,
+	"labels": true,
+	"tests":  true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (d DashboardWidgetFilters) MarshalJSON() ([]byte, error) {
+	type alias DashboardWidgetFilters
+
+	data, err := json.Marshal(alias(d))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, d.Extra, jsonFieldsDashboardWidgetFilters)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (d *DashboardWidgetFilters) UnmarshalJSON(data []byte) error {
+	type alias DashboardWidgetFilters
+
+	if err := json.Unmarshal(data, (*alias)(d)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsDashboardWidgetFilters)
+	if err != nil {
+		return err
+	}
+	d.Extra = extra
+	return nil
+}
+
+// jsonFieldsDashboardWidgetMeasure are the lower case JSON keys decoded into the
+// fields of DashboardWidgetMeasure, or ignored
+var jsonFieldsDashboardWidgetMeasure = map[string]bool{
+	"percentile": true,
+	"type":       true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (d DashboardWidgetMeasure) MarshalJSON() ([]byte, error) {
+	type alias DashboardWidgetMeasure
+
+	data, err := json.Marshal(alias(d))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, d.Extra, jsonFieldsDashboardWidgetMeasure)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (d *DashboardWidgetMeasure) UnmarshalJSON(data []byte) error {
+	type alias DashboardWidgetMeasure
+
+	if err := json.Unmarshal(data, (*alias)(d)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsDashboardWidgetMeasure)
+	if err != nil {
+		return err
+	}
+	d.Extra = extra
+	return nil
+}
+
+// jsonFieldsDashboardWidgetTimespan are the lower case JSON keys decoded into the
+// fields of DashboardWidgetTimespan, or ignored
+var jsonFieldsDashboardWidgetTimespan = map[string]bool{
+	"unit":  true,
+	"value": true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (d DashboardWidgetTimespan) MarshalJSON() ([]byte, error) {
+	type alias DashboardWidgetTimespan
+
+	data, err := json.Marshal(alias(d))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, d.Extra, jsonFieldsDashboardWidgetTimespan)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (d *DashboardWidgetTimespan) UnmarshalJSON(data []byte) error {
+	type alias DashboardWidgetTimespan
+
+	if err := json.Unmarshal(data, (*alias)(d)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsDashboardWidgetTimespan)
+	if err != nil {
+		return err
+	}
+	d.Extra = extra
+	return nil
+}
+
+// jsonFieldsEndpointAgent are the lower case JSON keys decoded into the
+// fields of EndpointAgent, or ignored
+var jsonFieldsEndpointAgent = map[string]bool{
+	"agentid":                  true,
+	"agentname":                true,
+	"agenttype":                true,
+	"computername":             true,
+	"created":                  true,
+	"kernelversion":            true,
+	"lastseen":                 true,
+	"location":                 true,
+	"manufacturer":             true,
+	"model":                    true,
+	"networkinterfaceprofiles": true,
+	"osversion":                true,
+	"platform":                 true,
+	"publicip":                 true,
+	"status":                   true,
+	"version":                  true,
+	"vpnprofiles":              true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (a EndpointAgent) MarshalJSON() ([]byte, error) {
+	type alias EndpointAgent
+
+	data, err := json.Marshal(alias(a))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, a.Extra, jsonFieldsEndpointAgent)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (a *EndpointAgent) UnmarshalJSON(data []byte) error {
+	type alias EndpointAgent
+
+	if err := json.Unmarshal(data, (*alias)(a)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsEndpointAgent)
+	if err != nil {
+		return err
+	}
+	a.Extra = extra
+	return nil
+}
+
+// jsonFieldsEndpointAgentLocation are the lower case JSON keys decoded into the
+// fields of EndpointAgentLocation, or ignored
+var jsonFieldsEndpointAgentLocation = map[string]bool{
+	"latitude":     true,
+	"locationname": true,
+	"longitude":    true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (e EndpointAgentLocation) MarshalJSON() ([]byte, error) {
+	type alias EndpointAgentLocation
+
+	data, err := json.Marshal(alias(e))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, e.Extra, jsonFieldsEndpointAgentLocation)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (e *EndpointAgentLocation) UnmarshalJSON(data []byte) error {
+	type alias EndpointAgentLocation
+
+	if err := json.Unmarshal(data, (*alias)(e)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsEndpointAgentLocation)
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+	return nil
+}
+
+// jsonFieldsEndpointAgentSelector are the lower case JSON keys decoded into the
+// fields of EndpointAgentSelector, or ignored
+var jsonFieldsEndpointAgentSelector = map[string]bool{
+	"agentselectortype":   true,
+	"endpointagentlabels": true,
+	"endpointagents":      true,
+	"maxmachines":         true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (e EndpointAgentSelector) MarshalJSON() ([]byte, error) {
+	type alias EndpointAgentSelector
+
+	data, err := json.Marshal(alias(e))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, e.Extra, jsonFieldsEndpointAgentSelector)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (e *EndpointAgentSelector) UnmarshalJSON(data []byte) error {
+	type alias EndpointAgentSelector
+
+	if err := json.Unmarshal(data, (*alias)(e)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsEndpointAgentSelector)
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+	return nil
+}
+
+// jsonFieldsEndpointAgentServer are the lower case JSON keys decoded into the
+// fields of EndpointAgentServer, or ignored
+var jsonFieldsEndpointAgentServer = map[string]bool{
+	"agentselectorconfig": true,
+	"alertrules":          true,
+	"alertsenabled":       true,
+	"apilinks":            true,
+	"enabled":             true,
+	"interval":            true,
+	"port":                true,
+	"probemode":           true,
+	"protocol":            true,
+	"server":              true,
+	"tcpprobemode":        true,
+	"testid":              true,
+	"testname":            true,
+	"type":                true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (t EndpointAgentServer) MarshalJSON() ([]byte, error) {
+	type alias EndpointAgentServer
+
+	data, err := json.Marshal(struct {
+		alias
+		AlertsEnabled *intBool `json:"alertsEnabled,omitempty"`
+		Enabled       *intBool `json:"enabled,omitempty"`
+	}{
+		alias:         alias(t),
+		AlertsEnabled: (*intBool)(t.AlertsEnabled),
+		Enabled:       (*intBool)(t.Enabled),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, t.Extra, jsonFieldsEndpointAgentServer)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (t *EndpointAgentServer) UnmarshalJSON(data []byte) error {
+	type alias EndpointAgentServer
+
+	aux := struct {
+		*alias
+		AlertsEnabled *intBool `json:"alertsEnabled,omitempty"`
+		Enabled       *intBool `json:"enabled,omitempty"`
+	}{
+		alias:         (*alias)(t),
+		AlertsEnabled: (*intBool)(t.AlertsEnabled),
+		Enabled:       (*intBool)(t.Enabled),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.AlertsEnabled = (*bool)(aux.AlertsEnabled)
+	t.Enabled = (*bool)(aux.Enabled)
+	extra, err := unknownFields(data, jsonFieldsEndpointAgentServer)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
+
+// jsonFieldsEndpointAgentUsage are the lower case JSON keys decoded into the
+// fields of EndpointAgentUsage, or ignored
+var jsonFieldsEndpointAgentUsage = map[string]bool{
+	"accountgroupname":   true,
+	"aid":                true,
+	"endpointagentsused": true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (e EndpointAgentUsage) MarshalJSON() ([]byte, error) {
+	type alias EndpointAgentUsage
+
+	data, err := json.Marshal(alias(e))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, e.Extra, jsonFieldsEndpointAgentUsage)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (e *EndpointAgentUsage) UnmarshalJSON(data []byte) error {
+	type alias EndpointAgentUsage
+
+	if err := json.Unmarshal(data, (*alias)(e)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsEndpointAgentUsage)
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+	return nil
+}
+
+// jsonFieldsEndpointHTTPServer are the lower case JSON keys decoded into the
+// fields of EndpointHTTPServer, or ignored
+var jsonFieldsEndpointHTTPServer = map[string]bool{
+	"agentselectorconfig": true,
+	"alertrules":          true,
+	"alertsenabled":       true,
+	"apilinks":            true,
+	"authtype":            true,
+	"enabled":             true,
+	"httptimelimit":       true,
+	"interval":            true,
+	"password":            true,
+	"probemode":           true,
+	"protocol":            true,
+	"sslversionid":        true,
+	"tcpprobemode":        true,
+	"testid":              true,
+	"testname":            true,
+	"type":                true,
+	"url":                 true,
+	"username":            true,
+	"verifycertificate":   true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (t EndpointHTTPServer) MarshalJSON() ([]byte, error) {
+	type alias EndpointHTTPServer
+
+	data, err := json.Marshal(struct {
+		alias
+		AlertsEnabled     *intBool `json:"alertsEnabled,omitempty"`
+		Enabled           *intBool `json:"enabled,omitempty"`
+		VerifyCertificate *intBool `json:"verifyCertificate,omitempty"`
+	}{
+		alias:             alias(t),
+		AlertsEnabled:     (*intBool)(t.AlertsEnabled),
+		Enabled:           (*intBool)(t.Enabled),
+		VerifyCertificate: (*intBool)(t.VerifyCertificate),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, t.Extra, jsonFieldsEndpointHTTPServer)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (t *EndpointHTTPServer) UnmarshalJSON(data []byte) error {
+	type alias EndpointHTTPServer
+
+	aux := struct {
+		*alias
+		AlertsEnabled     *intBool `json:"alertsEnabled,omitempty"`
+		Enabled           *intBool `json:"enabled,omitempty"`
+		VerifyCertificate *intBool `json:"verifyCertificate,omitempty"`
+	}{
+		alias:             (*alias)(t),
+		AlertsEnabled:     (*intBool)(t.AlertsEnabled),
+		Enabled:           (*intBool)(t.Enabled),
+		VerifyCertificate: (*intBool)(t.VerifyCertificate),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.AlertsEnabled = (*bool)(aux.AlertsEnabled)
+	t.Enabled = (*bool)(aux.Enabled)
+	t.VerifyCertificate = (*bool)(aux.VerifyCertificate)
+	extra, err := unknownFields(data, jsonFieldsEndpointHTTPServer)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
+
+// jsonFieldsEndpointNetworkProfile are the lower case JSON keys decoded into the
+// fields of EndpointNetworkProfile, or ignored
+var jsonFieldsEndpointNetworkProfile = map[string]bool{
+	"dnsservers":      true,
+	"gateway":         true,
+	"hardwaretype":    true,
+	"interfacename":   true,
+	"interfacetype":   true,
+	"ipaddresses":     true,
+	"ipv6addresses":   true,
+	"proxyconfigured": true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (e EndpointNetworkProfile) MarshalJSON() ([]byte, error) {
+	type alias EndpointNetworkProfile
+
+	data, err := json.Marshal(alias(e))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, e.Extra, jsonFieldsEndpointNetworkProfile)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (e *EndpointNetworkProfile) UnmarshalJSON(data []byte) error {
+	type alias EndpointNetworkProfile
+
+	if err := json.Unmarshal(data, (*alias)(e)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsEndpointNetworkProfile)
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+	return nil
+}
+
+// jsonFieldsEndpointVPNProfile are the lower case JSON keys decoded into the
+// fields of EndpointVPNProfile, or ignored
+var jsonFieldsEndpointVPNProfile = map[string]bool{
+	"interfacename":         true,
+	"vpnclientaddresses":    true,
+	"vpnclientnetworkrange": true,
+	"vpngatewayaddress":     true,
+	"vpntype":               true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (e EndpointVPNProfile) MarshalJSON() ([]byte, error) {
+	type alias EndpointVPNProfile
+
+	data, err := json.Marshal(alias(e))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, e.Extra, jsonFieldsEndpointVPNProfile)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (e *EndpointVPNProfile) UnmarshalJSON(data []byte) error {
+	type alias EndpointVPNProfile
+
+	if err := json.Unmarshal(data, (*alias)(e)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsEndpointVPNProfile)
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+	return nil
+}
+
+// jsonFieldsEnterpriseAgentUsage are the lower case JSON keys decoded into the
+// fields of EnterpriseAgentUsage, or ignored
+var jsonFieldsEnterpriseAgentUsage = map[string]bool{
+	"accountgroupname":     true,
+	"aid":                  true,
+	"enterpriseagentsused": true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (e EnterpriseAgentUsage) MarshalJSON() ([]byte, error) {
+	type alias EnterpriseAgentUsage
+
+	data, err := json.Marshal(alias(e))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, e.Extra, jsonFieldsEnterpriseAgentUsage)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (e *EnterpriseAgentUsage) UnmarshalJSON(data []byte) error {
+	type alias EnterpriseAgentUsage
+
+	if err := json.Unmarshal(data, (*alias)(e)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsEnterpriseAgentUsage)
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+	return nil
+}
+
+// jsonFieldsFTPServer are the lower case JSON keys decoded into the
+// fields of FTPServer, or ignored
+var jsonFieldsFTPServer = map[string]bool{
+	"agents":              true,
+	"alertrules":          true,
+	"alertsenabled":       true,
+	"apilinks":            true,
+	"bgpmeasurements":     true,
+	"createdby":           true,
+	"createddate":         true,
+	"description":         true,
+	"downloadlimit":       true,
+	"enabled":             true,
+	"ftptargettime":       true,
+	"ftptimelimit":        true,
+	"groups":              true,
+	"interval":            true,
+	"liveshare":           true,
+	"modifiedby":          true,
+	"modifieddate":        true,
+	"mtumeasurements":     true,
+	"networkmeasurements": true,
+	"numpathtraces":       true,
+	"password":            true,
+	"pathtracemode":       true,
+	"probemode":           true,
+	"protocol":            true,
+	"requesttype":         true,
+	"savedevent":          true,
+	"sharedwithaccounts":  true,
+	"testid":              true,
+	"testname":            true,
+	"type":                true,
+	"url":                 true,
+	"useactiveftp":        true,
+	"useexplicitftps":     true,
+	"username":            true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (t FTPServer) MarshalJSON() ([]byte, error) {
+	type alias FTPServer
+
+	data, err := json.Marshal(struct {
+		alias
+		AlertsEnabled       *intBool `json:"alertsEnabled,omitempty"`
+		Enabled             *intBool `json:"enabled,omitempty"`
+		SavedEvent          *intBool `json:"savedEvent,omitempty"`
+		LiveShare           *intBool `json:"liveShare,omitempty"`
+		BGPMeasurements     *intBool `json:"bgpMeasurements,omitempty"`
+		MTUMeasurements     *intBool `json:"mtuMeasurements,omitempty"`
+		NetworkMeasurements *intBool `json:"networkMeasurements,omitempty"`
+	}{
+		alias:               alias(t),
+		AlertsEnabled:       (*intBool)(t.AlertsEnabled),
+		Enabled:             (*intBool)(t.Enabled),
+		SavedEvent:          (*intBool)(t.SavedEvent),
+		LiveShare:           (*intBool)(t.LiveShare),
+		BGPMeasurements:     (*intBool)(t.BGPMeasurements),
+		MTUMeasurements:     (*intBool)(t.MTUMeasurements),
+		NetworkMeasurements: (*intBool)(t.NetworkMeasurements),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, t.Extra, jsonFieldsFTPServer)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (t *FTPServer) UnmarshalJSON(data []byte) error {
+	type alias FTPServer
+
+	aux := struct {
+		*alias
+		AlertsEnabled       *intBool `json:"alertsEnabled,omitempty"`
+		Enabled             *intBool `json:"enabled,omitempty"`
+		SavedEvent          *intBool `json:"savedEvent,omitempty"`
+		LiveShare           *intBool `json:"liveShare,omitempty"`
+		BGPMeasurements     *intBool `json:"bgpMeasurements,omitempty"`
+		MTUMeasurements     *intBool `json:"mtuMeasurements,omitempty"`
+		NetworkMeasurements *intBool `json:"networkMeasurements,omitempty"`
+	}{
+		alias:               (*alias)(t),
+		AlertsEnabled:       (*intBool)(t.AlertsEnabled),
+		Enabled:             (*intBool)(t.Enabled),
+		SavedEvent:          (*intBool)(t.SavedEvent),
+		LiveShare:           (*intBool)(t.LiveShare),
+		BGPMeasurements:     (*intBool)(t.BGPMeasurements),
+		MTUMeasurements:     (*intBool)(t.MTUMeasurements),
+		NetworkMeasurements: (*intBool)(t.NetworkMeasurements),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.AlertsEnabled = (*bool)(aux.AlertsEnabled)
+	t.Enabled = (*bool)(aux.Enabled)
+	t.SavedEvent = (*bool)(aux.SavedEvent)
+	t.LiveShare = (*bool)(aux.LiveShare)
+	t.BGPMeasurements = (*bool)(aux.BGPMeasurements)
+	t.MTUMeasurements = (*bool)(aux.MTUMeasurements)
+	t.NetworkMeasurements = (*bool)(aux.NetworkMeasurements)
+	extra, err := unknownFields(data, jsonFieldsFTPServer)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
+
+// jsonFieldsGenericEndpointTest are the lower case JSON keys decoded into the
+// fields of GenericEndpointTest, or ignored
+var jsonFieldsGenericEndpointTest = map[string]bool{
+	"agentselectorconfig": true,
+	"alertsenabled":       true,
+	"apilinks":            true,
+	"enabled":             true,
+	"interval":            true,
+	"testid":              true,
+	"testname":            true,
+	"type":                true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (t GenericEndpointTest) MarshalJSON() ([]byte, error) {
+	type alias GenericEndpointTest
+
+	data, err := json.Marshal(struct {
+		alias
+		AlertsEnabled *intBool `json:"alertsEnabled,omitempty"`
+		Enabled       *intBool `json:"enabled,omitempty"`
+	}{
+		alias:         alias(t),
+		AlertsEnabled: (*intBool)(t.AlertsEnabled),
+		Enabled:       (*intBool)(t.Enabled),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, t.Extra, jsonFieldsGenericEndpointTest)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (t *GenericEndpointTest) UnmarshalJSON(data []byte) error {
+	type alias GenericEndpointTest
+
+	aux := struct {
+		*alias
+		AlertsEnabled *intBool `json:"alertsEnabled,omitempty"`
+		Enabled       *intBool `json:"enabled,omitempty"`
+	}{
+		alias:         (*alias)(t),
+		AlertsEnabled: (*intBool)(t.AlertsEnabled),
+		Enabled:       (*intBool)(t.Enabled),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.AlertsEnabled = (*bool)(aux.AlertsEnabled)
+	t.Enabled = (*bool)(aux.Enabled)
+	extra, err := unknownFields(data, jsonFieldsGenericEndpointTest)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
+
+// jsonFieldsGenericTest are the lower case JSON keys decoded into the
+// fields of GenericTest, or ignored
+var jsonFieldsGenericTest = map[string]bool{
+	"agents":             true,
+	"alertrules":         true,
+	"alertsenabled":      true,
+	"apilinks":           true,
+	"createdby":          true,
+	"createddate":        true,
+	"description":        true,
+	"enabled":            true,
+	"groups":             true,
+	"liveshare":          true,
+	"modifiedby":         true,
+	"modifieddate":       true,
+	"savedevent":         true,
+	"sharedwithaccounts": true,
+	"testid":             true,
+	"testname":           true,
+	"type":               true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (t GenericTest) MarshalJSON() ([]byte, error) {
+	type alias GenericTest
+
+	data, err := json.Marshal(struct {
+		alias
+		AlertsEnabled *intBool `json:"alertsEnabled,omitempty"`
+		Enabled       *intBool `json:"enabled,omitempty"`
+		SavedEvent    *intBool `json:"savedEvent,omitempty"`
+		LiveShare     *intBool `json:"liveShare,omitempty"`
+	}{
+		alias:         alias(t),
+		AlertsEnabled: (*intBool)(t.AlertsEnabled),
+		Enabled:       (*intBool)(t.Enabled),
+		SavedEvent:    (*intBool)(t.SavedEvent),
+		LiveShare:     (*intBool)(t.LiveShare),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, t.Extra, jsonFieldsGenericTest)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (t *GenericTest) UnmarshalJSON(data []byte) error {
+	type alias GenericTest
+
+	aux := struct {
+		*alias
+		AlertsEnabled *intBool `json:"alertsEnabled,omitempty"`
+		Enabled       *intBool `json:"enabled,omitempty"`
+		SavedEvent    *intBool `json:"savedEvent,omitempty"`
+		LiveShare     *intBool `json:"liveShare,omitempty"`
+	}{
+		alias:         (*alias)(t),
+		AlertsEnabled: (*intBool)(t.AlertsEnabled),
+		Enabled:       (*intBool)(t.Enabled),
+		SavedEvent:    (*intBool)(t.SavedEvent),
+		LiveShare:     (*intBool)(t.LiveShare),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.AlertsEnabled = (*bool)(aux.AlertsEnabled)
+	t.Enabled = (*bool)(aux.Enabled)
+	t.SavedEvent = (*bool)(aux.SavedEvent)
+	t.LiveShare = (*bool)(aux.LiveShare)
+	extra, err := unknownFields(data, jsonFieldsGenericTest)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
+
+// jsonFieldsGroupLabel are the lower case JSON keys decoded into the
+// fields of GroupLabel, or ignored
+var jsonFieldsGroupLabel = map[string]bool{
+	"agents":     true,
+	"builtin":    true,
+	"dashboards": true,
+	"groupid":    true,
+	"name":       true,
+	"tests":      true,
+	"type":       true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (t GroupLabel) MarshalJSON() ([]byte, error) {
+	type alias GroupLabel
+
+	data, err := json.Marshal(struct {
+		alias
+		Builtin *intBool `json:"builtin,omitempty"`
+	}{
+		alias:   alias(t),
+		Builtin: (*intBool)(t.Builtin),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if data, err = appendExtraFields(data, t.Extra, jsonFieldsGroupLabel); err != nil {
+		return nil, err
+	}
+	return t.afterMarshalJSON(data)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (t *GroupLabel) UnmarshalJSON(data []byte) error {
+	type alias GroupLabel
+
+	data, err := t.beforeUnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+
+	aux := struct {
+		*alias
+		Builtin *intBool `json:"builtin,omitempty"`
+	}{
+		alias:   (*alias)(t),
+		Builtin: (*intBool)(t.Builtin),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.Builtin = (*bool)(aux.Builtin)
+	extra, err := unknownFields(data, jsonFieldsGroupLabel)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
+
+// jsonFieldsHTTPServer are the lower case JSON keys decoded into the
+// fields of HTTPServer, or ignored
+var jsonFieldsHTTPServer = map[string]bool{
+	"agents":                true,
+	"alertrules":            true,
+	"alertsenabled":         true,
+	"apilinks":              true,
+	"authtype":              true,
+	"bandwidthmeasurements": true,
+	"bgpmeasurements":       true,
+	"bgpmonitors":           true,
+	"clientcertificate":     true,
+	"contentregex":          true,
+	"createdby":             true,
+	"createddate":           true,
+	"customheaders":         true,
+	"description":           true,
+	"desiredstatuscode":     true,
+	"dnsoverride":           true,
+	"downloadlimit":         true,
+	"enabled":               true,
+	"followredirects":       true,
+	"groups":                true,
+	"headers":               true,
+	"httptargettime":        true,
+	"httptimelimit":         true,
+	"httpversion":           true,
+	"interval":              true,
+	"liveshare":             true,
+	"modifiedby":            true,
+	"modifieddate":          true,
+	"mtumeasurements":       true,
+	"networkmeasurements":   true,
+	"numpathtraces":         true,
+	"password":              true,
+	"pathtracemode":         true,
+	"postbody":              true,
+	"probemode":             true,
+	"protocol":              true,
+	"savedevent":            true,
+	"sharedwithaccounts":    true,
+	"sslversion":            true,
+	"sslversionid":          true,
+	"testid":                true,
+	"testname":              true,
+	"type":                  true,
+	"url":                   true,
+	"usentlm":               true,
+	"useragent":             true,
+	"username":              true,
+	"verifycertificate":     true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (t HTTPServer) MarshalJSON() ([]byte, error) {
+	type alias HTTPServer
+
+	data, err := json.Marshal(struct {
+		alias
+		AlertsEnabled         *intBool `json:"alertsEnabled,omitempty"`
+		Enabled               *intBool `json:"enabled,omitempty"`
+		SavedEvent            *intBool `json:"savedEvent,omitempty"`
+		LiveShare             *intBool `json:"liveShare,omitempty"`
+		BandwidthMeasurements *intBool `json:"bandwidthMeasurements,omitempty"`
+		BGPMeasurements       *intBool `json:"bgpMeasurements,omitempty"`
+		FollowRedirects       *intBool `json:"followRedirects,omitempty"`
+		MTUMeasurements       *intBool `json:"mtuMeasurements,omitempty"`
+		NetworkMeasurements   *intBool `json:"networkMeasurements,omitempty"`
+		UseNTLM               *intBool `json:"useNtlm,omitempty"`
+		VerifyCertificate     *intBool `json:"verifyCertificate,omitempty"`
+	}{
+		alias:                 alias(t),
+		AlertsEnabled:         (*intBool)(t.AlertsEnabled),
+		Enabled:               (*intBool)(t.Enabled),
+		SavedEvent:            (*intBool)(t.SavedEvent),
+		LiveShare:             (*intBool)(t.LiveShare),
+		BandwidthMeasurements: (*intBool)(t.BandwidthMeasurements),
+		BGPMeasurements:       (*intBool)(t.BGPMeasurements),
+		FollowRedirects:       (*intBool)(t.FollowRedirects),
+		MTUMeasurements:       (*intBool)(t.MTUMeasurements),
+		NetworkMeasurements:   (*intBool)(t.NetworkMeasurements),
+		UseNTLM:               (*intBool)(t.UseNTLM),
+		VerifyCertificate:     (*intBool)(t.VerifyCertificate),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, t.Extra, jsonFieldsHTTPServer)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (t *HTTPServer) UnmarshalJSON(data []byte) error {
+	type alias HTTPServer
+
+	aux := struct {
+		*alias
+		AlertsEnabled         *intBool `json:"alertsEnabled,omitempty"`
+		Enabled               *intBool `json:"enabled,omitempty"`
+		SavedEvent            *intBool `json:"savedEvent,omitempty"`
+		LiveShare             *intBool `json:"liveShare,omitempty"`
+		BandwidthMeasurements *intBool `json:"bandwidthMeasurements,omitempty"`
+		BGPMeasurements       *intBool `json:"bgpMeasurements,omitempty"`
+		FollowRedirects       *intBool `json:"followRedirects,omitempty"`
+		MTUMeasurements       *intBool `json:"mtuMeasurements,omitempty"`
+		NetworkMeasurements   *intBool `json:"networkMeasurements,omitempty"`
+		UseNTLM               *intBool `json:"useNtlm,omitempty"`
+		VerifyCertificate     *intBool `json:"verifyCertificate,omitempty"`
+	}{
+		alias:                 (*alias)(t),
+		AlertsEnabled:         (*intBool)(t.AlertsEnabled),
+		Enabled:               (*intBool)(t.Enabled),
+		SavedEvent:            (*intBool)(t.SavedEvent),
+		LiveShare:             (*intBool)(t.LiveShare),
+		BandwidthMeasurements: (*intBool)(t.BandwidthMeasurements),
+		BGPMeasurements:       (*intBool)(t.BGPMeasurements),
+		FollowRedirects:       (*intBool)(t.FollowRedirects),
+		MTUMeasurements:       (*intBool)(t.MTUMeasurements),
+		NetworkMeasurements:   (*intBool)(t.NetworkMeasurements),
+		UseNTLM:               (*intBool)(t.UseNTLM),
+		VerifyCertificate:     (*intBool)(t.VerifyCertificate),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.AlertsEnabled = (*bool)(aux.AlertsEnabled)
+	t.Enabled = (*bool)(aux.Enabled)
+	t.SavedEvent = (*bool)(aux.SavedEvent)
+	t.LiveShare = (*bool)(aux.LiveShare)
+	t.BandwidthMeasurements = (*bool)(aux.BandwidthMeasurements)
+	t.BGPMeasurements = (*bool)(aux.BGPMeasurements)
+	t.FollowRedirects = (*bool)(aux.FollowRedirects)
+	t.MTUMeasurements = (*bool)(aux.MTUMeasurements)
+	t.NetworkMeasurements = (*bool)(aux.NetworkMeasurements)
+	t.UseNTLM = (*bool)(aux.UseNTLM)
+	t.VerifyCertificate = (*bool)(aux.VerifyCertificate)
+	extra, err := unknownFields(data, jsonFieldsHTTPServer)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
+
+// jsonFieldsIntegration are the lower case JSON keys decoded into the
+// fields of Integration, or ignored
+var jsonFieldsIntegration = map[string]bool{
+	"authmethod":      true,
+	"authtoken":       true,
+	"authuser":        true,
+	"channel":         true,
+	"integrationid":   true,
+	"integrationname": true,
+	"integrationtype": true,
+	"target":          true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (i Integration) MarshalJSON() ([]byte, error) {
+	type alias Integration
+
+	data, err := json.Marshal(alias(i))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, i.Extra, jsonFieldsIntegration)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (i *Integration) UnmarshalJSON(data []byte) error {
+	type alias Integration
+
+	if err := json.Unmarshal(data, (*alias)(i)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsIntegration)
+	if err != nil {
+		return err
+	}
+	i.Extra = extra
+	return nil
+}
+
+// jsonFieldsMonitor are the lower case JSON keys decoded into the
+// fields of Monitor, or ignored
+var jsonFieldsMonitor = map[string]bool{
+	"countryid":   true,
+	"ipaddress":   true,
+	"monitorid":   true,
+	"monitorname": true,
+	"monitortype": true,
+	"network":     true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (m Monitor) MarshalJSON() ([]byte, error) {
+	type alias Monitor
+
+	data, err := json.Marshal(alias(m))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, m.Extra, jsonFieldsMonitor)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (m *Monitor) UnmarshalJSON(data []byte) error {
+	type alias Monitor
+
+	if err := json.Unmarshal(data, (*alias)(m)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsMonitor)
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+// jsonFieldsNotification are the lower case JSON keys decoded into the
+// fields of Notification, or ignored
+var jsonFieldsNotification = map[string]bool{
+	"email":      true,
+	"thirdparty": true,
+	"webhook":    true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (n Notification) MarshalJSON() ([]byte, error) {
+	type alias Notification
+
+	data, err := json.Marshal(alias(n))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, n.Extra, jsonFieldsNotification)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (n *Notification) UnmarshalJSON(data []byte) error {
+	type alias Notification
+
+	if err := json.Unmarshal(data, (*alias)(n)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsNotification)
+	if err != nil {
+		return err
+	}
+	n.Extra = extra
+	return nil
+}
+
+// jsonFieldsNotificationEmail are the lower case JSON keys decoded into the
+// fields of NotificationEmail, or ignored
+var jsonFieldsNotificationEmail = map[string]bool{
+	"message":   true,
+	"recipient": true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (n NotificationEmail) MarshalJSON() ([]byte, error) {
+	type alias NotificationEmail
+
+	data, err := json.Marshal(alias(n))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, n.Extra, jsonFieldsNotificationEmail)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (n *NotificationEmail) UnmarshalJSON(data []byte) error {
+	type alias NotificationEmail
+
+	if err := json.Unmarshal(data, (*alias)(n)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsNotificationEmail)
+	if err != nil {
+		return err
+	}
+	n.Extra = extra
+	return nil
+}
+
+// jsonFieldsNotificationThirdParty are the lower case JSON keys decoded into the
+// fields of NotificationThirdParty, or ignored
+var jsonFieldsNotificationThirdParty = map[string]bool{
+	"integrationid":   true,
+	"integrationtype": true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (n NotificationThirdParty) MarshalJSON() ([]byte, error) {
+	type alias NotificationThirdParty
+
+	data, err := json.Marshal(alias(n))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, n.Extra, jsonFieldsNotificationThirdParty)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (n *NotificationThirdParty) UnmarshalJSON(data []byte) error {
+	type alias NotificationThirdParty
+
+	if err := json.Unmarshal(data, (*alias)(n)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsNotificationThirdParty)
+	if err != nil {
+		return err
+	}
+	n.Extra = extra
+	return nil
+}
+
+// jsonFieldsNotificationWebhook are the lower case JSON keys decoded into the
+// fields of NotificationWebhook, or ignored
+var jsonFieldsNotificationWebhook = map[string]bool{
+	"integrationid":   true,
+	"integrationtype": true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (n NotificationWebhook) MarshalJSON() ([]byte, error) {
+	type alias NotificationWebhook
+
+	data, err := json.Marshal(alias(n))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, n.Extra, jsonFieldsNotificationWebhook)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (n *NotificationWebhook) UnmarshalJSON(data []byte) error {
+	type alias NotificationWebhook
+
+	if err := json.Unmarshal(data, (*alias)(n)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsNotificationWebhook)
+	if err != nil {
+		return err
+	}
+	n.Extra = extra
+	return nil
+}
+
+// jsonFieldsPageLoad are the lower case JSON keys decoded into the
+// fields of PageLoad, or ignored
+var jsonFieldsPageLoad = map[string]bool{
+	"agents":                true,
+	"alertrules":            true,
+	"alertsenabled":         true,
+	"apilinks":              true,
+	"authtype":              true,
+	"bandwidthmeasurements": true,
+	"bgpmeasurements":       true,
+	"bgpmonitors":           true,
+	"contentregex":          true,
+	"createdby":             true,
+	"createddate":           true,
+	"customheaders":         true,
+	"description":           true,
+	"enabled":               true,
+	"followredirects":       true,
+	"groups":                true,
+	"httpinterval":          true,
+	"httptargettime":        true,
+	"httptimelimit":         true,
+	"httpversion":           true,
+	"includeheaders":        true,
+	"interval":              true,
+	"liveshare":             true,
+	"modifiedby":            true,
+	"modifieddate":          true,
+	"mtumeasurements":       true,
+	"networkmeasurements":   true,
+	"numpathtraces":         true,
+	"pageloadtargettime":    true,
+	"pageloadtimelimit":     true,
+	"password":              true,
+	"pathtracemode":         true,
+	"probemode":             true,
+	"protocol":              true,
+	"savedevent":            true,
+	"sharedwithaccounts":    true,
+	"sslversion":            true,
+	"sslversionid":          true,
+	"subinterval":           true,
+	"testid":                true,
+	"testname":              true,
+	"type":                  true,
+	"url":                   true,
+	"usentlm":               true,
+	"usepublicbgp":          true,
+	"useragent":             true,
+	"username":              true,
+	"verifycertificate":     true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (t PageLoad) MarshalJSON() ([]byte, error) {
+	type alias PageLoad
+
+	data, err := json.Marshal(struct {
+		alias
+		AlertsEnabled         *intBool `json:"alertsEnabled,omitempty"`
+		Enabled               *intBool `json:"enabled,omitempty"`
+		SavedEvent            *intBool `json:"savedEvent,omitempty"`
+		LiveShare             *intBool `json:"liveShare,omitempty"`
+		BandwidthMeasurements *intBool `json:"bandwidthMeasurements,omitempty"`
+		BGPMeasurements       *intBool `json:"bgpMeasurements,omitempty"`
+		FollowRedirects       *intBool `json:"followRedirects,omitempty"`
+		IncludeHeaders        *intBool `json:"includeHeaders,omitempty"`
+		MTUMeasurements       *intBool `json:"mtuMeasurements,omitempty"`
+		NetworkMeasurements   *intBool `json:"networkMeasurements,omitempty"`
+		UseNTLM               *intBool `json:"useNtlm,omitempty"`
+		UsePublicBGP          *intBool `json:"usePublicBgp,omitempty"`
+		VerifyCertificate     *intBool `json:"verifyCertificate,omitempty"`
+	}{
+		alias:                 alias(t),
+		AlertsEnabled:         (*intBool)(t.AlertsEnabled),
+		Enabled:               (*intBool)(t.Enabled),
+		SavedEvent:            (*intBool)(t.SavedEvent),
+		LiveShare:             (*intBool)(t.LiveShare),
+		BandwidthMeasurements: (*intBool)(t.BandwidthMeasurements),
+		BGPMeasurements:       (*intBool)(t.BGPMeasurements),
+		FollowRedirects:       (*intBool)(t.FollowRedirects),
+		IncludeHeaders:        (*intBool)(t.IncludeHeaders),
+		MTUMeasurements:       (*intBool)(t.MTUMeasurements),
+		NetworkMeasurements:   (*intBool)(t.NetworkMeasurements),
+		UseNTLM:               (*intBool)(t.UseNTLM),
+		UsePublicBGP:          (*intBool)(t.UsePublicBGP),
+		VerifyCertificate:     (*intBool)(t.VerifyCertificate),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, t.Extra, jsonFieldsPageLoad)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (t *PageLoad) UnmarshalJSON(data []byte) error {
+	type alias PageLoad
+
+	aux := struct {
+		*alias
+		AlertsEnabled         *intBool `json:"alertsEnabled,omitempty"`
+		Enabled               *intBool `json:"enabled,omitempty"`
+		SavedEvent            *intBool `json:"savedEvent,omitempty"`
+		LiveShare             *intBool `json:"liveShare,omitempty"`
+		BandwidthMeasurements *intBool `json:"bandwidthMeasurements,omitempty"`
+		BGPMeasurements       *intBool `json:"bgpMeasurements,omitempty"`
+		FollowRedirects       *intBool `json:"followRedirects,omitempty"`
+		IncludeHeaders        *intBool `json:"includeHeaders,omitempty"`
+		MTUMeasurements       *intBool `json:"mtuMeasurements,omitempty"`
+		NetworkMeasurements   *intBool `json:"networkMeasurements,omitempty"`
+		UseNTLM               *intBool `json:"useNtlm,omitempty"`
+		UsePublicBGP          *intBool `json:"usePublicBgp,omitempty"`
+		VerifyCertificate     *intBool `json:"verifyCertificate,omitempty"`
+	}{
+		alias:                 (*alias)(t),
+		AlertsEnabled:         (*intBool)(t.AlertsEnabled),
+		Enabled:               (*intBool)(t.Enabled),
+		SavedEvent:            (*intBool)(t.SavedEvent),
+		LiveShare:             (*intBool)(t.LiveShare),
+		BandwidthMeasurements: (*intBool)(t.BandwidthMeasurements),
+		BGPMeasurements:       (*intBool)(t.BGPMeasurements),
+		FollowRedirects:       (*intBool)(t.FollowRedirects),
+		IncludeHeaders:        (*intBool)(t.IncludeHeaders),
+		MTUMeasurements:       (*intBool)(t.MTUMeasurements),
+		NetworkMeasurements:   (*intBool)(t.NetworkMeasurements),
+		UseNTLM:               (*intBool)(t.UseNTLM),
+		UsePublicBGP:          (*intBool)(t.UsePublicBGP),
+		VerifyCertificate:     (*intBool)(t.VerifyCertificate),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.AlertsEnabled = (*bool)(aux.AlertsEnabled)
+	t.Enabled = (*bool)(aux.Enabled)
+	t.SavedEvent = (*bool)(aux.SavedEvent)
+	t.LiveShare = (*bool)(aux.LiveShare)
+	t.BandwidthMeasurements = (*bool)(aux.BandwidthMeasurements)
+	t.BGPMeasurements = (*bool)(aux.BGPMeasurements)
+	t.FollowRedirects = (*bool)(aux.FollowRedirects)
+	t.IncludeHeaders = (*bool)(aux.IncludeHeaders)
+	t.MTUMeasurements = (*bool)(aux.MTUMeasurements)
+	t.NetworkMeasurements = (*bool)(aux.NetworkMeasurements)
+	t.UseNTLM = (*bool)(aux.UseNTLM)
+	t.UsePublicBGP = (*bool)(aux.UsePublicBGP)
+	t.VerifyCertificate = (*bool)(aux.VerifyCertificate)
+	extra, err := unknownFields(data, jsonFieldsPageLoad)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
+
+// jsonFieldsPermission are the lower case JSON keys decoded into the
+// fields of Permission, or ignored
+var jsonFieldsPermission = map[string]bool{
+	"ismanagementpermission": true,
+	"label":                  true,
+	"permissionid":           true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (t Permission) MarshalJSON() ([]byte, error) {
+	type alias Permission
+
+	data, err := json.Marshal(struct {
+		alias
+		IsManagementPermission *intBool `json:"isManagementPermission"`
+	}{
+		alias:                  alias(t),
+		IsManagementPermission: (*intBool)(t.IsManagementPermission),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, t.Extra, jsonFieldsPermission)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (t *Permission) UnmarshalJSON(data []byte) error {
+	type alias Permission
+
+	aux := struct {
+		*alias
+		IsManagementPermission *intBool `json:"isManagementPermission"`
+	}{
+		alias:                  (*alias)(t),
+		IsManagementPermission: (*intBool)(t.IsManagementPermission),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.IsManagementPermission = (*bool)(aux.IsManagementPermission)
+	extra, err := unknownFields(data, jsonFieldsPermission)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
+
+// jsonFieldsRTPStream are the lower case JSON keys decoded into the
+// fields of RTPStream, or ignored
+var jsonFieldsRTPStream = map[string]bool{
+	"agents":             true,
+	"alertrules":         true,
+	"alertsenabled":      true,
+	"apilinks":           true,
+	"bgpmeasurements":    true,
+	"bgpmonitors":        true,
+	"codec":              true,
+	"codecid":            true,
+	"createdby":          true,
+	"createddate":        true,
+	"description":        true,
+	"dscp":               true,
+	"dscpid":             true,
+	"duration":           true,
+	"enabled":            true,
+	"groups":             true,
+	"interval":           true,
+	"jitterbuffer":       true,
+	"liveshare":          true,
+	"modifiedby":         true,
+	"modifieddate":       true,
+	"mtumeasurements":    true,
+	"numpathtraces":      true,
+	"savedevent":         true,
+	"server":             true,
+	"sharedwithaccounts": true,
+	"targetagentid":      true,
+	"testid":             true,
+	"testname":           true,
+	"type":               true,
+	"usepublicbgp":       true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (t RTPStream) MarshalJSON() ([]byte, error) {
+	type alias RTPStream
+
+	t.beforeMarshalJSON()
+
+	data, err := json.Marshal(struct {
+		alias
+		AlertsEnabled   *intBool `json:"alertsEnabled,omitempty"`
+		Enabled         *intBool `json:"enabled,omitempty"`
+		SavedEvent      *intBool `json:"savedEvent,omitempty"`
+		LiveShare       *intBool `json:"liveShare,omitempty"`
+		BGPMeasurements *intBool `json:"bgpMeasurements,omitempty"`
+		MTUMeasurements *intBool `json:"mtuMeasurements,omitempty"`
+		UsePublicBGP    *intBool `json:"usePublicBgp,omitempty"`
+	}{
+		alias:           alias(t),
+		AlertsEnabled:   (*intBool)(t.AlertsEnabled),
+		Enabled:         (*intBool)(t.Enabled),
+		SavedEvent:      (*intBool)(t.SavedEvent),
+		LiveShare:       (*intBool)(t.LiveShare),
+		BGPMeasurements: (*intBool)(t.BGPMeasurements),
+		MTUMeasurements: (*intBool)(t.MTUMeasurements),
+		UsePublicBGP:    (*intBool)(t.UsePublicBGP),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, t.Extra, jsonFieldsRTPStream)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (t *RTPStream) UnmarshalJSON(data []byte) error {
+	type alias RTPStream
+
+	aux := struct {
+		*alias
+		AlertsEnabled   *intBool `json:"alertsEnabled,omitempty"`
+		Enabled         *intBool `json:"enabled,omitempty"`
+		SavedEvent      *intBool `json:"savedEvent,omitempty"`
+		LiveShare       *intBool `json:"liveShare,omitempty"`
+		BGPMeasurements *intBool `json:"bgpMeasurements,omitempty"`
+		MTUMeasurements *intBool `json:"mtuMeasurements,omitempty"`
+		UsePublicBGP    *intBool `json:"usePublicBgp,omitempty"`
+	}{
+		alias:           (*alias)(t),
+		AlertsEnabled:   (*intBool)(t.AlertsEnabled),
+		Enabled:         (*intBool)(t.Enabled),
+		SavedEvent:      (*intBool)(t.SavedEvent),
+		LiveShare:       (*intBool)(t.LiveShare),
+		BGPMeasurements: (*intBool)(t.BGPMeasurements),
+		MTUMeasurements: (*intBool)(t.MTUMeasurements),
+		UsePublicBGP:    (*intBool)(t.UsePublicBGP),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.AlertsEnabled = (*bool)(aux.AlertsEnabled)
+	t.Enabled = (*bool)(aux.Enabled)
+	t.SavedEvent = (*bool)(aux.SavedEvent)
+	t.LiveShare = (*bool)(aux.LiveShare)
+	t.BGPMeasurements = (*bool)(aux.BGPMeasurements)
+	t.MTUMeasurements = (*bool)(aux.MTUMeasurements)
+	t.UsePublicBGP = (*bool)(aux.UsePublicBGP)
+	extra, err := unknownFields(data, jsonFieldsRTPStream)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	t.afterUnmarshalJSON()
+	return nil
+}
+
+// jsonFieldsSIPAuthData are the lower case JSON keys decoded into the
+// fields of SIPAuthData, or ignored
+var jsonFieldsSIPAuthData = map[string]bool{
+	"authuser":      true,
+	"credentialsid": true,
+	"password":      true,
+	"port":          true,
+	"protocol":      true,
+	"sipproxy":      true,
+	"sipregistrar":  true,
+	"user":          true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (s SIPAuthData) MarshalJSON() ([]byte, error) {
+	type alias SIPAuthData
+
+	data, err := json.Marshal(alias(s))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, s.Extra, jsonFieldsSIPAuthData)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (s *SIPAuthData) UnmarshalJSON(data []byte) error {
+	type alias SIPAuthData
+
+	if err := json.Unmarshal(data, (*alias)(s)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsSIPAuthData)
+	if err != nil {
+		return err
+	}
+	s.Extra = extra
+	return nil
+}
+
+// jsonFieldsSIPServer are the lower case JSON keys decoded into the
+// fields of SIPServer, or ignored
+var jsonFieldsSIPServer = map[string]bool{
+	"agents":                true,
+	"alertrules":            true,
+	"alertsenabled":         true,
+	"apilinks":              true,
+	"bandwidthmeasurements": true,
+	"bgpmeasurements":       true,
+	"createdby":             true,
+	"createddate":           true,
+	"description":           true,
+	"enabled":               true,
+	"groups":                true,
+	"interval":              true,
+	"liveshare":             true,
+	"modifiedby":            true,
+	"modifieddate":          true,
+	"mtumeasurements":       true,
+	"networkmeasurements":   true,
+	"numpathtraces":         true,
+	"options_regex":         true,
+	"pathtracemode":         true,
+	"probemode":             true,
+	"registerenabled":       true,
+	"savedevent":            true,
+	"sharedwithaccounts":    true,
+	"siptargettime":         true,
+	"siptimelimit":          true,
+	"targetsipcredentials":  true,
+	"testid":                true,
+	"testname":              true,
+	"type":                  true,
+	"usepublicbgp":          true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (t SIPServer) MarshalJSON() ([]byte, error) {
+	type alias SIPServer
+
+	data, err := json.Marshal(struct {
+		alias
+		AlertsEnabled         *intBool `json:"alertsEnabled,omitempty"`
+		Enabled               *intBool `json:"enabled,omitempty"`
+		SavedEvent            *intBool `json:"savedEvent,omitempty"`
+		LiveShare             *intBool `json:"liveShare,omitempty"`
+		BandwidthMeasurements *intBool `json:"bandwidthMeasurements,omitempty"`
+		BGPMeasurements       *intBool `json:"bgpMeasurements,omitempty"`
+		MTUMeasurements       *intBool `json:"mtuMeasurements,omitempty"`
+		NetworkMeasurements   *intBool `json:"networkMeasurements,omitempty"`
+		RegisterEnabled       *intBool `json:"registerEnabled,omitempty"`
+		UsePublicBGP          *intBool `json:"usePublicBgp,omitempty"`
+	}{
+		alias:                 alias(t),
+		AlertsEnabled:         (*intBool)(t.AlertsEnabled),
+		Enabled:               (*intBool)(t.Enabled),
+		SavedEvent:            (*intBool)(t.SavedEvent),
+		LiveShare:             (*intBool)(t.LiveShare),
+		BandwidthMeasurements: (*intBool)(t.BandwidthMeasurements),
+		BGPMeasurements:       (*intBool)(t.BGPMeasurements),
+		MTUMeasurements:       (*intBool)(t.MTUMeasurements),
+		NetworkMeasurements:   (*intBool)(t.NetworkMeasurements),
+		RegisterEnabled:       (*intBool)(t.RegisterEnabled),
+		UsePublicBGP:          (*intBool)(t.UsePublicBGP),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, t.Extra, jsonFieldsSIPServer)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (t *SIPServer) UnmarshalJSON(data []byte) error {
+	type alias SIPServer
+
+	aux := struct {
+		*alias
+		AlertsEnabled         *intBool `json:"alertsEnabled,omitempty"`
+		Enabled               *intBool `json:"enabled,omitempty"`
+		SavedEvent            *intBool `json:"savedEvent,omitempty"`
+		LiveShare             *intBool `json:"liveShare,omitempty"`
+		BandwidthMeasurements *intBool `json:"bandwidthMeasurements,omitempty"`
+		BGPMeasurements       *intBool `json:"bgpMeasurements,omitempty"`
+		MTUMeasurements       *intBool `json:"mtuMeasurements,omitempty"`
+		NetworkMeasurements   *intBool `json:"networkMeasurements,omitempty"`
+		RegisterEnabled       *intBool `json:"registerEnabled,omitempty"`
+		UsePublicBGP          *intBool `json:"usePublicBgp,omitempty"`
+	}{
+		alias:                 (*alias)(t),
+		AlertsEnabled:         (*intBool)(t.AlertsEnabled),
+		Enabled:               (*intBool)(t.Enabled),
+		SavedEvent:            (*intBool)(t.SavedEvent),
+		LiveShare:             (*intBool)(t.LiveShare),
+		BandwidthMeasurements: (*intBool)(t.BandwidthMeasurements),
+		BGPMeasurements:       (*intBool)(t.BGPMeasurements),
+		MTUMeasurements:       (*intBool)(t.MTUMeasurements),
+		NetworkMeasurements:   (*intBool)(t.NetworkMeasurements),
+		RegisterEnabled:       (*intBool)(t.RegisterEnabled),
+		UsePublicBGP:          (*intBool)(t.UsePublicBGP),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.AlertsEnabled = (*bool)(aux.AlertsEnabled)
+	t.Enabled = (*bool)(aux.Enabled)
+	t.SavedEvent = (*bool)(aux.SavedEvent)
+	t.LiveShare = (*bool)(aux.LiveShare)
+	t.BandwidthMeasurements = (*bool)(aux.BandwidthMeasurements)
+	t.BGPMeasurements = (*bool)(aux.BGPMeasurements)
+	t.MTUMeasurements = (*bool)(aux.MTUMeasurements)
+	t.NetworkMeasurements = (*bool)(aux.NetworkMeasurements)
+	t.RegisterEnabled = (*bool)(aux.RegisterEnabled)
+	t.UsePublicBGP = (*bool)(aux.UsePublicBGP)
+	extra, err := unknownFields(data, jsonFieldsSIPServer)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
+
+// jsonFieldsServer are the lower case JSON keys decoded into the
+// fields of Server, or ignored
+var jsonFieldsServer = map[string]bool{
+	"serverid":   true,
+	"servername": true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (s Server) MarshalJSON() ([]byte, error) {
+	type alias Server
+
+	data, err := json.Marshal(alias(s))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, s.Extra, jsonFieldsServer)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (s *Server) UnmarshalJSON(data []byte) error {
+	type alias Server
+
+	if err := json.Unmarshal(data, (*alias)(s)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsServer)
+	if err != nil {
+		return err
+	}
+	s.Extra = extra
+	return nil
+}
+
+// jsonFieldsSharedWithAccount are the lower case JSON keys decoded into the
+// fields of SharedWithAccount, or ignored
+var jsonFieldsSharedWithAccount = map[string]bool{
+	"aid":  true,
+	"name": true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (s SharedWithAccount) MarshalJSON() ([]byte, error) {
+	type alias SharedWithAccount
+
+	data, err := json.Marshal(alias(s))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, s.Extra, jsonFieldsSharedWithAccount)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (s *SharedWithAccount) UnmarshalJSON(data []byte) error {
+	type alias SharedWithAccount
+
+	if err := json.Unmarshal(data, (*alias)(s)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsSharedWithAccount)
+	if err != nil {
+		return err
+	}
+	s.Extra = extra
+	return nil
+}
+
+// jsonFieldsSnapshot are the lower case JSON keys decoded into the
+// fields of Snapshot, or ignored
+var jsonFieldsSnapshot = map[string]bool{
+	"createdby":      true,
+	"createddate":    true,
+	"displayname":    true,
+	"expirationdate": true,
+	"from":           true,
+	"ispublic":       true,
+	"snapshotid":     true,
+	"testid":         true,
+	"to":             true,
+	"url":            true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (t Snapshot) MarshalJSON() ([]byte, error) {
+	type alias Snapshot
+
+	data, err := json.Marshal(struct {
+		alias
+		IsPublic *intBool `json:"isPublic,omitempty"`
+	}{
+		alias:    alias(t),
+		IsPublic: (*intBool)(t.IsPublic),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, t.Extra, jsonFieldsSnapshot)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (t *Snapshot) UnmarshalJSON(data []byte) error {
+	type alias Snapshot
+
+	aux := struct {
+		*alias
+		IsPublic *intBool `json:"isPublic,omitempty"`
+	}{
+		alias:    (*alias)(t),
+		IsPublic: (*intBool)(t.IsPublic),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.IsPublic = (*bool)(aux.IsPublic)
+	extra, err := unknownFields(data, jsonFieldsSnapshot)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
+
+// jsonFieldsTestUsage are the lower case JSON keys decoded into the
+// fields of TestUsage, or ignored
+var jsonFieldsTestUsage = map[string]bool{
+	"accountgroupname":         true,
+	"aid":                      true,
+	"cloudunitsprojected":      true,
+	"cloudunitsused":           true,
+	"enterpriseunitsprojected": true,
+	"enterpriseunitsused":      true,
+	"testid":                   true,
+	"testname":                 true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (t TestUsage) MarshalJSON() ([]byte, error) {
+	type alias TestUsage
+
+	data, err := json.Marshal(alias(t))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, t.Extra, jsonFieldsTestUsage)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (t *TestUsage) UnmarshalJSON(data []byte) error {
+	type alias TestUsage
+
+	if err := json.Unmarshal(data, (*alias)(t)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsTestUsage)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
+
+// jsonFieldsUsage are the lower case JSON keys decoded into the
+// fields of Usage, or ignored
+var jsonFieldsUsage = map[string]bool{
+	"cloudunitsnextbillingperiod": true,
+	"cloudunitsprojected":         true,
+	"cloudunitsused":              true,
+	"endpointagents":              true,
+	"endpointagentsused":          true,
+	"enterpriseagents":            true,
+	"enterpriseagentsused":        true,
+	"enterpriseunitsprojected":    true,
+	"enterpriseunitsused":         true,
+	"month":                       true,
+	"quota":                       true,
+	"tests":                       true,
+	"usagedate":                   true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (u Usage) MarshalJSON() ([]byte, error) {
+	type alias Usage
+
+	data, err := json.Marshal(alias(u))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, u.Extra, jsonFieldsUsage)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (u *Usage) UnmarshalJSON(data []byte) error {
+	type alias Usage
+
+	if err := json.Unmarshal(data, (*alias)(u)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsUsage)
+	if err != nil {
+		return err
+	}
+	u.Extra = extra
+	return nil
+}
+
+// jsonFieldsUsageQuota are the lower case JSON keys decoded into the
+// fields of UsageQuota, or ignored
+var jsonFieldsUsageQuota = map[string]bool{
+	"cloudunitsincluded":       true,
+	"endpointagentsincluded":   true,
+	"enterpriseagentsincluded": true,
+	"monthend":                 true,
+	"monthstart":               true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (u UsageQuota) MarshalJSON() ([]byte, error) {
+	type alias UsageQuota
+
+	data, err := json.Marshal(alias(u))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, u.Extra, jsonFieldsUsageQuota)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (u *UsageQuota) UnmarshalJSON(data []byte) error {
+	type alias UsageQuota
+
+	if err := json.Unmarshal(data, (*alias)(u)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsUsageQuota)
+	if err != nil {
+		return err
+	}
+	u.Extra = extra
+	return nil
+}
+
+// jsonFieldsUser are the lower case JSON keys decoded into the
+// fields of User, or ignored
+var jsonFieldsUser = map[string]bool{
+	"accountgrouproles":    true,
+	"allaccountgrouproles": true,
+	"dateregistered":       true,
+	"email":                true,
+	"lastlogin":            true,
+	"loginaccountgroup":    true,
+	"name":                 true,
+	"uid":                  true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (u User) MarshalJSON() ([]byte, error) {
+	type alias User
+
+	data, err := json.Marshal(alias(u))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, u.Extra, jsonFieldsUser)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (u *User) UnmarshalJSON(data []byte) error {
+	type alias User
+
+	if err := json.Unmarshal(data, (*alias)(u)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsUser)
+	if err != nil {
+		return err
+	}
+	u.Extra = extra
+	return nil
+}
+
+// jsonFieldsUserAccountGroupRole are the lower case JSON keys decoded into the
+// fields of UserAccountGroupRole, or ignored
+var jsonFieldsUserAccountGroupRole = map[string]bool{
+	"accountgroup": true,
+	"roles":        true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (u UserAccountGroupRole) MarshalJSON() ([]byte, error) {
+	type alias UserAccountGroupRole
+
+	data, err := json.Marshal(alias(u))
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, u.Extra, jsonFieldsUserAccountGroupRole)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (u *UserAccountGroupRole) UnmarshalJSON(data []byte) error {
+	type alias UserAccountGroupRole
+
+	if err := json.Unmarshal(data, (*alias)(u)); err != nil {
+		return err
+	}
+	extra, err := unknownFields(data, jsonFieldsUserAccountGroupRole)
+	if err != nil {
+		return err
+	}
+	u.Extra = extra
+	return nil
+}
+
+// jsonFieldsVoiceCall are the lower case JSON keys decoded into the
+// fields of VoiceCall, or ignored
+var jsonFieldsVoiceCall = map[string]bool{
+	"agents":               true,
+	"alertrules":           true,
+	"alertsenabled":        true,
+	"apilinks":             true,
+	"bgpmeasurements":      true,
+	"codec":                true,
+	"codecid":              true,
+	"createdby":            true,
+	"createddate":          true,
+	"description":          true,
+	"dscp":                 true,
+	"dscpid":               true,
+	"duration":             true,
+	"enabled":              true,
+	"groups":               true,
+	"interval":             true,
+	"jitterbuffer":         true,
+	"liveshare":            true,
+	"modifiedby":           true,
+	"modifieddate":         true,
+	"numpathtraces":        true,
+	"savedevent":           true,
+	"sharedwithaccounts":   true,
+	"siptargettime":        true,
+	"siptimelimit":         true,
+	"sourcesipcredentials": true,
+	"targetagentid":        true,
+	"targetsipcredentials": true,
+	"testid":               true,
+	"testname":             true,
+	"type":                 true,
+	"usepublicbgp":         true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (t VoiceCall) MarshalJSON() ([]byte, error) {
+	type alias VoiceCall
+
+	t.beforeMarshalJSON()
+
+	data, err := json.Marshal(struct {
+		alias
+		AlertsEnabled   *intBool `json:"alertsEnabled,omitempty"`
+		Enabled         *intBool `json:"enabled,omitempty"`
+		SavedEvent      *intBool `json:"savedEvent,omitempty"`
+		LiveShare       *intBool `json:"liveShare,omitempty"`
+		BGPMeasurements *intBool `json:"bgpMeasurements,omitempty"`
+		UsePublicBGP    *intBool `json:"usePublicBgp,omitempty"`
+	}{
+		alias:           alias(t),
+		AlertsEnabled:   (*intBool)(t.AlertsEnabled),
+		Enabled:         (*intBool)(t.Enabled),
+		SavedEvent:      (*intBool)(t.SavedEvent),
+		LiveShare:       (*intBool)(t.LiveShare),
+		BGPMeasurements: (*intBool)(t.BGPMeasurements),
+		UsePublicBGP:    (*intBool)(t.UsePublicBGP),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, t.Extra, jsonFieldsVoiceCall)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (t *VoiceCall) UnmarshalJSON(data []byte) error {
+	type alias VoiceCall
+
+	aux := struct {
+		*alias
+		AlertsEnabled   *intBool `json:"alertsEnabled,omitempty"`
+		Enabled         *intBool `json:"enabled,omitempty"`
+		SavedEvent      *intBool `json:"savedEvent,omitempty"`
+		LiveShare       *intBool `json:"liveShare,omitempty"`
+		BGPMeasurements *intBool `json:"bgpMeasurements,omitempty"`
+		UsePublicBGP    *intBool `json:"usePublicBgp,omitempty"`
+	}{
+		alias:           (*alias)(t),
+		AlertsEnabled:   (*intBool)(t.AlertsEnabled),
+		Enabled:         (*intBool)(t.Enabled),
+		SavedEvent:      (*intBool)(t.SavedEvent),
+		LiveShare:       (*intBool)(t.LiveShare),
+		BGPMeasurements: (*intBool)(t.BGPMeasurements),
+		UsePublicBGP:    (*intBool)(t.UsePublicBGP),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.AlertsEnabled = (*bool)(aux.AlertsEnabled)
+	t.Enabled = (*bool)(aux.Enabled)
+	t.SavedEvent = (*bool)(aux.SavedEvent)
+	t.LiveShare = (*bool)(aux.LiveShare)
+	t.BGPMeasurements = (*bool)(aux.BGPMeasurements)
+	t.UsePublicBGP = (*bool)(aux.UsePublicBGP)
+	extra, err := unknownFields(data, jsonFieldsVoiceCall)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	t.afterUnmarshalJSON()
+	return nil
+}
+
+// jsonFieldsWebTransaction are the lower case JSON keys decoded into the
+// fields of WebTransaction, or ignored
+var jsonFieldsWebTransaction = map[string]bool{
+	"agents":                true,
+	"alertrules":            true,
+	"alertsenabled":         true,
+	"apilinks":              true,
+	"authtype":              true,
+	"bandwidthmeasurements": true,
+	"contentregex":          true,
+	"createdby":             true,
+	"createddate":           true,
+	"credentials":           true,
+	"customheaders":         true,
+	"description":           true,
+	"desiredstatuscode":     true,
+	"enabled":               true,
+	"groups":                true,
+	"httptargettime":        true,
+	"httptimelimit":         true,
+	"httpversion":           true,
+	"includeheaders":        true,
+	"interval":              true,
+	"liveshare":             true,
+	"modifiedby":            true,
+	"modifieddate":          true,
+	"mtumeasurements":       true,
+	"networkmeasurements":   true,
+	"numpathtraces":         true,
+	"password":              true,
+	"pathtracemode":         true,
+	"probemode":             true,
+	"protocol":              true,
+	"savedevent":            true,
+	"sharedwithaccounts":    true,
+	"sslversionid":          true,
+	"subinterval":           true,
+	"targettime":            true,
+	"testid":                true,
+	"testname":              true,
+	"timelimit":             true,
+	"transactionscript":     true,
+	"type":                  true,
+	"url":                   true,
+	"usentlm":               true,
+	"useragent":             true,
+	"username":              true,
+	"verifycertificate":     true,
+}
+
+// MarshalJSON implements the json.Marshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and encodes the Extra fields.
+func (t WebTransaction) MarshalJSON() ([]byte, error) {
+	type alias WebTransaction
+
+	data, err := json.Marshal(struct {
+		alias
+		AlertsEnabled         *intBool `json:"alertsEnabled,omitempty"`
+		Enabled               *intBool `json:"enabled,omitempty"`
+		SavedEvent            *intBool `json:"savedEvent,omitempty"`
+		LiveShare             *intBool `json:"liveShare,omitempty"`
+		BandwidthMeasurements *intBool `json:"bandwidthMeasurements,omitempty"`
+		IncludeHeaders        *intBool `json:"includeHeaders,omitempty"`
+		MTUMeasurements       *intBool `json:"mtuMeasurements,omitempty"`
+		NetworkMeasurements   *intBool `json:"networkMeasurements,omitempty"`
+		UseNTLM               *intBool `json:"useNtlm,omitempty"`
+		VerifyCertificate     *intBool `json:"verifyCertificate,omitempty"`
+	}{
+		alias:                 alias(t),
+		AlertsEnabled:         (*intBool)(t.AlertsEnabled),
+		Enabled:               (*intBool)(t.Enabled),
+		SavedEvent:            (*intBool)(t.SavedEvent),
+		LiveShare:             (*intBool)(t.LiveShare),
+		BandwidthMeasurements: (*intBool)(t.BandwidthMeasurements),
+		IncludeHeaders:        (*intBool)(t.IncludeHeaders),
+		MTUMeasurements:       (*intBool)(t.MTUMeasurements),
+		NetworkMeasurements:   (*intBool)(t.NetworkMeasurements),
+		UseNTLM:               (*intBool)(t.UseNTLM),
+		VerifyCertificate:     (*intBool)(t.VerifyCertificate),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return appendExtraFields(data, t.Extra, jsonFieldsWebTransaction)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
+// that ThousandEyes int fields that only use the values 0 or 1 are
+// treated as booleans, and keeps unknown fields in Extra.
+func (t *WebTransaction) UnmarshalJSON(data []byte) error {
+	type alias WebTransaction
+
+	aux := struct {
+		*alias
+		AlertsEnabled         *intBool `json:"alertsEnabled,omitempty"`
+		Enabled               *intBool `json:"enabled,omitempty"`
+		SavedEvent            *intBool `json:"savedEvent,omitempty"`
+		LiveShare             *intBool `json:"liveShare,omitempty"`
+		BandwidthMeasurements *intBool `json:"bandwidthMeasurements,omitempty"`
+		IncludeHeaders        *intBool `json:"includeHeaders,omitempty"`
+		MTUMeasurements       *intBool `json:"mtuMeasurements,omitempty"`
+		NetworkMeasurements   *intBool `json:"networkMeasurements,omitempty"`
+		UseNTLM               *intBool `json:"useNtlm,omitempty"`
+		VerifyCertificate     *intBool `json:"verifyCertificate,omitempty"`
+	}{
+		alias:                 (*alias)(t),
+		AlertsEnabled:         (*intBool)(t.AlertsEnabled),
+		Enabled:               (*intBool)(t.Enabled),
+		SavedEvent:            (*intBool)(t.SavedEvent),
+		LiveShare:             (*intBool)(t.LiveShare),
+		BandwidthMeasurements: (*intBool)(t.BandwidthMeasurements),
+		IncludeHeaders:        (*intBool)(t.IncludeHeaders),
+		MTUMeasurements:       (*intBool)(t.MTUMeasurements),
+		NetworkMeasurements:   (*intBool)(t.NetworkMeasurements),
+		UseNTLM:               (*intBool)(t.UseNTLM),
+		VerifyCertificate:     (*intBool)(t.VerifyCertificate),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.AlertsEnabled = (*bool)(aux.AlertsEnabled)
+	t.Enabled = (*bool)(aux.Enabled)
+	t.SavedEvent = (*bool)(aux.SavedEvent)
+	t.LiveShare = (*bool)(aux.LiveShare)
+	t.BandwidthMeasurements = (*bool)(aux.BandwidthMeasurements)
+	t.IncludeHeaders = (*bool)(aux.IncludeHeaders)
+	t.MTUMeasurements = (*bool)(aux.MTUMeasurements)
+	t.NetworkMeasurements = (*bool)(aux.NetworkMeasurements)
+	t.UseNTLM = (*bool)(aux.UseNTLM)
+	t.VerifyCertificate = (*bool)(aux.VerifyCertificate)
+	extra, err := unknownFields(data, jsonFieldsWebTransaction)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
@@ -1,5 +1,7 @@
 package thousandeyes
 
+import "encoding/json"
+
 // Monitors - List of monitor
 type Monitors []Monitor
 
@@ -11,4 +13,7 @@ type Monitor struct {
 	MonitorName *string `json:"monitorName,omitempty"`
 	Network     *string `json:"network,omitempty"`
 	MonitorType *string `json:"monitorType,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
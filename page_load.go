@@ -27,7 +27,7 @@ type PageLoad struct {
 
 	// Fields unique to this test
 	Agents                *[]Agent       `json:"agents,omitempty"`
-	AuthType              *string        `json:"authType,omitempty"`
+	AuthType              *AuthType      `json:"authType,omitempty"`
 	BandwidthMeasurements *bool          `json:"bandwidthMeasurements,omitempty" te:"int-bool"`
 	BGPMeasurements       *bool          `json:"bgpMeasurements,omitempty" te:"int-bool"`
 	BGPMonitors           *[]BGPMonitor  `json:"bgpMonitors,omitempty"`
@@ -46,9 +46,9 @@ type PageLoad struct {
 	PageLoadTargetTime    *int           `json:"pageLoadTargetTime,omitempty"`
 	PageLoadTimeLimit     *int           `json:"pageLoadTimeLimit,omitempty"`
 	Password              *string        `json:"password,omitempty"`
-	PathTraceMode         *string        `json:"pathTraceMode,omitempty"`
-	ProbeMode             *string        `json:"probeMode,omitempty"`
-	Protocol              *string        `json:"protocol,omitempty"`
+	PathTraceMode         *PathTraceMode `json:"pathTraceMode,omitempty"`
+	ProbeMode             *ProbeMode     `json:"probeMode,omitempty"`
+	Protocol              *Protocol      `json:"protocol,omitempty"`
 	SSLVersion            *string        `json:"sslVersion,omitempty"`
 	SSLVersionID          *int           `json:"sslVersionId,omitempty"`
 	Subinterval           *int           `json:"subinterval,omitempty"`
@@ -58,40 +58,17 @@ type PageLoad struct {
 	UserAgent             *string        `json:"userAgent,omitempty"`
 	Username              *string        `json:"username,omitempty"`
 	VerifyCertificate     *bool          `json:"verifyCertificate,omitempty" te:"int-bool"`
-}
-
-// MarshalJSON implements the json.Marshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t PageLoad) MarshalJSON() ([]byte, error) {
-	type aliasTest PageLoad
 
-	data, err := json.Marshal((aliasTest)(t))
-	if err != nil {
-		return nil, err
-	}
-
-	return jsonBoolToInt(&t, data)
-}
-
-// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t *PageLoad) UnmarshalJSON(data []byte) error {
-	type aliasTest PageLoad
-	test := (*aliasTest)(t)
-
-	data, err := jsonIntToBool(t, data)
-	if err != nil {
-		return err
-	}
-
-	return json.Unmarshal(data, &test)
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // AddAgent  - add an aget
 func (t *PageLoad) AddAgent(id int) {
 	agent := Agent{AgentID: Int(id)}
+	if t.Agents == nil {
+		t.Agents = &[]Agent{}
+	}
 	*t.Agents = append(*t.Agents, agent)
 }
 
@@ -30,7 +30,7 @@ func TestClient_CreatePageLoad(t *testing.T) {
 		Interval:              Int(300),
 		HTTPInterval:          Int(300),
 		URL:                   String("https://test.com"),
-		Protocol:              String("TCP"),
+		Protocol:              Protocol("TCP").Ptr(),
 		FollowRedirects:       Bool(true),
 		NetworkMeasurements:   Bool(true),
 		MTUMeasurements:       Bool(true),
@@ -48,8 +48,8 @@ func TestClient_CreatePageLoad(t *testing.T) {
 		SSLVersionID:          Int(0),
 		VerifyCertificate:     Bool(true),
 		UseNTLM:               Bool(false),
-		AuthType:              String("NONE"),
-		ProbeMode:             String("AUTO"),
+		AuthType:              AuthType("NONE").Ptr(),
+		ProbeMode:             ProbeMode("AUTO").Ptr(),
 		ContentRegex:          String(""),
 		Agents: &[]Agent{
 			{
@@ -74,6 +74,7 @@ func TestClient_CreatePageLoad(t *testing.T) {
 				MonitorID:   Int(62),
 				IPAddress:   String("2001:1890:111d:1::63"),
 				MonitorName: String("New York, NY-6"),
+				CountryID:   String("US"),
 				Network:     String("AT&T Services, Inc. (AS 7018)"),
 				MonitorType: String("Public"),
 			},
@@ -137,7 +138,7 @@ func TestClient_GetPageLoad(t *testing.T) {
 		Interval:              Int(300),
 		HTTPInterval:          Int(300),
 		URL:                   String("https://test.com"),
-		Protocol:              String("TCP"),
+		Protocol:              Protocol("TCP").Ptr(),
 		FollowRedirects:       Bool(true),
 		NetworkMeasurements:   Bool(true),
 		MTUMeasurements:       Bool(true),
@@ -155,8 +156,8 @@ func TestClient_GetPageLoad(t *testing.T) {
 		SSLVersionID:          Int(0),
 		VerifyCertificate:     Bool(true),
 		UseNTLM:               Bool(false),
-		AuthType:              String("NONE"),
-		ProbeMode:             String("AUTO"),
+		AuthType:              AuthType("NONE").Ptr(),
+		ProbeMode:             ProbeMode("AUTO").Ptr(),
 		ContentRegex:          String(""),
 		Agents: &[]Agent{
 			{
@@ -181,6 +182,7 @@ func TestClient_GetPageLoad(t *testing.T) {
 				MonitorID:   Int(62),
 				IPAddress:   String("2001:1890:111d:1::63"),
 				MonitorName: String("New York, NY-6"),
+				CountryID:   String("US"),
 				Network:     String("AT&T Services, Inc. (AS 7018)"),
 				MonitorType: String("Public"),
 			},
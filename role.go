@@ -12,6 +12,9 @@ type AccountGroupRole struct {
 	HasManagementPermissions *bool         `json:"hasManagementPermissions,omitempty" te:"int-bool"`
 	Builtin                  *bool         `json:"builtin,omitempty" te:"int-bool"`
 	Permissions              *[]Permission `json:"permissions,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // Permission - permission attached to roles
@@ -19,64 +22,9 @@ type Permission struct {
 	IsManagementPermission *bool   `json:"isManagementPermission" te:"int-bool"`
 	Label                  *string `json:"label"`
 	PermissionID           *int    `json:"permissionId"`
-}
-
-// MarshalJSON implements the json.Marshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t AccountGroupRole) MarshalJSON() ([]byte, error) {
-	type alias AccountGroupRole
-
-	data, err := json.Marshal((alias)(t))
-	if err != nil {
-		return nil, err
-	}
 
-	return jsonBoolToInt(&t, data)
-}
-
-// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t *AccountGroupRole) UnmarshalJSON(data []byte) error {
-	type alias AccountGroupRole
-	test := (*alias)(t)
-
-	data, err := jsonIntToBool(t, data)
-	if err != nil {
-		return err
-	}
-
-	return json.Unmarshal(data, &test)
-}
-
-// MarshalJSON implements the json.Marshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t Permission) MarshalJSON() ([]byte, error) {
-	type alias Permission
-
-	data, err := json.Marshal((alias)(t))
-	if err != nil {
-		return nil, err
-	}
-
-	return jsonBoolToInt(&t, data)
-}
-
-// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t *Permission) UnmarshalJSON(data []byte) error {
-	type alias Permission
-	test := (*alias)(t)
-
-	data, err := jsonIntToBool(t, data)
-	if err != nil {
-		return err
-	}
-
-	return json.Unmarshal(data, &test)
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetRoles - get roles
@@ -150,3 +98,88 @@ func (c *Client) CreateRole(user AccountGroupRole) (*AccountGroupRole, error) {
 	}
 	return &target, nil
 }
+
+// GetPermissions - get all permissions that can be assigned to roles
+func (c *Client) GetPermissions() (*[]Permission, error) {
+	resp, err := c.get("/permissions")
+	if err != nil {
+		return nil, err
+	}
+	var target map[string][]Permission
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("could not decode JSON response: %v", dErr)
+	}
+	permissions := target["permissions"]
+	return &permissions, nil
+}
+
+// RolePermissionDiff - permissions granted and revoked between two sets of permissions
+type RolePermissionDiff struct {
+	Added   []Permission
+	Removed []Permission
+}
+
+// Empty reports whether both sides grant the same permissions.
+func (d RolePermissionDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0
+}
+
+// AddsManagementPermissions reports whether any added permission is a
+// management permission.
+func (d RolePermissionDiff) AddsManagementPermissions() bool {
+	for _, p := range d.Added {
+		if p.IsManagementPermission != nil && *p.IsManagementPermission {
+			return true
+		}
+	}
+	return false
+}
+
+// DiffPermissions - compare two permission lists.  Permissions are matched by
+// ID, or by label when no ID is set.  Added holds permissions in to but not in
+// from; Removed holds permissions in from but not in to.
+func DiffPermissions(from, to []Permission) RolePermissionDiff {
+	key := func(p Permission) string {
+		if p.PermissionID != nil {
+			return fmt.Sprintf("id:%d", *p.PermissionID)
+		}
+		if p.Label != nil {
+			return "label:" + *p.Label
+		}
+		return ""
+	}
+	index := func(perms []Permission) map[string]bool {
+		m := map[string]bool{}
+		for _, p := range perms {
+			m[key(p)] = true
+		}
+		return m
+	}
+	fromKeys, toKeys := index(from), index(to)
+
+	diff := RolePermissionDiff{}
+	for _, p := range to {
+		if !fromKeys[key(p)] {
+			diff.Added = append(diff.Added, p)
+		}
+	}
+	for _, p := range from {
+		if !toKeys[key(p)] {
+			diff.Removed = append(diff.Removed, p)
+		}
+	}
+	return diff
+}
+
+// DiffRolePermissions - compare the permissions of two roles, for example a
+// live custom role against the template it was created from.
+func DiffRolePermissions(from, to AccountGroupRole) RolePermissionDiff {
+	var fromPerms, toPerms []Permission
+	if from.Permissions != nil {
+		fromPerms = *from.Permissions
+	}
+	if to.Permissions != nil {
+		toPerms = *to.Permissions
+	}
+	return DiffPermissions(fromPerms, toPerms)
+}
@@ -236,3 +236,59 @@ func TestClient_CreateRoleJsonError(t *testing.T) {
 	assert.Error(t, err)
 	assert.EqualError(t, err, "could not decode JSON response: invalid character 'e' in literal true (expecting 'r')")
 }
+
+func TestClient_GetPermissions(t *testing.T) {
+	setup()
+	out := `{"permissions": [{"isManagementPermission": 0, "label": "View tests", "permissionId": 1}, {"isManagementPermission": 1, "label": "Edit users", "permissionId": 2}]}`
+	mux.HandleFunc("/permissions.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		_, _ = w.Write([]byte(out))
+	})
+
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+
+	res, err := client.GetPermissions()
+	teardown()
+	assert.Nil(t, err)
+	expected := []Permission{
+		{IsManagementPermission: Bool(false), Label: String("View tests"), PermissionID: Int(1)},
+		{IsManagementPermission: Bool(true), Label: String("Edit users"), PermissionID: Int(2)},
+	}
+	assert.Equal(t, &expected, res)
+}
+
+func TestClient_GetPermissionsJsonError(t *testing.T) {
+	setup()
+	mux.HandleFunc("/permissions.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"permissions": [test]}`))
+	})
+
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+
+	_, err := client.GetPermissions()
+	teardown()
+	assert.EqualError(t, err, "could not decode JSON response: invalid character 'e' in literal true (expecting 'r')")
+}
+
+func TestDiffRolePermissions(t *testing.T) {
+	view := Permission{IsManagementPermission: Bool(false), Label: String("View tests"), PermissionID: Int(1)}
+	edit := Permission{IsManagementPermission: Bool(true), Label: String("Edit users"), PermissionID: Int(2)}
+	alerts := Permission{IsManagementPermission: Bool(false), Label: String("Edit alert rules"), PermissionID: Int(3)}
+
+	template := AccountGroupRole{RoleName: String("template"), Permissions: &[]Permission{view, alerts}}
+	live := AccountGroupRole{RoleName: String("live"), Permissions: &[]Permission{view, edit}}
+
+	diff := DiffRolePermissions(template, live)
+	assert.Equal(t, []Permission{edit}, diff.Added)
+	assert.Equal(t, []Permission{alerts}, diff.Removed)
+	assert.False(t, diff.Empty())
+	assert.True(t, diff.AddsManagementPermissions())
+
+	diff = DiffRolePermissions(live, live)
+	assert.True(t, diff.Empty())
+	assert.False(t, diff.AddsManagementPermissions())
+
+	diff = DiffRolePermissions(AccountGroupRole{}, template)
+	assert.Equal(t, []Permission{view, alerts}, diff.Added)
+	assert.Nil(t, diff.Removed)
+}
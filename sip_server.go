@@ -28,61 +28,41 @@ type SIPServer struct {
 	LiveShare          *bool                `json:"liveShare,omitempty" te:"int-bool"`
 
 	// Fields unique to this test
-	Agents                *[]Agent     `json:"agents,omitempty"`
-	BandwidthMeasurements *bool        `json:"bandwidthMeasurements,omitempty" te:"int-bool"`
-	BGPMeasurements       *bool        `json:"bgpMeasurements,omitempty" te:"int-bool"`
-	Interval              *int         `json:"interval,omitempty"`
-	MTUMeasurements       *bool        `json:"mtuMeasurements,omitempty" te:"int-bool"`
-	NetworkMeasurements   *bool        `json:"networkMeasurements,omitempty" te:"int-bool"`
-	NumPathTraces         *int         `json:"numPathTraces,omitempty"`
-	OptionsRegex          *string      `json:"options_regex,omitempty"`
-	PathTraceMode         *string      `json:"pathTraceMode,omitempty"`
-	ProbeMode             *string      `json:"probeMode,omitempty"`
-	RegisterEnabled       *bool        `json:"registerEnabled,omitempty" te:"int-bool"`
-	SIPTargetTime         *int         `json:"sipTargetTime,omitempty"`
-	SIPTimeLimit          *int         `json:"sipTimeLimit,omitempty"`
-	TargetSIPCredentials  *SIPAuthData `json:"targetSipCredentials,omitempty"`
-	UsePublicBGP          *bool        `json:"usePublicBgp,omitempty" te:"int-bool"`
-}
-
-// MarshalJSON implements the json.Marshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t SIPServer) MarshalJSON() ([]byte, error) {
-	type aliasTest SIPServer
-
-	data, err := json.Marshal((aliasTest)(t))
-	if err != nil {
-		return nil, err
-	}
-
-	return jsonBoolToInt(&t, data)
-}
-
-// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t *SIPServer) UnmarshalJSON(data []byte) error {
-	type aliasTest SIPServer
-	test := (*aliasTest)(t)
-
-	data, err := jsonIntToBool(t, data)
-	if err != nil {
-		return err
-	}
-
-	return json.Unmarshal(data, &test)
+	Agents                *[]Agent       `json:"agents,omitempty"`
+	BandwidthMeasurements *bool          `json:"bandwidthMeasurements,omitempty" te:"int-bool"`
+	BGPMeasurements       *bool          `json:"bgpMeasurements,omitempty" te:"int-bool"`
+	Interval              *int           `json:"interval,omitempty"`
+	MTUMeasurements       *bool          `json:"mtuMeasurements,omitempty" te:"int-bool"`
+	NetworkMeasurements   *bool          `json:"networkMeasurements,omitempty" te:"int-bool"`
+	NumPathTraces         *int           `json:"numPathTraces,omitempty"`
+	OptionsRegex          *string        `json:"options_regex,omitempty"`
+	PathTraceMode         *PathTraceMode `json:"pathTraceMode,omitempty"`
+	ProbeMode             *ProbeMode     `json:"probeMode,omitempty"`
+	RegisterEnabled       *bool          `json:"registerEnabled,omitempty" te:"int-bool"`
+	SIPTargetTime         *int           `json:"sipTargetTime,omitempty"`
+	SIPTimeLimit          *int           `json:"sipTimeLimit,omitempty"`
+	TargetSIPCredentials  *SIPAuthData   `json:"targetSipCredentials,omitempty"`
+	UsePublicBGP          *bool          `json:"usePublicBgp,omitempty" te:"int-bool"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // AddAgent - Add agemt to sip server  test
 func (t *SIPServer) AddAgent(id int) {
 	agent := Agent{AgentID: Int(id)}
+	if t.Agents == nil {
+		t.Agents = &[]Agent{}
+	}
 	*t.Agents = append(*t.Agents, agent)
 }
 
 // AddAlertRule - Adds an alert to agent test
 func (t *SIPServer) AddAlertRule(id int) {
 	alertRule := AlertRule{RuleID: Int(id)}
+	if t.AlertRules == nil {
+		t.AlertRules = &[]AlertRule{}
+	}
 	*t.AlertRules = append(*t.AlertRules, alertRule)
 }
 
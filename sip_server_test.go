@@ -1,6 +1,7 @@
 package thousandeyes
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
 
@@ -153,6 +154,10 @@ func TestClient_CreateSIPServer(t *testing.T) {
 				Rel:  String("data"),
 			},
 		},
+		Extra: map[string]json.RawMessage{
+			"authUser": json.RawMessage(`"usernameB"`),
+			"password": json.RawMessage(`"secret"`),
+		},
 	}
 	create := SIPServer{
 		TestName: String("test1"),
@@ -198,7 +203,7 @@ func TestClient_UpdateSIPServer(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	expected := SIPServer{TestID: Int64(1), TestName: String("test123"), Type: String("sip-server")}
+	expected := SIPServer{TestID: Int64(1), TestName: String("test123"), Type: String("sip-server"), Extra: map[string]json.RawMessage{"authUser": json.RawMessage(`"usernameB"`), "password": json.RawMessage(`"secret"`)}}
 	assert.Equal(t, &expected, res)
 
 }
@@ -0,0 +1,86 @@
+package thousandeyes
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// snapshotTimeFormat is the timestamp format of snapshot time ranges
+const snapshotTimeFormat = "2006-01-02 15:04:05"
+
+// Snapshot - a shareable copy of a test's data for a time range
+type Snapshot struct {
+	SnapshotID     *int64  `json:"snapshotId,omitempty"`
+	TestID         *int64  `json:"testId,omitempty"`
+	DisplayName    *string `json:"displayName,omitempty"`
+	From           *string `json:"from,omitempty"`
+	To             *string `json:"to,omitempty"`
+	IsPublic       *bool   `json:"isPublic,omitempty" te:"int-bool"`
+	CreatedBy      *string `json:"createdBy,omitempty"`
+	CreatedDate    *string `json:"createdDate,omitempty"`
+	ExpirationDate *string `json:"expirationDate,omitempty"`
+	URL            *string `json:"url,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// CreateSnapshot - Create a snapshot of a test's data between from and to.
+// Public snapshots can be viewed by anyone with the returned URL.
+func (c *Client) CreateSnapshot(testID int64, from, to time.Time, displayName string, public bool) (*Snapshot, error) {
+	if !from.Before(to) {
+		return nil, fmt.Errorf("snapshot start %s is not before end %s", from, to)
+	}
+	s := Snapshot{
+		TestID:      Int64(testID),
+		DisplayName: String(displayName),
+		From:        String(from.UTC().Format(snapshotTimeFormat)),
+		To:          String(to.UTC().Format(snapshotTimeFormat)),
+		IsPublic:    Bool(public),
+	}
+	resp, err := c.post("/snapshot", s, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 201 {
+		return nil, fmt.Errorf("failed to create snapshot, response code %d", resp.StatusCode)
+	}
+	var target map[string][]Snapshot
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	if len(target["snapshot"]) < 1 {
+		return nil, fmt.Errorf("'snapshot' not found in JSON response")
+	}
+	return &target["snapshot"][0], nil
+}
+
+// GetSavedEvents - Get saved events, which are tests whose data has been
+// saved for a time range
+func (c *Client) GetSavedEvents() (*[]GenericTest, error) {
+	resp, err := c.get("/saved-events")
+	if err != nil {
+		return nil, err
+	}
+	var target map[string][]GenericTest
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	events := target["savedEvents"]
+	return &events, nil
+}
+
+// GetSharedTests - Get tests shared with the account group by other organizations
+func (c *Client) GetSharedTests() (*[]GenericTest, error) {
+	resp, err := c.get("/live-share")
+	if err != nil {
+		return nil, err
+	}
+	var target map[string][]GenericTest
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	tests := target["test"]
+	return &tests, nil
+}
@@ -0,0 +1,81 @@
+package thousandeyes
+
+import (
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_CreateSnapshot(t *testing.T) {
+	out := `{"snapshot":[{"snapshotId":7,"testId":10,"displayName":"outage","from":"2022-06-01 10:00:00","to":"2022-06-01 11:00:00","isPublic":1,"url":"https://app.thousandeyes.com/s/abc"}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/snapshot.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"testId":10,"displayName":"outage","from":"2022-06-01 10:00:00","to":"2022-06-01 11:00:00","isPublic":1}`, string(body))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(out))
+	})
+
+	from := time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+	res, err := client.CreateSnapshot(10, from, from.Add(time.Hour), "outage", true)
+	teardown()
+	assert.Nil(t, err)
+	expected := Snapshot{
+		SnapshotID:  Int64(7),
+		TestID:      Int64(10),
+		DisplayName: String("outage"),
+		From:        String("2022-06-01 10:00:00"),
+		To:          String("2022-06-01 11:00:00"),
+		IsPublic:    Bool(true),
+		URL:         String("https://app.thousandeyes.com/s/abc"),
+	}
+	assert.Equal(t, &expected, res)
+}
+
+func TestClient_CreateSnapshotInvalidRange(t *testing.T) {
+	client := &Client{}
+	from := time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+	_, err := client.CreateSnapshot(10, from, from, "outage", false)
+	assert.Error(t, err)
+}
+
+func TestClient_GetSavedEvents(t *testing.T) {
+	out := `{"savedEvents":[{"testId":10,"testName":"outage","type":"http-server","savedEvent":1}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/saved-events.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		_, _ = w.Write([]byte(out))
+	})
+
+	res, err := client.GetSavedEvents()
+	teardown()
+	assert.Nil(t, err)
+	expected := []GenericTest{
+		{TestID: Int64(10), TestName: String("outage"), Type: String("http-server"), SavedEvent: Bool(true)},
+	}
+	assert.Equal(t, &expected, res)
+}
+
+func TestClient_GetSharedTests(t *testing.T) {
+	out := `{"test":[{"testId":20,"testName":"partner web","type":"http-server","liveShare":1}]}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/live-share.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		_, _ = w.Write([]byte(out))
+	})
+
+	res, err := client.GetSharedTests()
+	teardown()
+	assert.Nil(t, err)
+	expected := []GenericTest{
+		{TestID: Int64(20), TestName: String("partner web"), Type: String("http-server"), LiveShare: Bool(true)},
+	}
+	assert.Equal(t, &expected, res)
+}
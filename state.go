@@ -0,0 +1,778 @@
+package thousandeyes
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// StateAction - the change a state plan makes to a single object
+type StateAction string
+
+// State actions
+const (
+	StateCreate StateAction = "create"
+	StateUpdate StateAction = "update"
+	StateDelete StateAction = "delete"
+	StateNoOp   StateAction = "no-op"
+	// StateConflict - a live object has the name of a desired object but
+	// is not owned by the desired state, so it is left alone
+	StateConflict StateAction = "conflict"
+)
+
+// StateKind - the kind of object a state change applies to
+type StateKind string
+
+// State kinds.  Test kinds use the test type reported by GetTests.
+const (
+	StateKindAlertRule   StateKind = "alert-rule"
+	StateKindLabel       StateKind = "label"
+	StateKindHTTPServer  StateKind = "http-server"
+	StateKindAgentServer StateKind = "agent-to-server"
+	StateKindDNSServer   StateKind = "dns-server"
+)
+
+// DesiredState - the tests, alert rules and labels which should exist in an
+// account group.  Objects are matched to live objects of the same kind by
+// name: RuleName for alert rules, Name and Type for labels and TestName for
+// tests.  Only the fields set on a desired object are compared and sent.
+//
+// Tests may refer to agents, alert rules and labels by AgentName, RuleName
+// and Name instead of by ID.  Names are resolved when the plan is computed
+// and again when it is applied, so tests can use alert rules and labels
+// created by the same plan.
+//
+// A DesiredState can be decoded from YAML, in which agents, alert rules and
+// labels are referenced by name.
+type DesiredState struct {
+	AlertRules   []AlertRule   `yaml:"alertRules,omitempty"`
+	Labels       []GroupLabel  `yaml:"labels,omitempty"`
+	HTTPServers  []HTTPServer  `yaml:"httpServers,omitempty"`
+	AgentServers []AgentServer `yaml:"agentServers,omitempty"`
+	DNSServers   []DNSServer   `yaml:"dnsServers,omitempty"`
+}
+
+// StateOptions - options for SyncState
+type StateOptions struct {
+	// Marker is an ownership marker, such as "[managed-by:gitops]".  It is
+	// appended to the description of every desired test, and live tests
+	// whose description contains it are considered owned by the desired
+	// state.  Live tests with the name of a desired test but without the
+	// marker are reported as conflicts.  Alert rules and labels have no
+	// description, so with a marker set, live ones with the name of a
+	// desired one are used as they are, and reported as conflicts if they
+	// differ from it.  Without a marker, every live object is owned.
+	Marker string
+	// Delete enables removal of owned tests which are not in the desired
+	// state.  It requires Marker.  Alert rules and labels have no
+	// description, so they cannot be marked and are never deleted.
+	Delete bool
+	// DryRun computes the plan without applying it.
+	DryRun bool
+}
+
+// FieldChange - a difference in a single field of an object
+type FieldChange struct {
+	Field string
+	From  interface{}
+	To    interface{}
+}
+
+// String returns the change as "field: from -> to"
+func (f FieldChange) String() string {
+	return fmt.Sprintf("%s: %s -> %s", f.Field, formatFieldValue(f.From), formatFieldValue(f.To))
+}
+
+func formatFieldValue(v interface{}) string {
+	if v == nil {
+		return "<unset>"
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
+
+// StateChange - a single change in a state plan
+type StateChange struct {
+	Kind   StateKind
+	Action StateAction
+	Name   string
+	// ID is the ID of the live object.  It is zero for creates.
+	ID     int64
+	Fields []FieldChange
+
+	// desired is a pointer to a copy of the desired object
+	desired interface{}
+}
+
+// StatePlan - the changes needed to bring an account group to the desired state
+type StatePlan struct {
+	Changes []StateChange
+}
+
+// HasChanges reports whether applying the plan would change anything
+func (p StatePlan) HasChanges() bool {
+	for _, c := range p.Changes {
+		if c.Action != StateNoOp {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns a human readable summary of the plan, one change per line
+// followed by the fields it changes.  Objects which are unchanged are omitted.
+func (p StatePlan) String() string {
+	var b strings.Builder
+	for _, c := range p.Changes {
+		if c.Action == StateNoOp {
+			continue
+		}
+		fmt.Fprintf(&b, "%s %s %q\n", c.Action, c.Kind, c.Name)
+		for _, f := range c.Fields {
+			fmt.Fprintf(&b, "  %s\n", f)
+		}
+	}
+	return b.String()
+}
+
+// stateReadOnlyFields are JSON keys populated by the server, which are
+// never compared.
+var stateReadOnlyFields = map[string]bool{
+	"alertRuleId":  true,
+	"apiLinks":     true,
+	"builtin":      true,
+	"createdBy":    true,
+	"createdDate":  true,
+	"groupId":      true,
+	"liveShare":    true,
+	"modifiedBy":   true,
+	"modifiedDate": true,
+	"ruleId":       true,
+	"savedEvent":   true,
+	"testId":       true,
+	"type":         true,
+}
+
+// stateReferenceFields maps JSON keys of object lists to the ID and name
+// keys of their members.  They are compared as sets of IDs.
+var stateReferenceFields = map[string][2]string{
+	"agents":             {"agentId", "agentName"},
+	"alertRules":         {"ruleId", "ruleName"},
+	"groups":             {"groupId", "name"},
+	"sharedWithAccounts": {"aid", "name"},
+	"tests":              {"testId", "testName"},
+}
+
+// stateFieldMap returns the JSON representation of an object as a map.
+// Fields the API encodes as 0 or 1 are booleans in the map.
+func stateFieldMap(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return intBoolFieldMap(fields, reflect.TypeOf(derefTest(v))), nil
+}
+
+// intBoolFieldMap replaces the 0 or 1 values of the fields of t tagged
+// te:"int-bool" in a JSON field map by booleans.  The map is modified and
+// returned.
+func intBoolFieldMap(fields map[string]interface{}, t reflect.Type) map[string]interface{} {
+	if t == nil || t.Kind() != reflect.Struct {
+		return fields
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Tag.Get("te") != "int-bool" {
+			continue
+		}
+		key := strings.Split(f.Tag.Get("json"), ",")[0]
+		if n, ok := fields[key].(float64); ok {
+			fields[key] = n != 0
+		}
+	}
+	return fields
+}
+
+// stateReferenceSet returns the sorted IDs of a list of objects.  Objects
+// without an ID are identified by name.
+func stateReferenceSet(v interface{}, keys [2]string) []string {
+	list, _ := v.([]interface{})
+	set := []string{}
+	for _, item := range list {
+		m, _ := item.(map[string]interface{})
+		if id, ok := m[keys[0]]; ok {
+			set = append(set, fmt.Sprint(id))
+		} else if name, ok := m[keys[1]]; ok {
+			set = append(set, fmt.Sprintf("name:%v", name))
+		}
+	}
+	sort.Strings(set)
+	return set
+}
+
+// diffStateFields compares the fields set on desired with the same fields
+// of live.  Server defaults are only applied to tests.
+func diffStateFields(desired, live interface{}) ([]FieldChange, error) {
+	d, err := stateFieldMap(desired)
+	if err != nil {
+		return nil, err
+	}
+	l, err := stateFieldMap(live)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(d))
+	for key := range d {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var defaults map[string]interface{}
+	if test := derefTest(desired); isTest(test) {
+		defaults = testDefaults(test)
+	}
+	return diffFieldMaps(l, d, keys, defaults), nil
+}
+
+// diffFieldMaps compares the given keys of two JSON field maps, skipping
+// read-only fields.  Missing values are replaced by their default, if any.
+func diffFieldMaps(from, to map[string]interface{}, keys []string, defaults map[string]interface{}) []FieldChange {
+	var changes []FieldChange
+	for _, key := range keys {
+		if stateReadOnlyFields[key] {
+			continue
+		}
+		f, t := from[key], to[key]
+		if f == nil {
+			f = defaults[key]
+		}
+		if t == nil {
+			t = defaults[key]
+		}
+		if refKeys, ok := stateReferenceFields[key]; ok {
+			fromSet, toSet := stateReferenceSet(f, refKeys), stateReferenceSet(t, refKeys)
+			if !reflect.DeepEqual(fromSet, toSet) {
+				changes = append(changes, FieldChange{Field: key, From: fromSet, To: toSet})
+			}
+			continue
+		}
+		if !reflect.DeepEqual(f, t) {
+			changes = append(changes, FieldChange{Field: key, From: f, To: t})
+		}
+	}
+	return changes
+}
+
+// copyState deep copies src into dst through their JSON representation
+func copyState(src, dst interface{}) error {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dst)
+}
+
+// stateNames holds the IDs of agents, alert rules and test labels by name
+type stateNames struct {
+	agents     map[string]int
+	alertRules map[string]int
+	groups     map[string]int64
+}
+
+func (c *Client) getStateNames() (*stateNames, error) {
+	names := &stateNames{
+		agents:     map[string]int{},
+		alertRules: map[string]int{},
+		groups:     map[string]int64{},
+	}
+	agents, err := c.GetAgents()
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range *agents {
+		if a.AgentName != nil && a.AgentID != nil {
+			names.agents[*a.AgentName] = *a.AgentID
+		}
+	}
+	rules, err := c.GetAlertRules()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range *rules {
+		if r.RuleName != nil && r.RuleID != nil {
+			names.alertRules[*r.RuleName] = *r.RuleID
+		}
+	}
+	labels, err := c.GetGroupLabelsByType(LabelTypeTests)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range *labels {
+		if l.Name != nil && l.GroupID != nil {
+			names.groups[*l.Name] = *l.GroupID
+		}
+	}
+	return names, nil
+}
+
+// resolveLabelTests sets the IDs of the test members of a desired label
+// which are referenced by a name only one live test has.
+func resolveLabelTests(label *GroupLabel, tests []GenericTest) {
+	if label.Tests == nil {
+		return
+	}
+	ids := map[string]int64{}
+	for _, t := range tests {
+		if t.TestName == nil || t.TestID == nil {
+			continue
+		}
+		if _, ok := ids[*t.TestName]; ok {
+			ids[*t.TestName] = 0
+		} else {
+			ids[*t.TestName] = *t.TestID
+		}
+	}
+	for i, t := range *label.Tests {
+		if id := ids[t.GetTestName()]; t.TestID == nil && id != 0 {
+			(*label.Tests)[i].TestID = Int64(id)
+		}
+	}
+}
+
+// stateTestFields returns pointers to the fields of a desired test which
+// the state engine reads and modifies.
+func stateTestFields(test interface{}) (name *string, description **string, agents *[]Agent, rules *[]AlertRule, groups *[]GroupLabel) {
+	switch t := test.(type) {
+	case *HTTPServer:
+		return t.TestName, &t.Description, t.Agents, t.AlertRules, t.Groups
+	case *AgentServer:
+		return t.TestName, &t.Description, t.Agents, t.AlertRules, t.Groups
+	case *DNSServer:
+		return t.TestName, &t.Description, t.Agents, t.AlertRules, t.Groups
+	}
+	panic(fmt.Sprintf("unsupported desired test %T", test))
+}
+
+// resolve sets the IDs of agents, alert rules and labels referenced by name
+// in v, which must be a pointer, and returns the names it could not resolve.
+// References are the Agent, AlertRule and GroupLabel values nested in v, so
+// an alert rule or label is not resolved against itself.
+func (n *stateNames) resolve(v interface{}) []string {
+	var unresolved []string
+	_ = walkValues(reflect.ValueOf(v), "", func(v reflect.Value, path string) error {
+		if path == "" || !v.CanAddr() {
+			return nil
+		}
+		switch r := v.Addr().Interface().(type) {
+		case *Agent:
+			if r.AgentID == nil && r.AgentName != nil {
+				if id, ok := n.agents[*r.AgentName]; ok {
+					r.AgentID = Int(id)
+				} else {
+					unresolved = append(unresolved, fmt.Sprintf("agent %q", *r.AgentName))
+				}
+			}
+		case *AlertRule:
+			if r.RuleID == nil && r.RuleName != nil {
+				if id, ok := n.alertRules[*r.RuleName]; ok {
+					r.RuleID = Int(id)
+				} else {
+					unresolved = append(unresolved, fmt.Sprintf("alert rule %q", *r.RuleName))
+				}
+			}
+		case *GroupLabel:
+			if r.GroupID == nil && r.Name != nil {
+				if id, ok := n.groups[*r.Name]; ok {
+					r.GroupID = Int64(id)
+				} else {
+					unresolved = append(unresolved, fmt.Sprintf("label %q", *r.Name))
+				}
+			}
+		}
+		return nil
+	})
+	return unresolved
+}
+
+// desiredTest is a copy of a desired test with its kind and name
+type desiredTest struct {
+	kind StateKind
+	name string
+	test interface{}
+}
+
+// desiredTests copies the desired tests, adding the ownership marker
+func desiredTests(desired DesiredState, marker string) ([]desiredTest, error) {
+	var tests []desiredTest
+	add := func(kind StateKind, src interface{}, dst interface{}) error {
+		if err := copyState(src, dst); err != nil {
+			return err
+		}
+		name, description, _, _, _ := stateTestFields(dst)
+		if name == nil || *name == "" {
+			return fmt.Errorf("desired %s test has no name", kind)
+		}
+		if err := dst.(interface{ Validate() error }).Validate(); err != nil {
+			return fmt.Errorf("desired %s test %q: %v", kind, *name, err)
+		}
+		if marker != "" {
+			switch {
+			case *description == nil || **description == "":
+				*description = String(marker)
+			case !strings.Contains(**description, marker):
+				*description = String(**description + " " + marker)
+			}
+		}
+		tests = append(tests, desiredTest{kind: kind, name: *name, test: dst})
+		return nil
+	}
+	for _, t := range desired.HTTPServers {
+		if err := add(StateKindHTTPServer, t, &HTTPServer{}); err != nil {
+			return nil, err
+		}
+	}
+	for _, t := range desired.AgentServers {
+		if err := add(StateKindAgentServer, t, &AgentServer{}); err != nil {
+			return nil, err
+		}
+	}
+	for _, t := range desired.DNSServers {
+		if err := add(StateKindDNSServer, t, &DNSServer{}); err != nil {
+			return nil, err
+		}
+	}
+	seen := map[string]bool{}
+	for _, t := range tests {
+		key := string(t.kind) + "/" + t.name
+		if seen[key] {
+			return nil, fmt.Errorf("duplicate desired %s test %q", t.kind, t.name)
+		}
+		seen[key] = true
+	}
+	return tests, nil
+}
+
+// getStateTest fetches the live test matching a desired test
+func (c *Client) getStateTest(kind StateKind, id int) (interface{}, error) {
+	switch kind {
+	case StateKindHTTPServer:
+		return c.GetHTTPServer(id)
+	case StateKindAgentServer:
+		return c.GetAgentServer(id)
+	case StateKindDNSServer:
+		return c.GetDNSServer(id)
+	}
+	return nil, fmt.Errorf("unsupported test kind %s", kind)
+}
+
+// PlanState - compute the changes needed so that the alert rules, labels
+// and tests of the account group match the desired state.
+func (c *Client) PlanState(desired DesiredState, opts StateOptions) (*StatePlan, error) {
+	if opts.Delete && opts.Marker == "" {
+		return nil, fmt.Errorf("deleting tests requires an ownership marker")
+	}
+	tests, err := desiredTests(desired, opts.Marker)
+	if err != nil {
+		return nil, err
+	}
+	names, err := c.getStateNames()
+	if err != nil {
+		return nil, err
+	}
+	plan := &StatePlan{}
+
+	// Alert rules
+	rules, err := c.GetAlertRules()
+	if err != nil {
+		return nil, err
+	}
+	liveRules := map[string]AlertRule{}
+	for _, r := range *rules {
+		if r.RuleName != nil {
+			liveRules[*r.RuleName] = r
+		}
+	}
+	seen := map[string]bool{}
+	for _, r := range desired.AlertRules {
+		if r.RuleName == nil || *r.RuleName == "" {
+			return nil, fmt.Errorf("desired alert rule has no name")
+		}
+		name := *r.RuleName
+		if seen[name] {
+			return nil, fmt.Errorf("duplicate desired alert rule %q", name)
+		}
+		seen[name] = true
+		rule := &AlertRule{}
+		if err := copyState(r, rule); err != nil {
+			return nil, err
+		}
+		change := StateChange{Kind: StateKindAlertRule, Action: StateCreate, Name: name, desired: rule}
+		if live, ok := liveRules[name]; ok && live.RuleID != nil {
+			change.ID = int64(*live.RuleID)
+			if change.Fields, err = diffStateFields(rule, live); err != nil {
+				return nil, err
+			}
+			change.Action = stateUpdateOrConflict(change.Fields, opts.Marker == "")
+		}
+		plan.Changes = append(plan.Changes, change)
+	}
+
+	liveTests, err := c.GetTests()
+	if err != nil {
+		return nil, err
+	}
+
+	// Labels
+	if len(desired.Labels) > 0 {
+		labels, err := c.GetGroupLabels()
+		if err != nil {
+			return nil, err
+		}
+		liveLabels := map[string]GroupLabel{}
+		for _, l := range *labels {
+			if l.Name != nil && l.Type != nil {
+				liveLabels[string(*l.Type)+"/"+*l.Name] = l
+			}
+		}
+		seen = map[string]bool{}
+		for _, l := range desired.Labels {
+			if l.Name == nil || *l.Name == "" {
+				return nil, fmt.Errorf("desired label has no name")
+			}
+			if l.Type == nil {
+				return nil, fmt.Errorf("desired label %q has no type", *l.Name)
+			}
+			if err := l.Type.Validate(); err != nil {
+				return nil, err
+			}
+			key := string(*l.Type) + "/" + *l.Name
+			if seen[key] {
+				return nil, fmt.Errorf("duplicate desired label %q", *l.Name)
+			}
+			seen[key] = true
+			label := &GroupLabel{}
+			if err := copyState(l, label); err != nil {
+				return nil, err
+			}
+			names.resolve(label)
+			resolveLabelTests(label, *liveTests)
+			change := StateChange{Kind: StateKindLabel, Action: StateCreate, Name: *l.Name, desired: label}
+			if live, ok := liveLabels[key]; ok && live.GroupID != nil {
+				change.ID = *live.GroupID
+				if change.Fields, err = diffStateFields(label, live); err != nil {
+					return nil, err
+				}
+				change.Action = stateUpdateOrConflict(change.Fields, opts.Marker == "")
+			}
+			plan.Changes = append(plan.Changes, change)
+		}
+	}
+
+	// Tests
+	live := map[string]GenericTest{}
+	for _, t := range *liveTests {
+		if t.Type != nil && t.TestName != nil {
+			key := *t.Type + "/" + *t.TestName
+			if _, ok := live[key]; ok {
+				return nil, fmt.Errorf("more than one %s test is named %q", *t.Type, *t.TestName)
+			}
+			live[key] = t
+		}
+	}
+	wanted := map[string]bool{}
+	for _, t := range tests {
+		key := string(t.kind) + "/" + t.name
+		wanted[key] = true
+		names.resolve(t.test)
+		change := StateChange{Kind: t.kind, Action: StateCreate, Name: t.name, desired: t.test}
+		if l, ok := live[key]; ok && l.TestID != nil {
+			change.ID = *l.TestID
+			if !stateOwned(l, opts.Marker) {
+				change.Action = StateConflict
+				plan.Changes = append(plan.Changes, change)
+				continue
+			}
+			current, err := c.getStateTest(t.kind, int(*l.TestID))
+			if err != nil {
+				return nil, err
+			}
+			if change.Fields, err = diffStateFields(t.test, current); err != nil {
+				return nil, err
+			}
+			change.Action = stateUpdateOrNoOp(change.Fields)
+		}
+		plan.Changes = append(plan.Changes, change)
+	}
+
+	if opts.Delete {
+		var deletes []StateChange
+		for key, t := range live {
+			kind := StateKind(*t.Type)
+			if kind != StateKindHTTPServer && kind != StateKindAgentServer && kind != StateKindDNSServer {
+				continue
+			}
+			if wanted[key] || !stateOwned(t, opts.Marker) {
+				continue
+			}
+			deletes = append(deletes, StateChange{Kind: kind, Action: StateDelete, Name: *t.TestName, ID: *t.TestID})
+		}
+		sort.Slice(deletes, func(i, j int) bool {
+			if deletes[i].Kind != deletes[j].Kind {
+				return deletes[i].Kind < deletes[j].Kind
+			}
+			return deletes[i].Name < deletes[j].Name
+		})
+		plan.Changes = append(plan.Changes, deletes...)
+	}
+	return plan, nil
+}
+
+func stateUpdateOrNoOp(fields []FieldChange) StateAction {
+	if len(fields) == 0 {
+		return StateNoOp
+	}
+	return StateUpdate
+}
+
+// stateUpdateOrConflict is stateUpdateOrNoOp for objects which cannot carry
+// the ownership marker: changes to them are conflicts unless they are owned.
+func stateUpdateOrConflict(fields []FieldChange, owned bool) StateAction {
+	if action := stateUpdateOrNoOp(fields); action == StateNoOp || owned {
+		return action
+	}
+	return StateConflict
+}
+
+// stateOwned reports whether a live test is owned by the desired state
+func stateOwned(t GenericTest, marker string) bool {
+	return marker == "" || (t.Description != nil && strings.Contains(*t.Description, marker))
+}
+
+// ApplyState - apply a plan computed by PlanState.  Changes are applied in
+// plan order, which creates and updates alert rules and labels before tests.
+// It stops at the first failed change and returns the changes applied so far.
+// Plans with conflicts are not applied at all.
+func (c *Client) ApplyState(plan StatePlan) ([]StateChange, error) {
+	var conflicts []string
+	for _, change := range plan.Changes {
+		if change.Action == StateConflict {
+			conflicts = append(conflicts, fmt.Sprintf("%s %q", change.Kind, change.Name))
+		}
+	}
+	if len(conflicts) > 0 {
+		return nil, fmt.Errorf("plan conflicts with objects it does not own: %s", strings.Join(conflicts, ", "))
+	}
+	var applied []StateChange
+	var names *stateNames
+	for _, change := range plan.Changes {
+		if change.Action == StateNoOp {
+			continue
+		}
+		err := c.applyStateChange(change, &names)
+		if err != nil {
+			return applied, fmt.Errorf("failed to %s %s %q: %v", change.Action, change.Kind, change.Name, err)
+		}
+		applied = append(applied, change)
+	}
+	return applied, nil
+}
+
+// applyStateChange applies a single change.  names caches the IDs used to
+// resolve test references, and is reset whenever an alert rule or label is
+// created.
+func (c *Client) applyStateChange(change StateChange, names **stateNames) error {
+	id := int(change.ID)
+	if change.Action == StateDelete {
+		switch change.Kind {
+		case StateKindHTTPServer:
+			return c.DeleteHTTPServer(id)
+		case StateKindAgentServer:
+			return c.DeleteAgentServer(id)
+		case StateKindDNSServer:
+			return c.DeleteDNSServer(id)
+		}
+		return fmt.Errorf("cannot delete %s", change.Kind)
+	}
+	if change.desired == nil {
+		return fmt.Errorf("change has no desired object")
+	}
+	create := change.Action == StateCreate
+
+	switch d := change.desired.(type) {
+	case *AlertRule:
+		*names = nil
+		if create {
+			_, err := c.CreateAlertRule(*d)
+			return err
+		}
+		_, err := c.UpdateAlertRule(id, *d)
+		return err
+	case *GroupLabel:
+		*names = nil
+		if create {
+			_, err := c.CreateGroupLabel(*d)
+			return err
+		}
+		// Like CreateGroupLabel, the type must not be submitted.
+		update := *d
+		update.Type = nil
+		_, err := c.UpdateGroupLabel(id, update)
+		return err
+	}
+
+	if *names == nil {
+		n, err := c.getStateNames()
+		if err != nil {
+			return err
+		}
+		*names = n
+	}
+	if unresolved := (*names).resolve(change.desired); len(unresolved) > 0 {
+		return fmt.Errorf("not found: %s", strings.Join(unresolved, ", "))
+	}
+	var err error
+	switch d := change.desired.(type) {
+	case *HTTPServer:
+		if create {
+			_, err = c.CreateHTTPServer(*d)
+		} else {
+			_, err = c.UpdateHTTPServer(id, *d)
+		}
+	case *AgentServer:
+		if create {
+			_, err = c.CreateAgentServer(*d)
+		} else {
+			_, err = c.UpdateAgentServer(id, *d)
+		}
+	case *DNSServer:
+		if create {
+			_, err = c.CreateDNSServer(*d)
+		} else {
+			_, err = c.UpdateDNSServer(id, *d)
+		}
+	default:
+		err = fmt.Errorf("unsupported desired object %T", d)
+	}
+	return err
+}
+
+// SyncState - plan and, unless opts.DryRun is set, apply a desired state.
+func (c *Client) SyncState(desired DesiredState, opts StateOptions) (*StatePlan, error) {
+	plan, err := c.PlanState(desired, opts)
+	if err != nil {
+		return nil, err
+	}
+	if opts.DryRun {
+		return plan, nil
+	}
+	_, err = c.ApplyState(*plan)
+	return plan, err
+}
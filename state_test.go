@@ -0,0 +1,279 @@
+package thousandeyes
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stateTestServer serves an account group with one managed HTTP server
+// test, one managed DNS server test which is no longer desired, and one
+// unmanaged test.  Alert rules and labels created through the API are
+// returned by later list calls.
+func stateTestServer(t *testing.T) *[]string {
+	var calls []string
+	ruleCreated, labelCreated := false, false
+	mux.HandleFunc("/agents.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"agents":[{"agentId":10,"agentName":"London"}]}`))
+	})
+	mux.HandleFunc("/alert-rules.json", func(w http.ResponseWriter, r *http.Request) {
+		if ruleCreated {
+			_, _ = w.Write([]byte(`{"alertRules":[{"ruleId":1,"ruleName":"Default HTTP","expression":"a"},{"ruleId":2,"ruleName":"Latency","expression":"b"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"alertRules":[{"ruleId":1,"ruleName":"Default HTTP","expression":"a"}]}`))
+	})
+	mux.HandleFunc("/groups.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"groups":[{"groupId":5,"name":"web","type":"tests"}]}`))
+	})
+	mux.HandleFunc("/groups/tests.json", func(w http.ResponseWriter, r *http.Request) {
+		if labelCreated {
+			_, _ = w.Write([]byte(`{"groups":[{"groupId":5,"name":"web","type":"tests"},{"groupId":6,"name":"api","type":"tests"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"groups":[{"groupId":5,"name":"web","type":"tests"}]}`))
+	})
+	mux.HandleFunc("/tests.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"test":[
+			{"testId":100,"testName":"checkout","type":"http-server","description":"x [gitops]"},
+			{"testId":101,"testName":"old","type":"dns-server","description":"[gitops]"},
+			{"testId":102,"testName":"manual","type":"http-server"}
+		]}`))
+	})
+	mux.HandleFunc("/tests/100.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"test":[{"testId":100,"testName":"checkout","type":"http-server","description":"x [gitops]","url":"https://example.com","interval":60,"enabled":1,"modifiedDate":"2022-06-01 10:00:00","agents":[{"agentId":10,"agentName":"London","location":"London, UK"}],"alertRules":[{"ruleId":1,"ruleName":"Default HTTP"}]}]}`))
+	})
+
+	mux.HandleFunc("/alert-rules/new.json", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "create alert rule")
+		ruleCreated = true
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"alertRuleId":2,"ruleName":"Latency"}`))
+	})
+	mux.HandleFunc("/groups/tests/new.json", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "create label")
+		labelCreated = true
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"groups":[{"groupId":6,"name":"api","type":"tests"}]}`))
+	})
+	mux.HandleFunc("/tests/http-server/100/update.json", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "update checkout")
+		var payload HTTPServer
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, 300, *payload.Interval)
+		assert.Equal(t, "x [gitops]", *payload.Description)
+		assert.Equal(t, 10, *(*payload.Agents)[0].AgentID)
+		assert.Equal(t, 2, *(*payload.AlertRules)[1].RuleID)
+		_, _ = w.Write([]byte(`{"test":[{"testId":100}]}`))
+	})
+	mux.HandleFunc("/tests/agent-to-server/new.json", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "create ssh")
+		var payload AgentServer
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, "[gitops]", *payload.Description)
+		assert.Equal(t, int64(6), *(*payload.Groups)[0].GroupID)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"test":[{"testId":103,"server":"ssh.example.com:22"}]}`))
+	})
+	mux.HandleFunc("/tests/dns-server/101/delete.json", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "delete old")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return &calls
+}
+
+func stateTestDesired() DesiredState {
+	return DesiredState{
+		AlertRules: []AlertRule{
+			{RuleName: String("Default HTTP"), Expression: String("a")},
+			{RuleName: String("Latency"), Expression: String("b"), AlertType: String("HTTP Server")},
+		},
+		Labels: []GroupLabel{
+			{Name: String("api"), Type: Ptr(LabelTypeTests)},
+		},
+		HTTPServers: []HTTPServer{
+			{
+				TestName:    String("checkout"),
+				Description: String("x"),
+				URL:         String("https://example.com"),
+				Interval:    Int(300),
+				Enabled:     Bool(true),
+				Agents:      &[]Agent{{AgentName: String("London")}},
+				AlertRules:  &[]AlertRule{{RuleName: String("Default HTTP")}, {RuleName: String("Latency")}},
+			},
+		},
+		AgentServers: []AgentServer{
+			{
+				TestName: String("ssh"),
+				Server:   String("ssh.example.com"),
+				Port:     Int(22),
+				Agents:   &[]Agent{{AgentID: Int(10)}},
+				Groups:   &[]GroupLabel{{Name: String("api")}},
+			},
+		},
+	}
+}
+
+func TestClient_PlanState(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	stateTestServer(t)
+
+	desired := stateTestDesired()
+	plan, err := client.PlanState(desired, StateOptions{Marker: "[gitops]", Delete: true})
+	teardown()
+	assert.Nil(t, err)
+	assert.True(t, plan.HasChanges())
+
+	var actions []string
+	for _, c := range plan.Changes {
+		actions = append(actions, string(c.Action)+" "+string(c.Kind)+" "+c.Name)
+	}
+	assert.Equal(t, []string{
+		"no-op alert-rule Default HTTP",
+		"create alert-rule Latency",
+		"create label api",
+		"update http-server checkout",
+		"create agent-to-server ssh",
+		"delete dns-server old",
+	}, actions)
+	assert.Equal(t, int64(100), plan.Changes[3].ID)
+	assert.Equal(t, int64(101), plan.Changes[5].ID)
+	assert.Equal(t, `create alert-rule "Latency"
+create label "api"
+update http-server "checkout"
+  alertRules: ["1"] -> ["1","name:Latency"]
+  interval: 60 -> 300
+create agent-to-server "ssh"
+delete dns-server "old"
+`, plan.String())
+
+	// The desired state passed in is not modified.
+	assert.Equal(t, stateTestDesired(), desired)
+}
+
+func TestClient_SyncState(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	calls := stateTestServer(t)
+
+	_, err := client.SyncState(stateTestDesired(), StateOptions{Marker: "[gitops]", Delete: true})
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"create alert rule", "create label", "update checkout", "create ssh", "delete old"}, *calls)
+}
+
+func TestClient_SyncStateDryRun(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	calls := stateTestServer(t)
+
+	plan, err := client.SyncState(stateTestDesired(), StateOptions{DryRun: true})
+	teardown()
+	assert.Nil(t, err)
+	assert.Len(t, plan.Changes, 5)
+	assert.Nil(t, *calls)
+}
+
+func TestClient_PlanStateConflicts(t *testing.T) {
+	setup()
+	defer teardown()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	calls := stateTestServer(t)
+
+	// The manual test is not marked, and the Default HTTP rule differs
+	// from the desired one.
+	desired := DesiredState{
+		AlertRules:  []AlertRule{{RuleName: String("Default HTTP"), Expression: String("changed")}},
+		HTTPServers: []HTTPServer{{TestName: String("manual"), URL: String("https://example.com")}},
+	}
+	plan, err := client.PlanState(desired, StateOptions{Marker: "[gitops]"})
+	assert.Nil(t, err)
+	assert.Equal(t, StateConflict, plan.Changes[0].Action)
+	assert.Equal(t, StateConflict, plan.Changes[1].Action)
+	assert.Equal(t, int64(102), plan.Changes[1].ID)
+
+	_, err = client.ApplyState(*plan)
+	assert.EqualError(t, err, `plan conflicts with objects it does not own: alert-rule "Default HTTP", http-server "manual"`)
+	assert.Nil(t, *calls)
+
+	// Without a marker, every object is owned.
+	mux.HandleFunc("/tests/102.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"test":[{"testId":102,"testName":"manual","type":"http-server","url":"https://example.org"}]}`))
+	})
+	plan, err = client.PlanState(desired, StateOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, StateUpdate, plan.Changes[0].Action)
+	assert.Equal(t, StateUpdate, plan.Changes[1].Action)
+}
+
+func TestClient_PlanStateLabelMembers(t *testing.T) {
+	setup()
+	defer teardown()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/agents.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"agents":[{"agentId":10,"agentName":"London"}]}`))
+	})
+	mux.HandleFunc("/alert-rules.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"alertRules":[]}`))
+	})
+	mux.HandleFunc("/groups/tests.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"groups":[]}`))
+	})
+	mux.HandleFunc("/groups.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"groups":[
+			{"groupId":5,"name":"web","type":"tests","tests":[{"testId":100,"testName":"checkout","type":"http-server","enabled":1}]},
+			{"groupId":6,"name":"eu","type":"agents","agents":[{"agentId":10,"agentName":"London","location":"London, UK"}]}
+		]}`))
+	})
+	mux.HandleFunc("/tests.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"test":[{"testId":100,"testName":"checkout","type":"http-server"}]}`))
+	})
+
+	// Members are compared by ID, and may be referenced by name.
+	plan, err := client.PlanState(DesiredState{Labels: []GroupLabel{
+		{Name: String("web"), Type: Ptr(LabelTypeTests), Tests: &[]GenericTest{{TestName: String("checkout")}}},
+		{Name: String("eu"), Type: Ptr(LabelTypeAgents), Agents: &[]Agent{{AgentName: String("London")}}},
+	}}, StateOptions{})
+	assert.Nil(t, err)
+	assert.False(t, plan.HasChanges())
+}
+
+func TestClient_PlanStateErrors(t *testing.T) {
+	client := &Client{}
+	_, err := client.PlanState(DesiredState{}, StateOptions{Delete: true})
+	assert.EqualError(t, err, "deleting tests requires an ownership marker")
+
+	_, err = client.PlanState(DesiredState{HTTPServers: []HTTPServer{{URL: String("https://example.com")}}}, StateOptions{})
+	assert.EqualError(t, err, "desired http-server test has no name")
+
+	_, err = client.PlanState(DesiredState{DNSServers: []DNSServer{{TestName: String("a")}, {TestName: String("a")}}}, StateOptions{})
+	assert.EqualError(t, err, `duplicate desired dns-server test "a"`)
+
+	_, err = client.PlanState(DesiredState{HTTPServers: []HTTPServer{{TestName: String("a"), Interval: Int(30)}}}, StateOptions{})
+	assert.EqualError(t, err, `desired http-server test "a": invalid configuration: Interval: must be one of 60, 120, 300, 600, 900, 1800, 3600, got 30`)
+}
+
+func TestDiffStateFields(t *testing.T) {
+	desired := HTTPServer{
+		Enabled:    Bool(true),
+		Interval:   Int(60),
+		Agents:     &[]Agent{{AgentID: Int(2)}, {AgentID: Int(1)}},
+		APILinks:   &[]APILink{},
+		AlertRules: &[]AlertRule{},
+	}
+	live := HTTPServer{
+		Enabled:      Bool(true),
+		Interval:     Int(60),
+		URL:          String("https://example.com"),
+		ModifiedDate: String("2022-06-01 10:00:00"),
+		Agents:       &[]Agent{{AgentID: Int(1), AgentName: String("a")}, {AgentID: Int(2), AgentName: String("b")}},
+		AlertRules:   &[]AlertRule{{RuleID: Int(1)}},
+	}
+	changes, err := diffStateFields(desired, live)
+	assert.Nil(t, err)
+	assert.Equal(t, []FieldChange{{Field: "alertRules", From: []string{"1"}, To: []string{}}}, changes)
+	assert.Equal(t, `alertRules: ["1"] -> []`, changes[0].String())
+}
@@ -0,0 +1,275 @@
+package thousandeyes
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// testServerDefaults are, by test type, the values the API assigns to test
+// fields which are not set when a test is created, in their JSON form.  A
+// field which is unset on one test and has its default value on the other
+// is not a change.
+var testServerDefaults = map[string]map[string]interface{}{
+	"agent-to-agent": mustFieldMap(`{
+		"alertsEnabled": 1,
+		"bgpMeasurements": 1,
+		"dscpId": 0,
+		"enabled": 1,
+		"mtuMeasurements": 0,
+		"networkMeasurements": 1,
+		"numPathTraces": 3,
+		"pathTraceMode": "classic",
+		"throughputMeasurements": 0,
+		"usePublicBgp": 1
+	}`),
+	"agent-to-server": mustFieldMap(`{
+		"alertsEnabled": 1,
+		"bandwidthMeasurements": 0,
+		"bgpMeasurements": 1,
+		"enabled": 1,
+		"mtuMeasurements": 0,
+		"networkMeasurements": 1,
+		"numPathTraces": 3,
+		"pathTraceMode": "classic",
+		"probeMode": "AUTO",
+		"usePublicBgp": 1
+	}`),
+	"bgp": mustFieldMap(`{
+		"alertsEnabled": 1,
+		"enabled": 1,
+		"usePublicBgp": 1
+	}`),
+	"dns-dnssec": mustFieldMap(`{
+		"alertsEnabled": 1,
+		"enabled": 1
+	}`),
+	"dns-server": mustFieldMap(`{
+		"alertsEnabled": 1,
+		"bandwidthMeasurements": 0,
+		"bgpMeasurements": 1,
+		"dnsTransportProtocol": "UDP",
+		"enabled": 1,
+		"mtuMeasurements": 0,
+		"networkMeasurements": 1,
+		"numPathTraces": 3,
+		"pathTraceMode": "classic",
+		"probeMode": "AUTO",
+		"recursiveQueries": 1,
+		"usePublicBgp": 1
+	}`),
+	"dns-trace": mustFieldMap(`{
+		"alertsEnabled": 1,
+		"dnsTransportProtocol": "UDP",
+		"enabled": 1
+	}`),
+	"ftp-server": mustFieldMap(`{
+		"alertsEnabled": 1,
+		"bgpMeasurements": 1,
+		"enabled": 1,
+		"mtuMeasurements": 0,
+		"networkMeasurements": 1,
+		"numPathTraces": 3,
+		"pathTraceMode": "classic",
+		"probeMode": "AUTO"
+	}`),
+	"http-server": mustFieldMap(`{
+		"alertsEnabled": 1,
+		"authType": "NONE",
+		"bandwidthMeasurements": 0,
+		"bgpMeasurements": 1,
+		"contentRegex": "",
+		"enabled": 1,
+		"followRedirects": 1,
+		"httpTargetTime": 1000,
+		"httpTimeLimit": 5,
+		"httpVersion": 2,
+		"mtuMeasurements": 0,
+		"networkMeasurements": 1,
+		"numPathTraces": 3,
+		"pathTraceMode": "classic",
+		"probeMode": "AUTO",
+		"sslVersionId": 0,
+		"useNtlm": 0,
+		"verifyCertificate": 1
+	}`),
+	"page-load": mustFieldMap(`{
+		"alertsEnabled": 1,
+		"authType": "NONE",
+		"bandwidthMeasurements": 0,
+		"bgpMeasurements": 1,
+		"contentRegex": "",
+		"enabled": 1,
+		"followRedirects": 1,
+		"httpTargetTime": 1000,
+		"httpTimeLimit": 5,
+		"httpVersion": 2,
+		"mtuMeasurements": 0,
+		"networkMeasurements": 1,
+		"numPathTraces": 3,
+		"pageLoadTargetTime": 6,
+		"pageLoadTimeLimit": 10,
+		"pathTraceMode": "classic",
+		"probeMode": "AUTO",
+		"sslVersionId": 0,
+		"useNtlm": 0,
+		"usePublicBgp": 1,
+		"verifyCertificate": 1
+	}`),
+	"sip-server": mustFieldMap(`{
+		"alertsEnabled": 1,
+		"bandwidthMeasurements": 0,
+		"bgpMeasurements": 1,
+		"enabled": 1,
+		"mtuMeasurements": 0,
+		"networkMeasurements": 1,
+		"numPathTraces": 3,
+		"pathTraceMode": "classic",
+		"probeMode": "AUTO",
+		"usePublicBgp": 1
+	}`),
+	"voice": mustFieldMap(`{
+		"alertsEnabled": 1,
+		"bgpMeasurements": 1,
+		"dscpId": 0,
+		"enabled": 1,
+		"mtuMeasurements": 0,
+		"numPathTraces": 3,
+		"usePublicBgp": 1
+	}`),
+	"voice-call": mustFieldMap(`{
+		"alertsEnabled": 1,
+		"bgpMeasurements": 1,
+		"dscpId": 0,
+		"enabled": 1,
+		"numPathTraces": 3,
+		"usePublicBgp": 1
+	}`),
+	"web-transactions": mustFieldMap(`{
+		"alertsEnabled": 1,
+		"authType": "NONE",
+		"bandwidthMeasurements": 0,
+		"contentRegex": "",
+		"enabled": 1,
+		"httpTargetTime": 1000,
+		"httpTimeLimit": 5,
+		"httpVersion": 2,
+		"mtuMeasurements": 0,
+		"networkMeasurements": 1,
+		"numPathTraces": 3,
+		"pathTraceMode": "classic",
+		"probeMode": "AUTO",
+		"sslVersionId": 0,
+		"useNtlm": 0,
+		"verifyCertificate": 1
+	}`),
+}
+
+// testTypes maps the test structs to the API type of their tests
+var testTypes = map[reflect.Type]string{
+	reflect.TypeOf(AgentAgent{}):     "agent-to-agent",
+	reflect.TypeOf(AgentServer{}):    "agent-to-server",
+	reflect.TypeOf(BGP{}):            "bgp",
+	reflect.TypeOf(DNSSec{}):         "dns-dnssec",
+	reflect.TypeOf(DNSServer{}):      "dns-server",
+	reflect.TypeOf(DNSTrace{}):       "dns-trace",
+	reflect.TypeOf(FTPServer{}):      "ftp-server",
+	reflect.TypeOf(HTTPServer{}):     "http-server",
+	reflect.TypeOf(PageLoad{}):       "page-load",
+	reflect.TypeOf(SIPServer{}):      "sip-server",
+	reflect.TypeOf(RTPStream{}):      "voice",
+	reflect.TypeOf(VoiceCall{}):      "voice-call",
+	reflect.TypeOf(WebTransaction{}): "web-transactions",
+}
+
+func mustFieldMap(data string) map[string]interface{} {
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(data), &fields); err != nil {
+		panic(err)
+	}
+	return fields
+}
+
+// isTest reports whether v is one of the test structs
+func isTest(v interface{}) bool {
+	switch v.(type) {
+	case GenericTest, EndpointAgentServer, EndpointHTTPServer:
+		return true
+	}
+	_, ok := testTypes[reflect.TypeOf(v)]
+	return ok
+}
+
+// testDefaults returns the server defaults for the type of test v, or nil
+// if the type is unknown.  Endpoint tests have no defaults.
+func testDefaults(v interface{}) map[string]interface{} {
+	var defaults map[string]interface{}
+	if t, ok := v.(GenericTest); ok {
+		if t.Type == nil {
+			return nil
+		}
+		defaults = testServerDefaults[*t.Type]
+	} else {
+		defaults = testServerDefaults[testTypes[reflect.TypeOf(v)]]
+	}
+	if defaults == nil {
+		return nil
+	}
+	copied := make(map[string]interface{}, len(defaults))
+	for k, d := range defaults {
+		copied[k] = d
+	}
+	return intBoolFieldMap(copied, reflect.TypeOf(v))
+}
+
+// DiffTests - compare two tests of the same type, such as a test before and
+// after UpdateHTTPServer, and return the fields which differ, sorted by JSON
+// key.  From holds the value in a and To the value in b.
+//
+// Fields populated by the server, such as TestID, CreatedBy, ModifiedDate
+// and APILinks, are ignored.  A field which is unset on one test is equal to
+// the server's default for it.  Fields the API encodes as 0 or 1 are
+// returned as booleans.  Agents, AlertRules, Groups and SharedWithAccounts
+// are compared as sets of IDs, so their order and any other member fields
+// are ignored.
+func DiffTests(a, b interface{}) ([]FieldChange, error) {
+	a, b = derefTest(a), derefTest(b)
+	if !isTest(a) {
+		return nil, fmt.Errorf("%T is not a test", a)
+	}
+	if reflect.TypeOf(a) != reflect.TypeOf(b) {
+		return nil, fmt.Errorf("cannot compare %T with %T", a, b)
+	}
+	from, err := stateFieldMap(a)
+	if err != nil {
+		return nil, err
+	}
+	to, err := stateFieldMap(b)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := map[string]bool{}
+	for key := range from {
+		keys[key] = true
+	}
+	for key := range to {
+		keys[key] = true
+	}
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+	return diffFieldMaps(from, to, sorted, testDefaults(a)), nil
+}
+
+// derefTest returns the test a pointer points to
+func derefTest(v interface{}) interface{} {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		return rv.Elem().Interface()
+	}
+	return v
+}
@@ -0,0 +1,81 @@
+package thousandeyes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffTests(t *testing.T) {
+	before := HTTPServer{
+		TestID:       Int64(1),
+		TestName:     String("web"),
+		URL:          String("https://example.com"),
+		Interval:     Int(60),
+		ModifiedDate: String("2022-06-01 10:00:00"),
+		APILinks:     &[]APILink{{Rel: String("self")}},
+		Agents:       &[]Agent{{AgentID: Int(1)}, {AgentID: Int(2)}},
+		AlertRules:   &[]AlertRule{{RuleID: Int(5)}},
+	}
+	after := HTTPServer{
+		TestID:              Int64(1),
+		TestName:            String("web"),
+		URL:                 String("https://example.com/health"),
+		Interval:            Int(60),
+		ModifiedDate:        String("2022-06-02 10:00:00"),
+		CreatedBy:           String("Alice"),
+		Enabled:             Bool(true),
+		NetworkMeasurements: Bool(true),
+		HTTPTimeLimit:       Int(5),
+		Agents:              &[]Agent{{AgentID: Int(2), AgentName: String("b")}, {AgentID: Int(1), AgentName: String("a")}},
+		AlertRules:          &[]AlertRule{{RuleID: Int(5)}, {RuleID: Int(6)}},
+	}
+
+	changes, err := DiffTests(before, &after)
+	assert.Nil(t, err)
+	assert.Equal(t, []FieldChange{
+		{Field: "alertRules", From: []string{"5"}, To: []string{"5", "6"}},
+		{Field: "url", From: "https://example.com", To: "https://example.com/health"},
+	}, changes)
+
+	changes, err = DiffTests(after, after)
+	assert.Nil(t, err)
+	assert.Nil(t, changes)
+}
+
+func TestDiffTestsDefaults(t *testing.T) {
+	changes, err := DiffTests(AgentServer{}, AgentServer{Enabled: Bool(false), NumPathTraces: Int(3), ProbeMode: ProbeMode("SACK").Ptr()})
+	assert.Nil(t, err)
+	assert.Equal(t, []FieldChange{
+		{Field: "enabled", From: true, To: false},
+		{Field: "probeMode", From: "AUTO", To: "SACK"},
+	}, changes)
+	assert.Equal(t, `enabled: true -> false`, changes[0].String())
+
+	// Generic tests use the defaults of their type.
+	changes, err = DiffTests(GenericTest{Type: String("dns-trace")}, GenericTest{Type: String("dns-trace"), Enabled: Bool(true)})
+	assert.Nil(t, err)
+	assert.Nil(t, changes)
+
+	// Endpoint tests have no defaults.
+	changes, err = DiffTests(EndpointHTTPServer{}, EndpointHTTPServer{Enabled: Bool(true)})
+	assert.Nil(t, err)
+	assert.Equal(t, []FieldChange{{Field: "enabled", From: nil, To: true}}, changes)
+}
+
+func TestDiffTestsSharedWithAccounts(t *testing.T) {
+	changes, err := DiffTests(
+		HTTPServer{SharedWithAccounts: &[]SharedWithAccount{{AID: Int(1), AccountGroupName: String("a")}}},
+		HTTPServer{SharedWithAccounts: &[]SharedWithAccount{{AID: Int(1)}, {AID: Int(2)}}},
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, []FieldChange{{Field: "sharedWithAccounts", From: []string{"1"}, To: []string{"1", "2"}}}, changes)
+}
+
+func TestDiffTestsErrors(t *testing.T) {
+	_, err := DiffTests(HTTPServer{}, PageLoad{})
+	assert.EqualError(t, err, "cannot compare thousandeyes.HTTPServer with thousandeyes.PageLoad")
+
+	_, err = DiffTests(Agent{}, Agent{})
+	assert.EqualError(t, err, "thousandeyes.Agent is not a test")
+}
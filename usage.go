@@ -0,0 +1,212 @@
+package thousandeyes
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Usage - units and agents consumed by the organization in the current billing period
+type Usage struct {
+	Month                       *string                 `json:"month,omitempty"`
+	UsageDate                   *string                 `json:"usageDate,omitempty"`
+	Quota                       *UsageQuota             `json:"quota,omitempty"`
+	CloudUnitsUsed              *int                    `json:"cloudUnitsUsed,omitempty"`
+	CloudUnitsProjected         *int                    `json:"cloudUnitsProjected,omitempty"`
+	CloudUnitsNextBillingPeriod *int                    `json:"cloudUnitsNextBillingPeriod,omitempty"`
+	EnterpriseUnitsUsed         *int                    `json:"enterpriseUnitsUsed,omitempty"`
+	EnterpriseUnitsProjected    *int                    `json:"enterpriseUnitsProjected,omitempty"`
+	EndpointAgentsUsed          *int                    `json:"endpointAgentsUsed,omitempty"`
+	EnterpriseAgentsUsed        *int                    `json:"enterpriseAgentsUsed,omitempty"`
+	Tests                       *[]TestUsage            `json:"tests,omitempty"`
+	EndpointAgents              *[]EndpointAgentUsage   `json:"endpointAgents,omitempty"`
+	EnterpriseAgents            *[]EnterpriseAgentUsage `json:"enterpriseAgents,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// UsageQuota - units and agents included in the organization's subscription
+type UsageQuota struct {
+	MonthStart               *string `json:"monthStart,omitempty"`
+	MonthEnd                 *string `json:"monthEnd,omitempty"`
+	CloudUnitsIncluded       *int    `json:"cloudUnitsIncluded,omitempty"`
+	EndpointAgentsIncluded   *int    `json:"endpointAgentsIncluded,omitempty"`
+	EnterpriseAgentsIncluded *int    `json:"enterpriseAgentsIncluded,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// TestUsage - units consumed by a single test
+type TestUsage struct {
+	AID                      *int    `json:"aid,omitempty"`
+	AccountGroupName         *string `json:"accountGroupName,omitempty"`
+	TestID                   *int64  `json:"testId,omitempty"`
+	TestName                 *string `json:"testName,omitempty"`
+	CloudUnitsUsed           *int    `json:"cloudUnitsUsed,omitempty"`
+	CloudUnitsProjected      *int    `json:"cloudUnitsProjected,omitempty"`
+	EnterpriseUnitsUsed      *int    `json:"enterpriseUnitsUsed,omitempty"`
+	EnterpriseUnitsProjected *int    `json:"enterpriseUnitsProjected,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// EndpointAgentUsage - endpoint agents used by an account group
+type EndpointAgentUsage struct {
+	AID                *int    `json:"aid,omitempty"`
+	AccountGroupName   *string `json:"accountGroupName,omitempty"`
+	EndpointAgentsUsed *int    `json:"endpointAgentsUsed,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// EnterpriseAgentUsage - enterprise agent units used by an account group
+type EnterpriseAgentUsage struct {
+	AID                  *int    `json:"aid,omitempty"`
+	AccountGroupName     *string `json:"accountGroupName,omitempty"`
+	EnterpriseAgentsUsed *int    `json:"enterpriseAgentsUsed,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// getUsage fetches and decodes a usage endpoint
+func (c *Client) getUsage(path string) (*Usage, error) {
+	resp, err := c.get(path)
+	if err != nil {
+		return nil, err
+	}
+	var target map[string]Usage
+	if dErr := c.decodeJSON(resp, &target); dErr != nil {
+		return nil, fmt.Errorf("Could not decode JSON response: %v", dErr)
+	}
+	usage, ok := target["usage"]
+	if !ok {
+		return nil, fmt.Errorf("'usage' not found in JSON response")
+	}
+	return &usage, nil
+}
+
+// GetUsage - get unit and agent usage for the current billing period
+func (c *Client) GetUsage() (*Usage, error) {
+	return c.getUsage("/usage")
+}
+
+// GetTestUsage - get unit usage per test
+func (c *Client) GetTestUsage() (*[]TestUsage, error) {
+	usage, err := c.getUsage("/usage/tests")
+	if err != nil {
+		return nil, err
+	}
+	if usage.Tests == nil {
+		return &[]TestUsage{}, nil
+	}
+	return usage.Tests, nil
+}
+
+// GetEndpointAgentUsage - get endpoint agent usage per account group
+func (c *Client) GetEndpointAgentUsage() (*[]EndpointAgentUsage, error) {
+	usage, err := c.getUsage("/usage/endpoint-agents")
+	if err != nil {
+		return nil, err
+	}
+	if usage.EndpointAgents == nil {
+		return &[]EndpointAgentUsage{}, nil
+	}
+	return usage.EndpointAgents, nil
+}
+
+// GetEnterpriseAgentUsage - get enterprise agent usage per account group
+func (c *Client) GetEnterpriseAgentUsage() (*[]EnterpriseAgentUsage, error) {
+	usage, err := c.getUsage("/usage/enterprise-agents")
+	if err != nil {
+		return nil, err
+	}
+	if usage.EnterpriseAgents == nil {
+		return &[]EnterpriseAgentUsage{}, nil
+	}
+	return usage.EnterpriseAgents, nil
+}
+
+// minutesPerMonth is the length of the month used for unit projections.
+const minutesPerMonth = 31 * 24 * 60
+
+// UnitCosts - the units consumed by a single test round on a single cloud
+// agent.  The costs depend on the subscription, so the SDK has no defaults
+// and the estimate helpers require the cost of the test type to be set.
+// NetworkMeasurements may be left at zero if network measurements are free.
+type UnitCosts struct {
+	HTTPServer          float64
+	PageLoad            float64
+	NetworkMeasurements float64
+}
+
+// roundsPerMonth returns the number of test rounds per agent in a month
+func roundsPerMonth(interval *int) (float64, error) {
+	if interval == nil || *interval <= 0 {
+		return 0, fmt.Errorf("test interval must be set to estimate units")
+	}
+	return float64(minutesPerMonth*60) / float64(*interval), nil
+}
+
+// agentCount returns the number of agents assigned to a test
+func agentCount(agents *[]Agent) (float64, error) {
+	if agents == nil || len(*agents) == 0 {
+		return 0, fmt.Errorf("test must have agents to estimate units")
+	}
+	return float64(len(*agents)), nil
+}
+
+// EstimateHTTPServerUnits - estimate the cloud units an HTTP server test
+// consumes in a 31 day month.
+func EstimateHTTPServerUnits(t HTTPServer, costs UnitCosts) (float64, error) {
+	if costs.HTTPServer <= 0 {
+		return 0, fmt.Errorf("HTTP server unit cost must be set to estimate units")
+	}
+	rounds, err := roundsPerMonth(t.Interval)
+	if err != nil {
+		return 0, err
+	}
+	agents, err := agentCount(t.Agents)
+	if err != nil {
+		return 0, err
+	}
+	perRound := costs.HTTPServer
+	if t.NetworkMeasurements == nil || *t.NetworkMeasurements {
+		// Network measurements are enabled by default.
+		perRound += costs.NetworkMeasurements
+	}
+	return rounds * agents * perRound, nil
+}
+
+// EstimatePageLoadUnits - estimate the cloud units a page load test
+// consumes in a 31 day month.  The HTTP and network measurements of a page
+// load test run at HTTPInterval, which defaults to Interval.
+func EstimatePageLoadUnits(t PageLoad, costs UnitCosts) (float64, error) {
+	if costs.PageLoad <= 0 || costs.HTTPServer <= 0 {
+		return 0, fmt.Errorf("page load and HTTP server unit costs must be set to estimate units")
+	}
+	rounds, err := roundsPerMonth(t.Interval)
+	if err != nil {
+		return 0, err
+	}
+	agents, err := agentCount(t.Agents)
+	if err != nil {
+		return 0, err
+	}
+	httpRounds := rounds
+	if t.HTTPInterval != nil {
+		if httpRounds, err = roundsPerMonth(t.HTTPInterval); err != nil {
+			return 0, err
+		}
+	}
+	units := rounds * agents * costs.PageLoad
+	httpCost := costs.HTTPServer
+	if t.NetworkMeasurements == nil || *t.NetworkMeasurements {
+		httpCost += costs.NetworkMeasurements
+	}
+	units += httpRounds * agents * httpCost
+	return units, nil
+}
@@ -0,0 +1,148 @@
+package thousandeyes
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetUsage(t *testing.T) {
+	out := `{"usage":{"month":"2022-06","usageDate":"2022-06-15","quota":{"monthStart":"2022-06-01","monthEnd":"2022-06-30","cloudUnitsIncluded":1000000,"endpointAgentsIncluded":50},"cloudUnitsUsed":1200,"cloudUnitsProjected":2400,"endpointAgentsUsed":12,"tests":[{"aid":1,"accountGroupName":"Default","testId":10,"testName":"test","cloudUnitsUsed":1200,"cloudUnitsProjected":2400}],"endpointAgents":[{"aid":1,"accountGroupName":"Default","endpointAgentsUsed":12}]}}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/usage.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		_, _ = w.Write([]byte(out))
+	})
+
+	expected := Usage{
+		Month:     String("2022-06"),
+		UsageDate: String("2022-06-15"),
+		Quota: &UsageQuota{
+			MonthStart:             String("2022-06-01"),
+			MonthEnd:               String("2022-06-30"),
+			CloudUnitsIncluded:     Int(1000000),
+			EndpointAgentsIncluded: Int(50),
+		},
+		CloudUnitsUsed:      Int(1200),
+		CloudUnitsProjected: Int(2400),
+		EndpointAgentsUsed:  Int(12),
+		Tests: &[]TestUsage{
+			{
+				AID:                 Int(1),
+				AccountGroupName:    String("Default"),
+				TestID:              Int64(10),
+				TestName:            String("test"),
+				CloudUnitsUsed:      Int(1200),
+				CloudUnitsProjected: Int(2400),
+			},
+		},
+		EndpointAgents: &[]EndpointAgentUsage{
+			{AID: Int(1), AccountGroupName: String("Default"), EndpointAgentsUsed: Int(12)},
+		},
+	}
+	res, err := client.GetUsage()
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &expected, res)
+}
+
+func TestClient_GetTestUsage(t *testing.T) {
+	out := `{"usage":{"tests":[{"testId":10,"cloudUnitsUsed":1200}]}}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/usage/tests.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		_, _ = w.Write([]byte(out))
+	})
+
+	res, err := client.GetTestUsage()
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &[]TestUsage{{TestID: Int64(10), CloudUnitsUsed: Int(1200)}}, res)
+}
+
+func TestClient_GetEndpointAgentUsage(t *testing.T) {
+	out := `{"usage":{}}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/usage/endpoint-agents.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		_, _ = w.Write([]byte(out))
+	})
+
+	res, err := client.GetEndpointAgentUsage()
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &[]EndpointAgentUsage{}, res)
+}
+
+func TestClient_GetEnterpriseAgentUsage(t *testing.T) {
+	out := `{"usage":{"enterpriseAgents":[{"aid":1,"accountGroupName":"Default","enterpriseAgentsUsed":3}]}}`
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/usage/enterprise-agents.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		_, _ = w.Write([]byte(out))
+	})
+
+	res, err := client.GetEnterpriseAgentUsage()
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, &[]EnterpriseAgentUsage{{AID: Int(1), AccountGroupName: String("Default"), EnterpriseAgentsUsed: Int(3)}}, res)
+}
+
+func TestClient_GetUsageMissing(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/usage.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	_, err := client.GetUsage()
+	teardown()
+	assert.EqualError(t, err, "'usage' not found in JSON response")
+}
+
+func TestEstimateHTTPServerUnits(t *testing.T) {
+	costs := UnitCosts{HTTPServer: 1, PageLoad: 3, NetworkMeasurements: 1}
+	test := HTTPServer{
+		Interval: Int(300),
+		Agents:   &[]Agent{{AgentID: Int(1)}, {AgentID: Int(2)}},
+	}
+	// 8928 rounds per agent in a 31 day month, two agents, HTTP and network.
+	units, err := EstimateHTTPServerUnits(test, costs)
+	assert.Nil(t, err)
+	assert.Equal(t, float64(8928*2*2), units)
+
+	test.NetworkMeasurements = Bool(false)
+	units, err = EstimateHTTPServerUnits(test, costs)
+	assert.Nil(t, err)
+	assert.Equal(t, float64(8928*2), units)
+
+	_, err = EstimateHTTPServerUnits(HTTPServer{Agents: test.Agents}, costs)
+	assert.EqualError(t, err, "test interval must be set to estimate units")
+
+	_, err = EstimateHTTPServerUnits(HTTPServer{Interval: Int(300)}, costs)
+	assert.EqualError(t, err, "test must have agents to estimate units")
+
+	_, err = EstimateHTTPServerUnits(test, UnitCosts{})
+	assert.EqualError(t, err, "HTTP server unit cost must be set to estimate units")
+}
+
+func TestEstimatePageLoadUnits(t *testing.T) {
+	costs := UnitCosts{HTTPServer: 1, PageLoad: 3, NetworkMeasurements: 1}
+	test := PageLoad{
+		Interval:            Int(600),
+		HTTPInterval:        Int(300),
+		NetworkMeasurements: Bool(false),
+		Agents:              &[]Agent{{AgentID: Int(1)}},
+	}
+	units, err := EstimatePageLoadUnits(test, costs)
+	assert.Nil(t, err)
+	assert.Equal(t, float64(4464*3+8928), units)
+
+	_, err = EstimatePageLoadUnits(test, UnitCosts{HTTPServer: 1})
+	assert.EqualError(t, err, "page load and HTTP server unit costs must be set to estimate units")
+}
@@ -1,20 +1,34 @@
 package thousandeyes
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 )
 
 // User - a user
 type User struct {
-	Name                 *string             `json:"name,omitempty"`
-	Email                *string             `json:"email,omitempty"`
-	UID                  *int                `json:"uid,omitempty"`
-	LastLogin            *time.Time          `json:"lastLogin,omitempty"`
-	DateRegistered       *time.Time          `json:"dateRegistered,omitempty"`
-	LoginAccountGroup    *AccountGroup       `json:"loginAccountGroup,omitempty"`
-	AccountGroupRoles    *[]AccountGroupRole `json:"accountGroupRoles,omitempty"`
-	AllAccountGroupRoles *[]AccountGroupRole `json:"allAccountGroupRoles,omitempty"`
+	Name                 *string                 `json:"name,omitempty"`
+	Email                *string                 `json:"email,omitempty"`
+	UID                  *int                    `json:"uid,omitempty"`
+	LastLogin            *time.Time              `json:"lastLogin,omitempty"`
+	DateRegistered       *time.Time              `json:"dateRegistered,omitempty"`
+	LoginAccountGroup    *AccountGroup           `json:"loginAccountGroup,omitempty"`
+	AccountGroupRoles    *[]UserAccountGroupRole `json:"accountGroupRoles,omitempty"`
+	AllAccountGroupRoles *[]UserAccountGroupRole `json:"allAccountGroupRoles,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// UserAccountGroupRole - an account group assigned to a user, together with
+// the roles the user holds in the account group
+type UserAccountGroupRole struct {
+	AccountGroup *AccountGroup       `json:"accountGroup,omitempty"`
+	Roles        *[]AccountGroupRole `json:"roles,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetUsers - get users
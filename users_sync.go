@@ -0,0 +1,260 @@
+package thousandeyes
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// UserSyncAction - the change a user sync plan makes to a single user
+type UserSyncAction string
+
+// User sync actions
+const (
+	UserSyncCreate UserSyncAction = "create"
+	UserSyncUpdate UserSyncAction = "update"
+	UserSyncDelete UserSyncAction = "delete"
+)
+
+// DesiredUser - a user as it should exist in ThousandEyes, for example
+// as exported from an identity provider.
+type DesiredUser struct {
+	Name  string
+	Email string
+	// LoginAccountGroupID is the account group the user lands in when
+	// logging in.  It is only used when creating the user.
+	LoginAccountGroupID int
+	// AccountGroupRoles maps account group IDs to the role IDs the user
+	// holds in that account group.
+	AccountGroupRoles map[int][]int
+}
+
+// UserSyncOptions - options for SyncUsers
+type UserSyncOptions struct {
+	// CallerEmail is the email of the user owning the API token.  That user
+	// is never deleted, even if it is missing from the desired list.  It is
+	// required when Delete is set.
+	CallerEmail string
+	// Delete enables removal of users which are not in the desired list.
+	Delete bool
+	// DryRun computes the plan without applying it.
+	DryRun bool
+}
+
+// UserSyncChange - a single change in a user sync plan
+type UserSyncChange struct {
+	Action UserSyncAction
+	Email  string
+	UID    *int
+	User   User
+}
+
+// UserSyncPlan - the set of changes needed to bring users to the desired state
+type UserSyncPlan struct {
+	Changes []UserSyncChange
+	// Protected lists users which would have been deleted but were kept,
+	// such as the calling user.
+	Protected []string
+}
+
+// String returns a human readable summary of the plan, one change per line.
+func (p UserSyncPlan) String() string {
+	var b strings.Builder
+	for _, c := range p.Changes {
+		fmt.Fprintf(&b, "%s %s\n", c.Action, c.Email)
+	}
+	for _, email := range p.Protected {
+		fmt.Fprintf(&b, "keep %s (protected)\n", email)
+	}
+	return b.String()
+}
+
+// PlanUserSync - compute the changes needed so that the users returned by
+// GetUsers match the desired users.  Users are matched by email, ignoring case.
+func (c *Client) PlanUserSync(desired []DesiredUser, opts UserSyncOptions) (*UserSyncPlan, error) {
+	if opts.Delete && opts.CallerEmail == "" {
+		return nil, fmt.Errorf("caller email is required to delete users")
+	}
+	users, err := c.GetUsers()
+	if err != nil {
+		return nil, err
+	}
+
+	current := map[string]User{}
+	for _, u := range *users {
+		if u.Email != nil {
+			current[strings.ToLower(*u.Email)] = u
+		}
+	}
+
+	plan := UserSyncPlan{}
+	seen := map[string]bool{}
+	for _, d := range desired {
+		key := strings.ToLower(d.Email)
+		if key == "" {
+			return nil, fmt.Errorf("desired user %q has no email", d.Name)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("duplicate desired user %s", d.Email)
+		}
+		seen[key] = true
+
+		existing, ok := current[key]
+		if !ok {
+			user := User{
+				Email:             String(d.Email),
+				AccountGroupRoles: desiredAccountGroupRoles(d.AccountGroupRoles),
+			}
+			if d.Name != "" {
+				user.Name = String(d.Name)
+			}
+			if d.LoginAccountGroupID != 0 {
+				user.LoginAccountGroup = &AccountGroup{AID: Int(d.LoginAccountGroupID)}
+			}
+			plan.Changes = append(plan.Changes, UserSyncChange{Action: UserSyncCreate, Email: d.Email, User: user})
+			continue
+		}
+
+		nameChanged := d.Name != "" && (existing.Name == nil || *existing.Name != d.Name)
+		rolesChanged := !sameAccountGroupRoles(userAccountGroupRoles(existing), d.AccountGroupRoles)
+		if nameChanged || rolesChanged {
+			user := User{AccountGroupRoles: desiredAccountGroupRoles(d.AccountGroupRoles)}
+			if d.Name != "" {
+				user.Name = String(d.Name)
+			}
+			plan.Changes = append(plan.Changes, UserSyncChange{Action: UserSyncUpdate, Email: d.Email, UID: existing.UID, User: user})
+		}
+	}
+
+	if opts.Delete {
+		for _, u := range *users {
+			if u.Email == nil || seen[strings.ToLower(*u.Email)] {
+				continue
+			}
+			if strings.EqualFold(*u.Email, opts.CallerEmail) {
+				plan.Protected = append(plan.Protected, *u.Email)
+				continue
+			}
+			plan.Changes = append(plan.Changes, UserSyncChange{Action: UserSyncDelete, Email: *u.Email, UID: u.UID})
+		}
+	}
+	return &plan, nil
+}
+
+// ApplyUserSync - apply a plan computed by PlanUserSync.  It stops at the
+// first failed change and returns the changes applied so far.
+func (c *Client) ApplyUserSync(plan UserSyncPlan) ([]UserSyncChange, error) {
+	var applied []UserSyncChange
+	for _, change := range plan.Changes {
+		var err error
+		switch change.Action {
+		case UserSyncCreate:
+			_, err = c.CreateUser(change.User)
+		case UserSyncUpdate:
+			if change.UID == nil {
+				return applied, fmt.Errorf("cannot update user %s without uid", change.Email)
+			}
+			_, err = c.UpdateUser(*change.UID, change.User)
+		case UserSyncDelete:
+			if change.UID == nil {
+				return applied, fmt.Errorf("cannot delete user %s without uid", change.Email)
+			}
+			err = c.DeleteUser(*change.UID)
+		default:
+			err = fmt.Errorf("unknown user sync action %q", change.Action)
+		}
+		if err != nil {
+			return applied, fmt.Errorf("failed to %s user %s: %v", change.Action, change.Email, err)
+		}
+		applied = append(applied, change)
+	}
+	return applied, nil
+}
+
+// SyncUsers - plan and, unless opts.DryRun is set, apply a user sync.
+func (c *Client) SyncUsers(desired []DesiredUser, opts UserSyncOptions) (*UserSyncPlan, error) {
+	plan, err := c.PlanUserSync(desired, opts)
+	if err != nil {
+		return nil, err
+	}
+	if opts.DryRun {
+		return plan, nil
+	}
+	_, err = c.ApplyUserSync(*plan)
+	return plan, err
+}
+
+// userAccountGroupRoles returns the role IDs a user holds, keyed by account group ID.
+func userAccountGroupRoles(u User) map[int][]int {
+	result := map[int][]int{}
+	if u.AccountGroupRoles == nil {
+		return result
+	}
+	for _, agr := range *u.AccountGroupRoles {
+		if agr.AccountGroup == nil || agr.AccountGroup.AID == nil || agr.Roles == nil {
+			continue
+		}
+		aid := *agr.AccountGroup.AID
+		for _, r := range *agr.Roles {
+			if r.RoleID != nil {
+				result[aid] = append(result[aid], *r.RoleID)
+			}
+		}
+	}
+	return result
+}
+
+// desiredAccountGroupRoles converts account group role IDs to the API representation.
+func desiredAccountGroupRoles(roles map[int][]int) *[]UserAccountGroupRole {
+	aids := make([]int, 0, len(roles))
+	for aid := range roles {
+		aids = append(aids, aid)
+	}
+	sort.Ints(aids)
+
+	result := []UserAccountGroupRole{}
+	for _, aid := range aids {
+		agRoles := []AccountGroupRole{}
+		for _, id := range roles[aid] {
+			agRoles = append(agRoles, AccountGroupRole{RoleID: Int(id)})
+		}
+		result = append(result, UserAccountGroupRole{
+			AccountGroup: &AccountGroup{AID: Int(aid)},
+			Roles:        &agRoles,
+		})
+	}
+	return &result
+}
+
+// sameAccountGroupRoles compares two account group role assignments, ignoring order.
+func sameAccountGroupRoles(a, b map[int][]int) bool {
+	normalize := func(m map[int][]int) map[int]map[int]bool {
+		n := map[int]map[int]bool{}
+		for aid, ids := range m {
+			if len(ids) == 0 {
+				continue
+			}
+			n[aid] = map[int]bool{}
+			for _, id := range ids {
+				n[aid][id] = true
+			}
+		}
+		return n
+	}
+	na, nb := normalize(a), normalize(b)
+	if len(na) != len(nb) {
+		return false
+	}
+	for aid, ids := range na {
+		other, ok := nb[aid]
+		if !ok || len(other) != len(ids) {
+			return false
+		}
+		for id := range ids {
+			if !other[id] {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,131 @@
+package thousandeyes
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const userSyncUsers = `{"users": [
+	{"name": "Admin", "email": "admin@example.com", "uid": 1, "accountGroupRoles": [{"accountGroup": {"aid": 10}, "roles": [{"roleId": 57}]}]},
+	{"name": "Unchanged", "email": "same@example.com", "uid": 2, "accountGroupRoles": [{"accountGroup": {"aid": 10}, "roles": [{"roleId": 58}, {"roleId": 57}]}]},
+	{"name": "Old Name", "email": "rename@example.com", "uid": 3, "accountGroupRoles": [{"accountGroup": {"aid": 10}, "roles": [{"roleId": 57}]}]},
+	{"name": "Leaver", "email": "leaver@example.com", "uid": 4}
+]}`
+
+func TestClient_PlanUserSync(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/users.json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		_, _ = w.Write([]byte(userSyncUsers))
+	})
+
+	desired := []DesiredUser{
+		{Name: "Unchanged", Email: "SAME@example.com", AccountGroupRoles: map[int][]int{10: {57, 58}}},
+		{Name: "New Name", Email: "rename@example.com", AccountGroupRoles: map[int][]int{10: {57}}},
+		{Name: "Joiner", Email: "joiner@example.com", LoginAccountGroupID: 10, AccountGroupRoles: map[int][]int{10: {57}}},
+	}
+	plan, err := client.PlanUserSync(desired, UserSyncOptions{CallerEmail: "admin@example.com", Delete: true})
+	teardown()
+	assert.Nil(t, err)
+
+	roles := &[]UserAccountGroupRole{
+		{AccountGroup: &AccountGroup{AID: Int(10)}, Roles: &[]AccountGroupRole{{RoleID: Int(57)}}},
+	}
+	expected := UserSyncPlan{
+		Changes: []UserSyncChange{
+			{Action: UserSyncUpdate, Email: "rename@example.com", UID: Int(3), User: User{Name: String("New Name"), AccountGroupRoles: roles}},
+			{Action: UserSyncCreate, Email: "joiner@example.com", User: User{
+				Name:              String("Joiner"),
+				Email:             String("joiner@example.com"),
+				LoginAccountGroup: &AccountGroup{AID: Int(10)},
+				AccountGroupRoles: roles,
+			}},
+			{Action: UserSyncDelete, Email: "leaver@example.com", UID: Int(4)},
+		},
+		Protected: []string{"admin@example.com"},
+	}
+	assert.Equal(t, &expected, plan)
+	assert.Equal(t, "update rename@example.com\ncreate joiner@example.com\ndelete leaver@example.com\nkeep admin@example.com (protected)\n", plan.String())
+}
+
+func TestClient_PlanUserSyncNoDelete(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/users.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(userSyncUsers))
+	})
+
+	plan, err := client.PlanUserSync([]DesiredUser{}, UserSyncOptions{})
+	teardown()
+	assert.Nil(t, err)
+	assert.Empty(t, plan.Changes)
+}
+
+func TestClient_PlanUserSyncDuplicate(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/users.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(userSyncUsers))
+	})
+
+	desired := []DesiredUser{{Email: "a@example.com"}, {Email: "A@example.com"}}
+	_, err := client.PlanUserSync(desired, UserSyncOptions{})
+	teardown()
+	assert.EqualError(t, err, "duplicate desired user A@example.com")
+}
+
+func TestClient_PlanUserSyncDeleteWithoutCaller(t *testing.T) {
+	var client = &Client{APIEndpoint: "http://localhost", AuthToken: "foo"}
+	_, err := client.PlanUserSync([]DesiredUser{}, UserSyncOptions{Delete: true})
+	assert.EqualError(t, err, "caller email is required to delete users")
+}
+
+func TestClient_SyncUsers(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	var calls []string
+	mux.HandleFunc("/users.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(userSyncUsers))
+	})
+	mux.HandleFunc("/users/new.json", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "create")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"uid": 5}`))
+	})
+	mux.HandleFunc("/users/4/delete.json", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "delete")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	desired := []DesiredUser{
+		{Email: "admin@example.com", AccountGroupRoles: map[int][]int{10: {57}}},
+		{Email: "same@example.com", AccountGroupRoles: map[int][]int{10: {57, 58}}},
+		{Email: "rename@example.com", AccountGroupRoles: map[int][]int{10: {57}}},
+		{Email: "joiner@example.com", AccountGroupRoles: map[int][]int{10: {57}}},
+	}
+	_, err := client.SyncUsers(desired, UserSyncOptions{CallerEmail: "admin@example.com", Delete: true, DryRun: true})
+	assert.Nil(t, err)
+	assert.Nil(t, calls)
+
+	_, err = client.SyncUsers(desired, UserSyncOptions{CallerEmail: "admin@example.com", Delete: true})
+	teardown()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"create", "delete"}, calls)
+}
+
+func TestClient_ApplyUserSyncError(t *testing.T) {
+	setup()
+	var client = &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	mux.HandleFunc("/users/4/delete.json", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	plan := UserSyncPlan{Changes: []UserSyncChange{{Action: UserSyncDelete, Email: "leaver@example.com", UID: Int(4)}}}
+	applied, err := client.ApplyUserSync(plan)
+	teardown()
+	assert.Empty(t, applied)
+	assert.ErrorContains(t, err, "failed to delete user leaver@example.com")
+}
@@ -1,11 +1,16 @@
 package thousandeyes
 
 import (
-	"encoding/json"
+	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 )
 
+//go:generate go run ./internal/cmd/marshalgen
+//go:generate go run ./internal/cmd/accessorgen
+//go:generate go run ./internal/cmd/buildergen
+
 // Bool is a helper routine that allocates a new bool value
 // to store v and returns a pointer to it.
 func Bool(v bool) *bool { return &v }
@@ -22,62 +27,110 @@ func Int64(v int64) *int64 { return &v }
 // to store v and returns a pointer to it.
 func String(v string) *string { return &v }
 
-// booleanFieldsFromStruct receives a struct pointer and returns the
-// JSON keys for fields tagged with "te:int-bool".
-func booleanFieldsFromStruct(structPtr interface{}) map[string]bool {
-	booleanFields := map[string]bool{}
-
-	v := reflect.ValueOf(structPtr).Elem()
-	t := reflect.TypeOf(v.Interface())
-
-	for i := 0; i < t.NumField(); i++ {
-		if tag, ok := t.Field(i).Tag.Lookup("te"); ok && tag == "int-bool" {
-			jsonKey := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
-			booleanFields[jsonKey] = true
-		}
+// Ptr is a helper routine that allocates a new T value
+// to store v and returns a pointer to it.
+func Ptr[T any](v T) *T { return &v }
+
+// Value returns the value p points to, or the zero value of T
+// if p is nil.
+func Value[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
 	}
-
-	return booleanFields
+	return *p
 }
 
-// jsonBoolToInt is a helper routine that transforms ThousandEyes
-// boolean fields to an int value. It is used by JSON marshalers.
-func jsonBoolToInt(structPtr interface{}, data []byte) ([]byte, error) {
-	booleanFields := booleanFieldsFromStruct(structPtr)
-
-	var jsonMap map[string]interface{}
-	if err := json.Unmarshal(data, &jsonMap); err != nil {
-		return nil, err
+// intBool is a boolean which ThousandEyes encodes as the int 0 or 1.
+// The JSON marshalers of structs with *bool fields tagged te:"int-bool"
+// use it in place of those fields; they are generated by marshalgen.
+//
+// Decoding is tolerant of the other encodings the API has been seen to
+// return: numbers other than 0 and 1, strings such as "1" or "true", and
+// JSON booleans.
+type intBool bool
+
+// MarshalJSON implements the json.Marshaler interface.
+func (b intBool) MarshalJSON() ([]byte, error) {
+	if b {
+		return []byte("1"), nil
 	}
+	return []byte("0"), nil
+}
 
-	for jsonKey, jsonValue := range jsonMap {
-		if booleanFields[jsonKey] {
-			if jsonValue.(bool) {
-				jsonMap[jsonKey] = 1
-			} else {
-				jsonMap[jsonKey] = 0
-			}
-		}
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (b *intBool) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
 	}
-
-	return json.Marshal(jsonMap)
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+	switch strings.ToLower(s) {
+	case "1", "true":
+		*b = true
+		return nil
+	case "0", "false", "":
+		*b = false
+		return nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return fmt.Errorf("cannot decode %s as a boolean", data)
+	}
+	*b = f != 0
+	return nil
 }
 
-// jsonIntToBool is a helper routine that transforms ThousandEyes int
-// fields to a boolean value. It is used by JSON unmarshalers.
-func jsonIntToBool(structPtr interface{}, data []byte) ([]byte, error) {
-	booleanFields := booleanFieldsFromStruct(structPtr)
-
-	var jsonMap map[string]interface{}
-	if err := json.Unmarshal(data, &jsonMap); err != nil {
-		return nil, err
+// walkValues calls fn with v and every value reachable from it through
+// pointers, interfaces, exported struct fields, slices, arrays and maps,
+// along with its path, such as "Agents[0].AgentName".  The walk stops at
+// the first error fn returns.
+func walkValues(v reflect.Value, path string, fn func(v reflect.Value, path string) error) error {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return walkValues(v.Elem(), path, fn)
+	case reflect.Invalid:
+		return nil
 	}
-
-	for jsonKey, jsonValue := range jsonMap {
-		if booleanFields[jsonKey] {
-			jsonMap[jsonKey] = jsonValue.(float64) == 1
+	if err := fn(v, path); err != nil {
+		return err
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).PkgPath != "" {
+				continue
+			}
+			field := t.Field(i).Name
+			if path != "" {
+				field = path + "." + field
+			}
+			if err := walkValues(v.Field(i), field, fn); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return nil
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := walkValues(v.Index(i), fmt.Sprintf("%s[%d]", path, i), fn); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			if err := walkValues(iter.Value(), fmt.Sprintf("%s[%v]", path, iter.Key()), fn); err != nil {
+				return err
+			}
 		}
 	}
-
-	return json.Marshal(jsonMap)
+	return nil
 }
@@ -0,0 +1,339 @@
+package thousandeyes
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// FieldError - a constraint violated by a single field
+type FieldError struct {
+	// Field is the path of the field, such as "Interval" or
+	// "TargetSIPCredentials.Port".
+	Field   string
+	Message string
+}
+
+// Error implements the error interface.
+func (e FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// ValidationErrors - all the constraints violated by an object
+type ValidationErrors []FieldError
+
+// Error implements the error interface, listing every violation.
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, fe := range e {
+		messages[i] = fe.Error()
+	}
+	return "invalid configuration: " + strings.Join(messages, "; ")
+}
+
+// testIntervals are the intervals, in seconds, tests can run at
+var testIntervals = []int{60, 120, 300, 600, 900, 1800, 3600}
+
+// Allowed values of test enum fields
+var (
+	validProtocols      = []string{string(ProtocolTCP), string(ProtocolICMP)}
+	validAgentProtocols = []string{string(ProtocolTCP), string(ProtocolUDP)}
+	validSIPProtocols   = []string{string(ProtocolTCP), string(ProtocolUDP), string(ProtocolTLS)}
+	validDNSProtocols   = []string{string(DNSTransportProtocolUDP), string(DNSTransportProtocolTCP)}
+	validProbeModes     = []string{string(ProbeModeAuto), string(ProbeModeSACK), string(ProbeModeSYN)}
+	validPathTraceModes = []string{string(PathTraceModeClassic), string(PathTraceModeInSession)}
+	validDirections     = []string{string(DirectionToTarget), string(DirectionFromTarget), string(DirectionBidirectional)}
+	validAuthTypes      = []string{string(AuthTypeNone), string(AuthTypeBasic), string(AuthTypeNTLM), string(AuthTypeKerberos)}
+	validFTPRequests    = []string{"Download", "Upload", "List"}
+)
+
+var statusCodePattern = regexp.MustCompile(`^[1-5][0-9][0-9]$`)
+
+// validator collects the violations found while validating an object
+type validator struct {
+	errs ValidationErrors
+}
+
+func (v *validator) add(field, format string, args ...interface{}) {
+	v.errs = append(v.errs, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// err returns the violations found, or nil if there were none
+func (v *validator) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+func (v *validator) interval(field string, interval *int) {
+	if interval == nil {
+		return
+	}
+	for _, i := range testIntervals {
+		if *interval == i {
+			return
+		}
+	}
+	v.add(field, "must be one of %s, got %d", joinInts(testIntervals), *interval)
+}
+
+// timeLimit checks that a time limit in seconds is positive and shorter than the interval
+func (v *validator) timeLimit(field string, limit, interval *int) {
+	if limit == nil {
+		return
+	}
+	if *limit <= 0 {
+		v.add(field, "must be positive, got %d", *limit)
+		return
+	}
+	if interval != nil && *limit >= *interval {
+		v.add(field, "must be less than the interval of %d seconds, got %d", *interval, *limit)
+	}
+}
+
+func (v *validator) oneOf(field string, value *string, allowed []string) {
+	if value == nil {
+		return
+	}
+	for _, a := range allowed {
+		if *value == a {
+			return
+		}
+	}
+	v.add(field, "must be one of %s, got %q", strings.Join(allowed, ", "), *value)
+}
+
+func (v *validator) url(field string, value *string, schemes ...string) {
+	if value == nil {
+		return
+	}
+	u, err := url.Parse(*value)
+	if err != nil {
+		v.add(field, "is not a valid URL: %v", err)
+		return
+	}
+	if u.Host == "" {
+		v.add(field, "must be an absolute URL with a host, got %q", *value)
+		return
+	}
+	for _, s := range schemes {
+		if strings.EqualFold(u.Scheme, s) {
+			return
+		}
+	}
+	v.add(field, "scheme must be one of %s, got %q", strings.Join(schemes, ", "), u.Scheme)
+}
+
+func (v *validator) port(field string, port *int) {
+	if port != nil && (*port < 1 || *port > 65535) {
+		v.add(field, "must be between 1 and 65535, got %d", *port)
+	}
+}
+
+func (v *validator) required(field string, set bool) {
+	if !set {
+		v.add(field, "is required")
+	}
+}
+
+func (v *validator) desiredStatusCode(field string, code *string) {
+	if code != nil && *code != "default" && !statusCodePattern.MatchString(*code) {
+		v.add(field, `must be "default" or a status code between 100 and 599, got %q`, *code)
+	}
+}
+
+// network checks the fields of tests which take network measurements
+func (v *validator) network(protocol *Protocol, probeMode *ProbeMode, pathTraceMode *PathTraceMode) {
+	v.oneOf("Protocol", (*string)(protocol), validProtocols)
+	v.oneOf("ProbeMode", (*string)(probeMode), validProbeModes)
+	v.oneOf("PathTraceMode", (*string)(pathTraceMode), validPathTraceModes)
+}
+
+func (v *validator) sipAuth(field string, d *SIPAuthData) {
+	if d == nil {
+		return
+	}
+	v.port(field+".Port", d.Port)
+	v.oneOf(field+".Protocol", (*string)(d.Protocol), validSIPProtocols)
+}
+
+func joinInts(values []int) string {
+	s := make([]string, len(values))
+	for i, value := range values {
+		s[i] = strconv.Itoa(value)
+	}
+	return strings.Join(s, ", ")
+}
+
+// The Validate methods of the tests check them against the documented
+// constraints of the API, so mistakes are found before a request is sent.
+// Unset fields are not checked, except for fields required to create the
+// test, and all violations are returned together as ValidationErrors.
+
+// Validate - check an agent to agent test; TargetAgentID is required
+func (t AgentAgent) Validate() error {
+	v := &validator{}
+	v.interval("Interval", t.Interval)
+	v.required("TargetAgentID", t.TargetAgentID != nil)
+	v.oneOf("Protocol", (*string)(t.Protocol), validAgentProtocols)
+	v.oneOf("PathTraceMode", (*string)(t.PathTraceMode), validPathTraceModes)
+	v.oneOf("Direction", (*string)(t.Direction), validDirections)
+	v.port("Port", t.Port)
+	return v.err()
+}
+
+// Validate - check an agent to server test
+func (t AgentServer) Validate() error {
+	v := &validator{}
+	v.interval("Interval", t.Interval)
+	v.network(t.Protocol, t.ProbeMode, t.PathTraceMode)
+	v.port("Port", t.Port)
+	return v.err()
+}
+
+// Validate - check a BGP test
+func (t BGP) Validate() error {
+	v := &validator{}
+	if t.Prefix != nil {
+		if _, _, err := net.ParseCIDR(*t.Prefix); err != nil {
+			v.add("Prefix", "must be a CIDR prefix, got %q", *t.Prefix)
+		}
+	}
+	return v.err()
+}
+
+// Validate - check a DNSSEC test
+func (t DNSSec) Validate() error {
+	v := &validator{}
+	v.interval("Interval", t.Interval)
+	return v.err()
+}
+
+// Validate - check a DNS server test
+func (t DNSServer) Validate() error {
+	v := &validator{}
+	v.interval("Interval", t.Interval)
+	v.network(t.Protocol, t.ProbeMode, t.PathTraceMode)
+	v.oneOf("DNSTransportProtocol", (*string)(t.DNSTransportProtocol), validDNSProtocols)
+	return v.err()
+}
+
+// Validate - check a DNS trace test
+func (t DNSTrace) Validate() error {
+	v := &validator{}
+	v.interval("Interval", t.Interval)
+	v.oneOf("DNSTransportProtocol", (*string)(t.DNSTransportProtocol), validDNSProtocols)
+	return v.err()
+}
+
+// Validate - check an FTP server test
+func (t FTPServer) Validate() error {
+	v := &validator{}
+	v.interval("Interval", t.Interval)
+	v.timeLimit("FTPTimeLimit", t.FTPTimeLimit, t.Interval)
+	v.network(t.Protocol, t.ProbeMode, t.PathTraceMode)
+	v.url("URL", t.URL, "ftp", "ftps", "sftp")
+	v.oneOf("RequestType", t.RequestType, validFTPRequests)
+	return v.err()
+}
+
+// Validate - check an HTTP server test
+func (t HTTPServer) Validate() error {
+	v := &validator{}
+	v.interval("Interval", t.Interval)
+	v.timeLimit("HTTPTimeLimit", t.HTTPTimeLimit, t.Interval)
+	v.network(t.Protocol, t.ProbeMode, t.PathTraceMode)
+	v.url("URL", t.URL, "http", "https")
+	v.oneOf("AuthType", (*string)(t.AuthType), validAuthTypes)
+	v.desiredStatusCode("DesiredStatusCode", t.DesiredStatusCode)
+	return v.err()
+}
+
+// Validate - check a page load test
+func (t PageLoad) Validate() error {
+	v := &validator{}
+	v.interval("Interval", t.Interval)
+	v.interval("HTTPInterval", t.HTTPInterval)
+	if t.Interval != nil && t.HTTPInterval != nil && *t.HTTPInterval > *t.Interval {
+		v.add("HTTPInterval", "must not be longer than the interval of %d seconds, got %d", *t.Interval, *t.HTTPInterval)
+	}
+	httpInterval := t.HTTPInterval
+	if httpInterval == nil {
+		httpInterval = t.Interval
+	}
+	v.timeLimit("HTTPTimeLimit", t.HTTPTimeLimit, httpInterval)
+	v.timeLimit("PageLoadTimeLimit", t.PageLoadTimeLimit, t.Interval)
+	v.network(t.Protocol, t.ProbeMode, t.PathTraceMode)
+	v.url("URL", t.URL, "http", "https")
+	v.oneOf("AuthType", (*string)(t.AuthType), validAuthTypes)
+	return v.err()
+}
+
+// Validate - check an RTP stream test; TargetAgentID is required
+func (t RTPStream) Validate() error {
+	v := &validator{}
+	v.interval("Interval", t.Interval)
+	v.required("TargetAgentID", t.TargetAgentID != nil)
+	return v.err()
+}
+
+// Validate - check a SIP server test
+func (t SIPServer) Validate() error {
+	v := &validator{}
+	v.interval("Interval", t.Interval)
+	v.timeLimit("SIPTimeLimit", t.SIPTimeLimit, t.Interval)
+	v.oneOf("ProbeMode", (*string)(t.ProbeMode), validProbeModes)
+	v.oneOf("PathTraceMode", (*string)(t.PathTraceMode), validPathTraceModes)
+	v.sipAuth("TargetSIPCredentials", t.TargetSIPCredentials)
+	return v.err()
+}
+
+// Validate - check a voice call test; TargetAgentID is required
+func (t VoiceCall) Validate() error {
+	v := &validator{}
+	v.interval("Interval", t.Interval)
+	v.required("TargetAgentID", t.TargetAgentID != nil)
+	v.timeLimit("SIPTimeLimit", t.SIPTimeLimit, t.Interval)
+	v.sipAuth("SourceSIPCredentials", t.SourceSIPCredentials)
+	v.sipAuth("TargetSIPCredentials", t.TargetSIPCredentials)
+	return v.err()
+}
+
+// Validate - check a web transaction test
+func (t WebTransaction) Validate() error {
+	v := &validator{}
+	v.interval("Interval", t.Interval)
+	v.timeLimit("HTTPTimeLimit", t.HTTPTimeLimit, t.Interval)
+	v.timeLimit("TimeLimit", t.TimeLimit, t.Interval)
+	v.network(t.Protocol, t.ProbeMode, t.PathTraceMode)
+	v.url("URL", t.URL, "http", "https")
+	v.oneOf("AuthType", (*string)(t.AuthType), validAuthTypes)
+	v.desiredStatusCode("DesiredStatusCode", t.DesiredStatusCode)
+	return v.err()
+}
+
+// Validate - check an endpoint agent to server test
+func (t EndpointAgentServer) Validate() error {
+	v := &validator{}
+	v.interval("Interval", t.Interval)
+	v.oneOf("Protocol", (*string)(t.Protocol), validProtocols)
+	v.oneOf("ProbeMode", (*string)(t.ProbeMode), validProbeModes)
+	v.port("Port", t.Port)
+	return v.err()
+}
+
+// Validate - check an endpoint HTTP server test
+func (t EndpointHTTPServer) Validate() error {
+	v := &validator{}
+	v.interval("Interval", t.Interval)
+	v.timeLimit("HTTPTimeLimit", t.HTTPTimeLimit, t.Interval)
+	v.oneOf("Protocol", (*string)(t.Protocol), validProtocols)
+	v.oneOf("ProbeMode", (*string)(t.ProbeMode), validProbeModes)
+	v.url("URL", t.URL, "http", "https")
+	v.oneOf("AuthType", (*string)(t.AuthType), validAuthTypes)
+	return v.err()
+}
@@ -0,0 +1,104 @@
+package thousandeyes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPServer_Validate(t *testing.T) {
+	valid := HTTPServer{
+		TestName:          String("web"),
+		URL:               String("https://example.com"),
+		Interval:          Int(300),
+		HTTPTimeLimit:     Int(5),
+		Protocol:          Protocol("TCP").Ptr(),
+		ProbeMode:         ProbeMode("AUTO").Ptr(),
+		PathTraceMode:     PathTraceMode("classic").Ptr(),
+		DesiredStatusCode: String("200"),
+	}
+	assert.Nil(t, valid.Validate())
+	assert.Nil(t, HTTPServer{}.Validate())
+
+	invalid := HTTPServer{
+		URL:               String("example.com/health"),
+		Interval:          Int(30),
+		HTTPTimeLimit:     Int(60),
+		Protocol:          Protocol("tcp").Ptr(),
+		ProbeMode:         ProbeMode("RST").Ptr(),
+		PathTraceMode:     PathTraceMode("inSesion").Ptr(),
+		DesiredStatusCode: String("2xx"),
+	}
+	err := invalid.Validate()
+	assert.Equal(t, ValidationErrors{
+		{Field: "Interval", Message: "must be one of 60, 120, 300, 600, 900, 1800, 3600, got 30"},
+		{Field: "HTTPTimeLimit", Message: "must be less than the interval of 30 seconds, got 60"},
+		{Field: "Protocol", Message: `must be one of TCP, ICMP, got "tcp"`},
+		{Field: "ProbeMode", Message: `must be one of AUTO, SACK, SYN, got "RST"`},
+		{Field: "PathTraceMode", Message: `must be one of classic, inSession, got "inSesion"`},
+		{Field: "URL", Message: `must be an absolute URL with a host, got "example.com/health"`},
+		{Field: "DesiredStatusCode", Message: `must be "default" or a status code between 100 and 599, got "2xx"`},
+	}, err)
+
+	err = HTTPServer{URL: String("ftp://example.com"), Interval: Int(60), HTTPTimeLimit: Int(60)}.Validate()
+	assert.EqualError(t, err, `invalid configuration: HTTPTimeLimit: must be less than the interval of 60 seconds, got 60; URL: scheme must be one of http, https, got "ftp"`)
+}
+
+func TestTargetAgentRequired(t *testing.T) {
+	expected := ValidationErrors{{Field: "TargetAgentID", Message: "is required"}}
+	assert.Equal(t, expected, AgentAgent{}.Validate())
+	assert.Equal(t, expected, RTPStream{}.Validate())
+	assert.Equal(t, expected, VoiceCall{}.Validate())
+	assert.Nil(t, AgentAgent{TargetAgentID: Int(1), Protocol: Protocol("UDP").Ptr(), Port: Int(49153)}.Validate())
+}
+
+func TestAgentServer_Validate(t *testing.T) {
+	err := AgentServer{Port: Int(0), Protocol: Protocol("UDP").Ptr()}.Validate()
+	assert.Equal(t, ValidationErrors{
+		{Field: "Protocol", Message: `must be one of TCP, ICMP, got "UDP"`},
+		{Field: "Port", Message: "must be between 1 and 65535, got 0"},
+	}, err)
+}
+
+func TestPageLoad_Validate(t *testing.T) {
+	err := PageLoad{Interval: Int(300), HTTPInterval: Int(600), HTTPTimeLimit: Int(300)}.Validate()
+	assert.Equal(t, ValidationErrors{
+		{Field: "HTTPInterval", Message: "must not be longer than the interval of 300 seconds, got 600"},
+	}, err)
+
+	err = PageLoad{Interval: Int(300), HTTPInterval: Int(60), HTTPTimeLimit: Int(60)}.Validate()
+	assert.Equal(t, ValidationErrors{
+		{Field: "HTTPTimeLimit", Message: "must be less than the interval of 60 seconds, got 60"},
+	}, err)
+}
+
+func TestVoiceCall_Validate(t *testing.T) {
+	err := VoiceCall{
+		TargetAgentID:        Int(1),
+		TargetSIPCredentials: &SIPAuthData{Port: Int(70000), Protocol: Protocol("SCTP").Ptr()},
+	}.Validate()
+	assert.Equal(t, ValidationErrors{
+		{Field: "TargetSIPCredentials.Port", Message: "must be between 1 and 65535, got 70000"},
+		{Field: "TargetSIPCredentials.Protocol", Message: `must be one of TCP, UDP, TLS, got "SCTP"`},
+	}, err)
+}
+
+func TestBGP_Validate(t *testing.T) {
+	assert.Nil(t, BGP{Prefix: String("192.0.2.0/24")}.Validate())
+	assert.Equal(t, ValidationErrors{{Field: "Prefix", Message: `must be a CIDR prefix, got "192.0.2.0"`}}, BGP{Prefix: String("192.0.2.0")}.Validate())
+}
+
+func TestEndpointTests_Validate(t *testing.T) {
+	err := EndpointAgentServer{Interval: Int(30), Port: Int(0)}.Validate()
+	assert.Equal(t, ValidationErrors{
+		{Field: "Interval", Message: "must be one of 60, 120, 300, 600, 900, 1800, 3600, got 30"},
+		{Field: "Port", Message: "must be between 1 and 65535, got 0"},
+	}, err)
+
+	err = EndpointHTTPServer{URL: String("example.com"), Protocol: Protocol("UDP").Ptr()}.Validate()
+	assert.Equal(t, ValidationErrors{
+		{Field: "Protocol", Message: `must be one of TCP, ICMP, got "UDP"`},
+		{Field: "URL", Message: `must be an absolute URL with a host, got "example.com"`},
+	}, err)
+	assert.Nil(t, EndpointHTTPServer{URL: String("https://example.com"), Interval: Int(300), HTTPTimeLimit: Int(5)}.Validate())
+}
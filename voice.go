@@ -31,9 +31,9 @@ type RTPStream struct {
 	Agents          *[]Agent      `json:"agents,omitempty"`
 	BGPMeasurements *bool         `json:"bgpMeasurements,omitempty" te:"int-bool"`
 	BGPMonitors     *[]BGPMonitor `json:"bgpMonitors,omitempty"`
-	Codec           *string       `json:"codec,omitempty"`
+	Codec           *Codec        `json:"codec,omitempty"`
 	CodecID         *int          `json:"codecId,omitempty"`
-	DSCP            *string       `json:"dscp,omitempty"`
+	DSCP            *DSCP         `json:"dscp,omitempty"`
 	DSCPID          *int          `json:"dscpId,omitempty"`
 	Duration        *int          `json:"duration,omitempty"`
 	Interval        *int          `json:"interval,omitempty"`
@@ -44,40 +44,31 @@ type RTPStream struct {
 	UsePublicBGP    *bool         `json:"usePublicBgp,omitempty" te:"int-bool"`
 	// server field is present in response, but we should not track it.
 	//Server          *string       `json:"server,omitempty"`
-}
-
-// MarshalJSON implements the json.Marshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t RTPStream) MarshalJSON() ([]byte, error) {
-	type aliasTest RTPStream
-
-	data, err := json.Marshal((aliasTest)(t))
-	if err != nil {
-		return nil, err
-	}
 
-	return jsonBoolToInt(&t, data)
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-" te:"ignore=server"`
 }
 
-// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t *RTPStream) UnmarshalJSON(data []byte) error {
-	type aliasTest RTPStream
-	test := (*aliasTest)(t)
-
-	data, err := jsonIntToBool(t, data)
-	if err != nil {
-		return err
-	}
+// beforeMarshalJSON fills in whichever of the codec and DSCP names and IDs
+// is missing from the other.
+func (t *RTPStream) beforeMarshalJSON() {
+	fillCodec(&t.Codec, &t.CodecID)
+	fillDSCP(&t.DSCP, &t.DSCPID)
+}
 
-	return json.Unmarshal(data, &test)
+// afterUnmarshalJSON fills in whichever of the codec and DSCP names and IDs
+// the API did not return.
+func (t *RTPStream) afterUnmarshalJSON() {
+	fillCodec(&t.Codec, &t.CodecID)
+	fillDSCP(&t.DSCP, &t.DSCPID)
 }
 
 // AddAgent - Add agent to voice call  test
 func (t *RTPStream) AddAgent(id int) {
 	agent := Agent{AgentID: Int(id)}
+	if t.Agents == nil {
+		t.Agents = &[]Agent{}
+	}
 	*t.Agents = append(*t.Agents, agent)
 }
 
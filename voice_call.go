@@ -7,13 +7,17 @@ import (
 
 // SIPAuthData - Authentication fields for SIP tests
 type SIPAuthData struct {
-	AuthUser     *string `json:"authUser,omitempty"`
-	Password     *string `json:"password,omitempty"`
-	Port         *int    `json:"port,omitempty"`
-	Protocol     *string `json:"protocol,omitempty"`
-	SIPProxy     *string `json:"sipProxy,omitempty"`
-	SIPRegistrar *string `json:"sipRegistrar,omitempty"`
-	User         *string `json:"user,omitempty"`
+	AuthUser      *string   `json:"authUser,omitempty"`
+	CredentialsID *int      `json:"credentialsId,omitempty"`
+	Password      *string   `json:"password,omitempty"`
+	Port          *int      `json:"port,omitempty"`
+	Protocol      *Protocol `json:"protocol,omitempty"`
+	SIPProxy      *string   `json:"sipProxy,omitempty"`
+	SIPRegistrar  *string   `json:"sipRegistrar,omitempty"`
+	User          *string   `json:"user,omitempty"`
+
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // VoiceCall - VoiceCall trace test
@@ -39,9 +43,9 @@ type VoiceCall struct {
 	// Fields unique to this test
 	Agents               *[]Agent     `json:"agents,omitempty"`
 	BGPMeasurements      *bool        `json:"bgpMeasurements,omitempty" te:"int-bool"`
-	Codec                *string      `json:"codec,omitempty"`
+	Codec                *Codec       `json:"codec,omitempty"`
 	CodecID              *int         `json:"codecId,omitempty"`
-	DSCP                 *string      `json:"dscp,omitempty"`
+	DSCP                 *DSCP        `json:"dscp,omitempty"`
 	DSCPID               *int         `json:"dscpId,omitempty"`
 	Duration             *int         `json:"duration,omitempty"`
 	Interval             *int         `json:"interval,omitempty"`
@@ -53,40 +57,31 @@ type VoiceCall struct {
 	TargetAgentID        *int         `json:"targetAgentId,omitempty"`
 	TargetSIPCredentials *SIPAuthData `json:"targetSipCredentials,omitempty"`
 	UsePublicBGP         *bool        `json:"usePublicBgp,omitempty" te:"int-bool"`
-}
-
-// MarshalJSON implements the json.Marshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t VoiceCall) MarshalJSON() ([]byte, error) {
-	type aliasTest VoiceCall
-
-	data, err := json.Marshal((aliasTest)(t))
-	if err != nil {
-		return nil, err
-	}
 
-	return jsonBoolToInt(&t, data)
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
-// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t *VoiceCall) UnmarshalJSON(data []byte) error {
-	type aliasTest VoiceCall
-	test := (*aliasTest)(t)
-
-	data, err := jsonIntToBool(t, data)
-	if err != nil {
-		return err
-	}
+// beforeMarshalJSON fills in whichever of the codec and DSCP names and IDs
+// is missing from the other.
+func (t *VoiceCall) beforeMarshalJSON() {
+	fillCodec(&t.Codec, &t.CodecID)
+	fillDSCP(&t.DSCP, &t.DSCPID)
+}
 
-	return json.Unmarshal(data, &test)
+// afterUnmarshalJSON fills in whichever of the codec and DSCP names and IDs
+// the API did not return.
+func (t *VoiceCall) afterUnmarshalJSON() {
+	fillCodec(&t.Codec, &t.CodecID)
+	fillDSCP(&t.DSCP, &t.DSCPID)
 }
 
 // AddAgent - Add agent to voice call  test
 func (t *VoiceCall) AddAgent(id int) {
 	agent := Agent{AgentID: Int(id)}
+	if t.Agents == nil {
+		t.Agents = &[]Agent{}
+	}
 	*t.Agents = append(*t.Agents, agent)
 }
 
@@ -1,6 +1,7 @@
 package thousandeyes
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
 
@@ -34,30 +35,32 @@ func TestClient_GetVoiceCall(t *testing.T) {
 		ModifiedDate:    String("2019-02-06 01:09:56"),
 		ModifiedBy:      String("ThousandEyes (support@thousandeyes.com)"),
 		TargetAgentID:   Int(69),
-		Codec:           String("G.711 @ 64 Kbps"),
+		Codec:           Codec("G.711 @ 64 Kbps").Ptr(),
 		CodecID:         Int(0),
 		BGPMeasurements: Bool(true),
 		UsePublicBGP:    Bool(true),
 		NumPathTraces:   Int(3),
-		DSCP:            String("EF (DSCP 46)"),
+		DSCP:            DSCP("EF (DSCP 46)").Ptr(),
 		DSCPID:          Int(46),
 		TargetSIPCredentials: &SIPAuthData{
-			Protocol:     String("UDP"),
-			AuthUser:     String("1005"),
-			Password:     nil,
-			Port:         Int(5060),
-			SIPProxy:     String(""),
-			SIPRegistrar: String("18.234.180.66"),
-			User:         String("1005"),
+			Protocol:      Protocol("UDP").Ptr(),
+			AuthUser:      String("1005"),
+			CredentialsID: Int(48165),
+			Password:      nil,
+			Port:          Int(5060),
+			SIPProxy:      String(""),
+			SIPRegistrar:  String("18.234.180.66"),
+			User:          String("1005"),
 		},
 		SourceSIPCredentials: &SIPAuthData{
-			Protocol:     String("UDP"),
-			AuthUser:     String("1006"),
-			Password:     nil,
-			Port:         Int(5060),
-			SIPProxy:     String(""),
-			SIPRegistrar: String("18.234.180.66"),
-			User:         String("1006"),
+			Protocol:      Protocol("UDP").Ptr(),
+			AuthUser:      String("1006"),
+			CredentialsID: Int(48162),
+			Password:      nil,
+			Port:          Int(5060),
+			SIPProxy:      String(""),
+			SIPRegistrar:  String("18.234.180.66"),
+			User:          String("1006"),
 		},
 		APILinks: &[]APILink{
 
@@ -78,6 +81,9 @@ func TestClient_GetVoiceCall(t *testing.T) {
 				Href: String("https://api.thousandeyes.com/v6/net/bgp-metrics/814641"),
 			},
 		},
+		Extra: map[string]json.RawMessage{
+			"server": json.RawMessage(`"18.234.180.66:5060"`),
+		},
 	}
 
 	res, err := client.GetVoiceCall(122621)
@@ -121,12 +127,13 @@ func TestClient_CreateVoiceCall(t *testing.T) {
 		TestName:        String("Voice Call - AWS SIP server"),
 		Interval:        Int(120),
 		AlertsEnabled:   Bool(false),
+		DSCP:            DSCP("EF (DSCP 46)").Ptr(),
 		DSCPID:          Int(46),
 		Duration:        Int(5),
 		BGPMeasurements: Bool(true),
 		UsePublicBGP:    Bool(true),
 		NumPathTraces:   Int(3),
-		Codec:           String("G.711 @ 64 Kbps"),
+		Codec:           Codec("G.711 @ 64 Kbps").Ptr(),
 		CodecID:         Int(0),
 		APILinks: &[]APILink{
 			{
@@ -146,13 +153,16 @@ func TestClient_CreateVoiceCall(t *testing.T) {
 				Rel:  String("data"),
 			},
 		},
+		Extra: map[string]json.RawMessage{
+			"server": json.RawMessage(`"18.234.180.66:5060"`),
+		},
 	}
 	create := VoiceCall{
 		TestName: String("test1"),
 		DSCPID:   Int(46),
 		Duration: Int(5),
 		Interval: Int(120),
-		Codec:    String("G.711 @ 64 Kbps"),
+		Codec:    Codec("G.711 @ 64 Kbps").Ptr(),
 	}
 	res, err := client.CreateVoiceCall(create)
 	teardown()
@@ -190,14 +200,14 @@ func TestClient_UpdateVoiceCall(t *testing.T) {
 	sipS := VoiceCall{
 		TestName:     String("Voice Call - AWS SIP server"),
 		CodecID:      Int(0),
-		Codec:        String("G.711 @ 64 Kbps"),
+		Codec:        Codec("G.711 @ 64 Kbps").Ptr(),
 		JitterBuffer: Int(40),
 	}
 	res, err := client.UpdateVoiceCall(id, sipS)
 	if err != nil {
 		t.Fatal(err)
 	}
-	expected := VoiceCall{AlertsEnabled: Bool(false), Interval: Int(120), TestID: Int64(1234), Codec: String("G.711 @ 64 Kbps"), TestName: String("Voice Call - AWS SIP server"), CodecID: Int(0), JitterBuffer: Int(40)}
+	expected := VoiceCall{AlertsEnabled: Bool(false), Interval: Int(120), TestID: Int64(1234), Codec: Codec("G.711 @ 64 Kbps").Ptr(), TestName: String("Voice Call - AWS SIP server"), CodecID: Int(0), JitterBuffer: Int(40), Extra: map[string]json.RawMessage{"server": json.RawMessage(`"18.234.180.66:5060"`)}}
 	assert.Equal(t, &expected, res)
 
 }
@@ -1,6 +1,7 @@
 package thousandeyes
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
 
@@ -32,11 +33,11 @@ func TestClient_GetRTPStream(t *testing.T) {
 		ModifiedDate:    String("2019-02-06 01:09:56"),
 		ModifiedBy:      String("ThousandEyes (support@thousandeyes.com)"),
 		TargetAgentID:   Int(69),
-		Codec:           String("G.711 @ 64 Kbps"),
+		Codec:           Codec("G.711 @ 64 Kbps").Ptr(),
 		CodecID:         Int(0),
 		BGPMeasurements: Bool(true),
 		UsePublicBGP:    Bool(true),
-		DSCP:            String("EF (DSCP 46)"),
+		DSCP:            DSCP("EF (DSCP 46)").Ptr(),
 		DSCPID:          Int(46),
 		NumPathTraces:   Int(3),
 		APILinks: &[]APILink{
@@ -58,6 +59,12 @@ func TestClient_GetRTPStream(t *testing.T) {
 				Href: String("https://api.thousandeyes.com/v6/net/bgp-metrics/814641"),
 			},
 		},
+		Extra: map[string]json.RawMessage{
+			"sipTargetTime":        json.RawMessage(`1000`),
+			"sipTimeLimit":         json.RawMessage(`5`),
+			"sourceSipCredentials": json.RawMessage(`{"credentialsId":48162,"user":"1006","sipRegistrar":"18.234.180.66","sipProxy":"","authUser":"1006","port":5060,"protocol":"UDP"}`),
+			"targetSipCredentials": json.RawMessage(`{"credentialsId":48165,"user":"1005","sipRegistrar":"18.234.180.66","sipProxy":"","authUser":"1005","port":5060,"protocol":"UDP"}`),
+		},
 	}
 
 	res, err := client.GetRTPStream(122621)
@@ -101,12 +108,13 @@ func TestClient_CreateRTPStream(t *testing.T) {
 		TestName:        String("RTP Stream - AWS RTP server"),
 		Interval:        Int(120),
 		AlertsEnabled:   Bool(false),
+		DSCP:            DSCP("EF (DSCP 46)").Ptr(),
 		DSCPID:          Int(46),
 		Duration:        Int(5),
 		BGPMeasurements: Bool(true),
 		UsePublicBGP:    Bool(true),
 		NumPathTraces:   Int(3),
-		Codec:           String("G.711 @ 64 Kbps"),
+		Codec:           Codec("G.711 @ 64 Kbps").Ptr(),
 		CodecID:         Int(0),
 		APILinks: &[]APILink{
 			{
@@ -132,7 +140,7 @@ func TestClient_CreateRTPStream(t *testing.T) {
 		DSCPID:   Int(46),
 		Duration: Int(5),
 		Interval: Int(120),
-		Codec:    String("G.711 @ 64 Kbps"),
+		Codec:    Codec("G.711 @ 64 Kbps").Ptr(),
 	}
 	res, err := client.CreateRTPStream(create)
 	teardown()
@@ -170,14 +178,14 @@ func TestClient_UpdateRTPStream(t *testing.T) {
 	sipS := RTPStream{
 		TestName:     String("RTP Stream - AWS RTP server"),
 		CodecID:      Int(0),
-		Codec:        String("G.711 @ 64 Kbps"),
+		Codec:        Codec("G.711 @ 64 Kbps").Ptr(),
 		JitterBuffer: Int(40),
 	}
 	res, err := client.UpdateRTPStream(id, sipS)
 	if err != nil {
 		t.Fatal(err)
 	}
-	expected := RTPStream{AlertsEnabled: Bool(false), Interval: Int(120), TestID: Int64(1234), Codec: String("G.711 @ 64 Kbps"), TestName: String("RTP Stream - AWS RTP server"), CodecID: Int(0), JitterBuffer: Int(40)}
+	expected := RTPStream{AlertsEnabled: Bool(false), Interval: Int(120), TestID: Int64(1234), Codec: Codec("G.711 @ 64 Kbps").Ptr(), TestName: String("RTP Stream - AWS RTP server"), CodecID: Int(0), JitterBuffer: Int(40)}
 	assert.Equal(t, &expected, res)
 
 }
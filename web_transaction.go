@@ -27,7 +27,7 @@ type WebTransaction struct {
 
 	// Fields unique to this test
 	Agents                *[]Agent       `json:"agents,omitempty"`
-	AuthType              *string        `json:"authType,omitempty"`
+	AuthType              *AuthType      `json:"authType,omitempty"`
 	BandwidthMeasurements *bool          `json:"bandwidthMeasurements,omitempty" te:"int-bool"`
 	ContentRegex          *string        `json:"contentRegex,omitempty"`
 	Credentials           *[]int         `json:"credentials,omitempty"`
@@ -42,9 +42,9 @@ type WebTransaction struct {
 	NetworkMeasurements   *bool          `json:"networkMeasurements,omitempty" te:"int-bool"`
 	NumPathTraces         *int           `json:"numPathTraces,omitempty"`
 	Password              *string        `json:"password,omitempty"`
-	PathTraceMode         *string        `json:"pathTraceMode,omitempty"`
-	ProbeMode             *string        `json:"probeMode,omitempty"`
-	Protocol              *string        `json:"protocol,omitempty"`
+	PathTraceMode         *PathTraceMode `json:"pathTraceMode,omitempty"`
+	ProbeMode             *ProbeMode     `json:"probeMode,omitempty"`
+	Protocol              *Protocol      `json:"protocol,omitempty"`
 	SSLVersionID          *int           `json:"sslVersionId,omitempty"`
 	SubInterval           *int           `json:"subinterval,omitempty"`
 	TargetTime            *int           `json:"targetTime,omitempty"`
@@ -55,35 +55,9 @@ type WebTransaction struct {
 	UserAgent             *string        `json:"userAgent,omitempty"`
 	Username              *string        `json:"username,omitempty"`
 	VerifyCertificate     *bool          `json:"verifyCertificate,omitempty" te:"int-bool"`
-}
-
-// MarshalJSON implements the json.Marshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t WebTransaction) MarshalJSON() ([]byte, error) {
-	type aliasTest WebTransaction
-
-	data, err := json.Marshal((aliasTest)(t))
-	if err != nil {
-		return nil, err
-	}
-
-	return jsonBoolToInt(&t, data)
-}
-
-// UnmarshalJSON implements the json.Unmarshaler interface. It ensures
-// that ThousandEyes int fields that only use the values 0 or 1 are
-// treated as booleans.
-func (t *WebTransaction) UnmarshalJSON(data []byte) error {
-	type aliasTest WebTransaction
-	test := (*aliasTest)(t)
-
-	data, err := jsonIntToBool(t, data)
-	if err != nil {
-		return err
-	}
 
-	return json.Unmarshal(data, &test)
+	// Extra holds fields returned by the API which the SDK does not model
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // CreateWebTransaction - Create a web transaction test
@@ -0,0 +1,353 @@
+package thousandeyes
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// YAML encoding
+//
+// Tests, alert rules, labels and roles implement yaml.Marshaler and
+// yaml.Unmarshaler, so they can be kept in YAML files:
+//
+//	testName: web
+//	url: https://example.com
+//	enabled: true
+//	agents:
+//	  - Singapore
+//	alertRules:
+//	  - Default HTTP Alert Rule
+//
+// Fields use their JSON names, and booleans the API encodes as 0 or 1 are
+// encoded as true or false.  Agents, alert rules and labels nested in
+// another object are encoded by name when they have one; on decoding, a
+// name sets only AgentName, RuleName or Name.  Client.ResolveNames looks up
+// their IDs before the object is created or updated.
+//
+// The YAML encoding is derived from the JSON one, so unknown fields are
+// kept in Extra as they are for JSON.
+
+// yamlReferences - the keys holding the names of objects which can be
+// referenced by name
+var yamlReferences = map[reflect.Type]string{
+	reflect.TypeOf(Agent{}):      "agentName",
+	reflect.TypeOf(AlertRule{}):  "ruleName",
+	reflect.TypeOf(GroupLabel{}): "name",
+}
+
+var anyType = reflect.TypeOf((*interface{})(nil)).Elem()
+
+// marshalYAML returns the YAML node of v, which must marshal to a JSON
+// object
+func marshalYAML(v interface{}) (*yaml.Node, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	// JSON is YAML, and decoding it into a node keeps the field order.
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	node := doc.Content[0]
+	humanizeYAML(node, reflect.TypeOf(v), false)
+	return node, nil
+}
+
+// humanizeYAML rewrites the node of a value of type t, decoded from JSON,
+// in block style with int-bools as booleans.  If ref is set and t can be
+// referenced by name, the node is replaced by the name.
+func humanizeYAML(node *yaml.Node, t reflect.Type, ref bool) {
+	node.Style = 0
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Interface {
+		// Unknown fields are only restyled.
+		for _, n := range node.Content {
+			humanizeYAML(n, t, false)
+		}
+		return
+	}
+	switch node.Kind {
+	case yaml.MappingNode:
+		if key, ok := yamlReferences[t]; ok && ref {
+			if name := yamlMappingValue(node, key); name != nil && name.Kind == yaml.ScalarNode {
+				*node = yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: name.Value}
+				return
+			}
+		}
+		if t.Kind() == reflect.Map {
+			for i := 1; i < len(node.Content); i += 2 {
+				node.Content[i-1].Style = 0
+				humanizeYAML(node.Content[i], t.Elem(), true)
+			}
+			return
+		}
+		if t.Kind() != reflect.Struct {
+			humanizeYAML(node, anyType, false)
+			return
+		}
+		// Endpoint members have no names the client can resolve.
+		members := true
+		if t == reflect.TypeOf(GroupLabel{}) {
+			if typ := yamlMappingValue(node, "type"); typ != nil && LabelType(typ.Value).isEndpoint() {
+				members = false
+			}
+		}
+		// Fields are ordered as in the struct, followed by unknown fields.
+		pairs := make([]yamlPair, 0, len(node.Content)/2)
+		for i := 1; i < len(node.Content); i += 2 {
+			key, value := node.Content[i-1], node.Content[i]
+			key.Style = 0
+			f, ok := jsonField(t, key.Value)
+			if !ok {
+				humanizeYAML(value, anyType, false)
+				pairs = append(pairs, yamlPair{t.NumField(), key, value})
+				continue
+			}
+			pairs = append(pairs, yamlPair{f.Index[0], key, value})
+			if f.Tag.Get("te") == "int-bool" && value.Kind == yaml.ScalarNode && value.Tag == "!!int" {
+				value.Tag = "!!bool"
+				value.Value = fmt.Sprint(value.Value != "0")
+				continue
+			}
+			humanizeYAML(value, f.Type, members)
+		}
+		sort.SliceStable(pairs, func(i, j int) bool { return pairs[i].index < pairs[j].index })
+		for i, p := range pairs {
+			node.Content[2*i], node.Content[2*i+1] = p.key, p.value
+		}
+	case yaml.SequenceNode:
+		elem := anyType
+		if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			elem = t.Elem()
+		}
+		for _, n := range node.Content {
+			humanizeYAML(n, elem, ref)
+		}
+	}
+}
+
+// yamlPair - a member of a mapping node and the index of its field
+type yamlPair struct {
+	index      int
+	key, value *yaml.Node
+}
+
+// yamlMappingValue returns the value of key in a mapping node, or nil
+func yamlMappingValue(node *yaml.Node, key string) *yaml.Node {
+	for i := 1; i < len(node.Content); i += 2 {
+		if node.Content[i-1].Value == key {
+			return node.Content[i]
+		}
+	}
+	return nil
+}
+
+// jsonField returns the field of the struct type t which encoding/json
+// would decode key into
+func jsonField(t reflect.Type, key string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if f.PkgPath != "" || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		if strings.EqualFold(name, key) {
+			return f, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// unmarshalYAML decodes node into v, a pointer to a type with a JSON
+// encoding
+func unmarshalYAML(node *yaml.Node, v interface{}) error {
+	var tree interface{}
+	if err := node.Decode(&tree); err != nil {
+		return err
+	}
+	data, err := json.Marshal(expandYAMLReferences(tree, reflect.TypeOf(v), false))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// expandYAMLReferences replaces names in the decoded YAML value v of a
+// value of type t with objects referenced by those names
+func expandYAMLReferences(v interface{}, t reflect.Type, ref bool) interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch v := v.(type) {
+	case string:
+		if key, ok := yamlReferences[t]; ok && ref {
+			return map[string]interface{}{key: v}
+		}
+	case map[string]interface{}:
+		if t.Kind() != reflect.Struct {
+			return v
+		}
+		members := true
+		if typ, ok := v["type"].(string); ok && t == reflect.TypeOf(GroupLabel{}) && LabelType(typ).isEndpoint() {
+			members = false
+		}
+		for key, value := range v {
+			if f, ok := jsonField(t, key); ok {
+				v[key] = expandYAMLReferences(value, f.Type, members)
+			}
+		}
+	case []interface{}:
+		if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+			return v
+		}
+		for i := range v {
+			v[i] = expandYAMLReferences(v[i], t.Elem(), ref)
+		}
+	}
+	return v
+}
+
+// ResolveNames sets the IDs of the agents, alert rules and labels nested
+// in v which have a name but no ID, such as those of an object decoded from
+// YAML.  v must be a pointer.  Labels are looked up among test labels.
+func (c *Client) ResolveNames(v interface{}) error {
+	names, err := c.getStateNames()
+	if err != nil {
+		return err
+	}
+	if unresolved := names.resolve(v); len(unresolved) > 0 {
+		return fmt.Errorf("not found: %s", strings.Join(unresolved, ", "))
+	}
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (t AccountGroupRole) MarshalYAML() (interface{}, error) { return marshalYAML(t) }
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (t *AccountGroupRole) UnmarshalYAML(node *yaml.Node) error { return unmarshalYAML(node, t) }
+
+// MarshalYAML implements yaml.Marshaler.
+func (t AgentAgent) MarshalYAML() (interface{}, error) { return marshalYAML(t) }
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (t *AgentAgent) UnmarshalYAML(node *yaml.Node) error { return unmarshalYAML(node, t) }
+
+// MarshalYAML implements yaml.Marshaler.
+func (t AgentServer) MarshalYAML() (interface{}, error) { return marshalYAML(t) }
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (t *AgentServer) UnmarshalYAML(node *yaml.Node) error { return unmarshalYAML(node, t) }
+
+// MarshalYAML implements yaml.Marshaler.
+func (t AlertRule) MarshalYAML() (interface{}, error) { return marshalYAML(t) }
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (t *AlertRule) UnmarshalYAML(node *yaml.Node) error { return unmarshalYAML(node, t) }
+
+// MarshalYAML implements yaml.Marshaler.
+func (t BGP) MarshalYAML() (interface{}, error) { return marshalYAML(t) }
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (t *BGP) UnmarshalYAML(node *yaml.Node) error { return unmarshalYAML(node, t) }
+
+// MarshalYAML implements yaml.Marshaler.
+func (t DNSSec) MarshalYAML() (interface{}, error) { return marshalYAML(t) }
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (t *DNSSec) UnmarshalYAML(node *yaml.Node) error { return unmarshalYAML(node, t) }
+
+// MarshalYAML implements yaml.Marshaler.
+func (t DNSServer) MarshalYAML() (interface{}, error) { return marshalYAML(t) }
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (t *DNSServer) UnmarshalYAML(node *yaml.Node) error { return unmarshalYAML(node, t) }
+
+// MarshalYAML implements yaml.Marshaler.
+func (t DNSTrace) MarshalYAML() (interface{}, error) { return marshalYAML(t) }
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (t *DNSTrace) UnmarshalYAML(node *yaml.Node) error { return unmarshalYAML(node, t) }
+
+// MarshalYAML implements yaml.Marshaler.
+func (t EndpointAgentServer) MarshalYAML() (interface{}, error) { return marshalYAML(t) }
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (t *EndpointAgentServer) UnmarshalYAML(node *yaml.Node) error { return unmarshalYAML(node, t) }
+
+// MarshalYAML implements yaml.Marshaler.
+func (t EndpointHTTPServer) MarshalYAML() (interface{}, error) { return marshalYAML(t) }
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (t *EndpointHTTPServer) UnmarshalYAML(node *yaml.Node) error { return unmarshalYAML(node, t) }
+
+// MarshalYAML implements yaml.Marshaler.
+func (t FTPServer) MarshalYAML() (interface{}, error) { return marshalYAML(t) }
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (t *FTPServer) UnmarshalYAML(node *yaml.Node) error { return unmarshalYAML(node, t) }
+
+// MarshalYAML implements yaml.Marshaler.
+func (t GenericEndpointTest) MarshalYAML() (interface{}, error) { return marshalYAML(t) }
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (t *GenericEndpointTest) UnmarshalYAML(node *yaml.Node) error { return unmarshalYAML(node, t) }
+
+// MarshalYAML implements yaml.Marshaler.
+func (t GenericTest) MarshalYAML() (interface{}, error) { return marshalYAML(t) }
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (t *GenericTest) UnmarshalYAML(node *yaml.Node) error { return unmarshalYAML(node, t) }
+
+// MarshalYAML implements yaml.Marshaler.
+func (t GroupLabel) MarshalYAML() (interface{}, error) { return marshalYAML(t) }
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (t *GroupLabel) UnmarshalYAML(node *yaml.Node) error { return unmarshalYAML(node, t) }
+
+// MarshalYAML implements yaml.Marshaler.
+func (t HTTPServer) MarshalYAML() (interface{}, error) { return marshalYAML(t) }
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (t *HTTPServer) UnmarshalYAML(node *yaml.Node) error { return unmarshalYAML(node, t) }
+
+// MarshalYAML implements yaml.Marshaler.
+func (t PageLoad) MarshalYAML() (interface{}, error) { return marshalYAML(t) }
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (t *PageLoad) UnmarshalYAML(node *yaml.Node) error { return unmarshalYAML(node, t) }
+
+// MarshalYAML implements yaml.Marshaler.
+func (t RTPStream) MarshalYAML() (interface{}, error) { return marshalYAML(t) }
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (t *RTPStream) UnmarshalYAML(node *yaml.Node) error { return unmarshalYAML(node, t) }
+
+// MarshalYAML implements yaml.Marshaler.
+func (t SIPServer) MarshalYAML() (interface{}, error) { return marshalYAML(t) }
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (t *SIPServer) UnmarshalYAML(node *yaml.Node) error { return unmarshalYAML(node, t) }
+
+// MarshalYAML implements yaml.Marshaler.
+func (t VoiceCall) MarshalYAML() (interface{}, error) { return marshalYAML(t) }
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (t *VoiceCall) UnmarshalYAML(node *yaml.Node) error { return unmarshalYAML(node, t) }
+
+// MarshalYAML implements yaml.Marshaler.
+func (t WebTransaction) MarshalYAML() (interface{}, error) { return marshalYAML(t) }
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (t *WebTransaction) UnmarshalYAML(node *yaml.Node) error { return unmarshalYAML(node, t) }
@@ -0,0 +1,135 @@
+package thousandeyes
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestMarshalYAML(t *testing.T) {
+	test := HTTPServer{
+		TestID:          Int64(1),
+		TestName:        String("web"),
+		URL:             String("https://example.com"),
+		Enabled:         Bool(true),
+		FollowRedirects: Bool(false),
+		Agents:          &[]Agent{{AgentID: Int(10), AgentName: String("London"), Enabled: Bool(true)}, {AgentID: Int(11)}},
+		AlertRules:      &[]AlertRule{{RuleID: Int(1), RuleName: String("Default HTTP")}},
+		Groups:          &[]GroupLabel{{GroupID: Int64(5), Name: String("web")}},
+		Extra:           map[string]json.RawMessage{"ipv6Policy": json.RawMessage(`"FORCE_IPV6"`)},
+	}
+	data, err := yaml.Marshal(test)
+	assert.Nil(t, err)
+	assert.Equal(t, `alertRules:
+    - Default HTTP
+enabled: true
+groups:
+    - web
+testId: 1
+testName: web
+agents:
+    - London
+    - agentId: 11
+followRedirects: false
+url: https://example.com
+ipv6Policy: FORCE_IPV6
+`, string(data))
+}
+
+func TestUnmarshalYAML(t *testing.T) {
+	var test HTTPServer
+	err := yaml.Unmarshal([]byte(`
+testName: web
+url: https://example.com
+enabled: true
+followRedirects: false
+agents: [London, {agentId: 11}]
+alertRules: [Default HTTP]
+groups: [web]
+ipv6Policy: FORCE_IPV6
+`), &test)
+	assert.Nil(t, err)
+	assert.Equal(t, HTTPServer{
+		TestName:        String("web"),
+		URL:             String("https://example.com"),
+		Enabled:         Bool(true),
+		FollowRedirects: Bool(false),
+		Agents:          &[]Agent{{AgentName: String("London")}, {AgentID: Int(11)}},
+		AlertRules:      &[]AlertRule{{RuleName: String("Default HTTP")}},
+		Groups:          &[]GroupLabel{{Name: String("web")}},
+		Extra:           map[string]json.RawMessage{"ipv6Policy": json.RawMessage(`"FORCE_IPV6"`)},
+	}, test)
+
+	err = yaml.Unmarshal([]byte(`enabled: maybe`), &test)
+	assert.EqualError(t, err, `cannot decode "maybe" as a boolean`)
+}
+
+func TestYAML_RoundTrip(t *testing.T) {
+	// Top-level alert rules and labels are not collapsed to their names.
+	state := DesiredState{
+		AlertRules: []AlertRule{{RuleName: String("Latency"), Expression: String("((responseTime >= 500 ms))"), NotifyOnClear: Bool(true)}},
+		Labels:     []GroupLabel{{Name: String("web"), Type: Ptr(LabelTypeTests)}},
+		HTTPServers: []HTTPServer{{
+			TestName:   String("checkout"),
+			Agents:     &[]Agent{{AgentName: String("London")}},
+			AlertRules: &[]AlertRule{{RuleName: String("Latency")}},
+		}},
+	}
+	data, err := yaml.Marshal(state)
+	assert.Nil(t, err)
+	assert.Equal(t, `alertRules:
+    - expression: ((responseTime >= 500 ms))
+      notifyOnClear: true
+      ruleName: Latency
+labels:
+    - name: web
+      type: tests
+httpServers:
+    - alertRules:
+        - Latency
+      testName: checkout
+      agents:
+        - London
+`, string(data))
+
+	var decoded DesiredState
+	assert.Nil(t, yaml.Unmarshal(data, &decoded))
+	assert.Equal(t, state, decoded)
+}
+
+func TestYAML_EndpointLabel(t *testing.T) {
+	label := GroupLabel{Name: String("laptops"), Type: Ptr(LabelTypeEndpointAgents), EndpointAgents: &[]EndpointAgent{{AgentID: String("a"), AgentName: String("laptop")}}}
+	data, err := yaml.Marshal(label)
+	assert.Nil(t, err)
+	assert.Equal(t, `name: laptops
+type: endpoint_agents
+agents:
+    - agentId: a
+      agentName: laptop
+`, string(data))
+
+	var decoded GroupLabel
+	assert.Nil(t, yaml.Unmarshal(data, &decoded))
+	assert.Equal(t, label, decoded)
+}
+
+func TestClient_ResolveNames(t *testing.T) {
+	setup()
+	defer teardown()
+	stateTestServer(t)
+
+	var test HTTPServer
+	assert.Nil(t, yaml.Unmarshal([]byte("testName: a\nagents: [London]\nalertRules: [Default HTTP]\ngroups: [web]\n"), &test))
+	client := &Client{APIEndpoint: server.URL, AuthToken: "foo"}
+	assert.Nil(t, client.ResolveNames(&test))
+	assert.Equal(t, &[]Agent{{AgentID: Int(10), AgentName: String("London")}}, test.Agents)
+	assert.Equal(t, &[]AlertRule{{RuleID: Int(1), RuleName: String("Default HTTP")}}, test.AlertRules)
+	assert.Equal(t, &[]GroupLabel{{GroupID: Int64(5), Name: String("web")}}, test.Groups)
+
+	// A label is not resolved against itself.
+	label := GroupLabel{Name: String("api"), Agents: &[]Agent{{AgentName: String("Paris")}}}
+	assert.EqualError(t, client.ResolveNames(&label), `not found: agent "Paris"`)
+	assert.Nil(t, label.GroupID)
+}